@@ -0,0 +1,173 @@
+package phonenumbers
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// ErrInvalidCanonical is returned when parsing a string which isn't in canonical format
+var ErrInvalidCanonical = errors.New("the string supplied is not a canonical phone number")
+
+// the parameters of the canonical format, in the order they're written
+const (
+	CANONICAL_EXTENSION            = "ext"  // the extension
+	CANONICAL_ITALIAN_LEADING_ZERO = "ilz"  // italian_leading_zero, if it doesn't follow from the digits
+	CANONICAL_LEADING_ZEROS        = "nlz"  // number_of_leading_zeros, if it doesn't follow from the digits
+	CANONICAL_CARRIER_CODE         = "pdcc" // the preferred domestic carrier code
+	CANONICAL_COUNTRY_CODE_SOURCE  = "src"  // the country code source, as its numeric value
+	CANONICAL_RAW_INPUT            = "raw"  // the raw input
+)
+
+// FormatCanonical formats the passed in number as a compact string which ParseCanonical restores
+// to an identical number, including its extension, leading zeros, carrier code, country code
+// source and raw input. The string starts with the country code and national significant
+// number, e.g. +44-2079460000, followed by a parameter for each field which is set, e.g.
+// +39-0236618300;ext=12;src=1;raw=+39%2002%2036618300
+//
+// Each number has exactly one canonical string, so it can be used as a database column or a
+// cache key, and the format won't change between versions. Note that numbers which are equal
+// but were parsed from different inputs will have different canonical strings if their raw
+// inputs or country code sources were kept.
+func FormatCanonical(number *PhoneNumber) string {
+	nationalSignificantNumber := GetNationalSignificantNumber(number)
+
+	canonical := &strings.Builder{}
+	canonical.WriteRune(PLUS_SIGN)
+	canonical.WriteString(strconv.Itoa(int(number.GetCountryCode())))
+	canonical.WriteByte('-')
+	canonical.WriteString(nationalSignificantNumber)
+
+	writeParam := func(name, value string) {
+		canonical.WriteByte(';')
+		canonical.WriteString(name)
+		canonical.WriteByte('=')
+		canonical.WriteString(value)
+	}
+
+	if number.Extension != nil {
+		writeParam(CANONICAL_EXTENSION, url.PathEscape(number.GetExtension()))
+	}
+
+	// leading zeros are written as part of the national significant number, so we only need
+	// parameters for them if they're set some other way than parsing would set them
+	implied := &PhoneNumber{}
+	setItalianLeadingZerosForPhoneNumber(nationalSignificantNumber, implied)
+	if number.ItalianLeadingZero != nil && (implied.ItalianLeadingZero == nil || number.GetItalianLeadingZero() != implied.GetItalianLeadingZero()) {
+		if number.GetItalianLeadingZero() {
+			writeParam(CANONICAL_ITALIAN_LEADING_ZERO, "1")
+		} else {
+			writeParam(CANONICAL_ITALIAN_LEADING_ZERO, "0")
+		}
+	}
+	if number.NumberOfLeadingZeros != nil && (implied.NumberOfLeadingZeros == nil || number.GetNumberOfLeadingZeros() != implied.GetNumberOfLeadingZeros()) {
+		writeParam(CANONICAL_LEADING_ZEROS, strconv.Itoa(int(number.GetNumberOfLeadingZeros())))
+	}
+
+	if number.PreferredDomesticCarrierCode != nil {
+		writeParam(CANONICAL_CARRIER_CODE, url.PathEscape(number.GetPreferredDomesticCarrierCode()))
+	}
+	if number.CountryCodeSource != nil {
+		writeParam(CANONICAL_COUNTRY_CODE_SOURCE, strconv.Itoa(int(number.GetCountryCodeSource())))
+	}
+	if number.RawInput != nil {
+		writeParam(CANONICAL_RAW_INPUT, url.PathEscape(number.GetRawInput()))
+	}
+
+	return canonical.String()
+}
+
+// ParseCanonical parses a string written by FormatCanonical, returning a number identical to
+// the one which was formatted. Parsing is strict, so anything which FormatCanonical wouldn't
+// have written, such as parameters out of order or unnecessary leading zeros, is rejected
+// with a ParseError which unwraps to ErrInvalidCanonical.
+func ParseCanonical(canonical string) (*PhoneNumber, error) {
+	invalid := func(offset int) error {
+		return newParseError(PARSE_INVALID_CANONICAL, canonical, offset, "")
+	}
+
+	parts := strings.Split(canonical, ";")
+	dash := strings.IndexByte(parts[0], '-')
+	if len(parts[0]) == 0 || parts[0][0] != '+' || dash < 0 {
+		return nil, invalid(0)
+	}
+	countryCode, err := strconv.ParseInt(parts[0][1:dash], 10, 32)
+	if err != nil || countryCode < 0 {
+		return nil, invalid(1)
+	}
+	digits := parts[0][dash+1:]
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return nil, invalid(dash + 1)
+	}
+	nationalNumber, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return nil, invalid(dash + 1)
+	}
+
+	number := &PhoneNumber{
+		CountryCode:    proto.Int32(int32(countryCode)),
+		NationalNumber: proto.Uint64(nationalNumber),
+	}
+	setItalianLeadingZerosForPhoneNumber(digits, number)
+
+	offset := len(parts[0]) + 1
+	for _, param := range parts[1:] {
+		equals := strings.IndexByte(param, '=')
+		if equals < 0 {
+			return nil, invalid(offset)
+		}
+		name, value := param[:equals], param[equals+1:]
+		unescaped, err := url.PathUnescape(value)
+		if err != nil {
+			return nil, invalid(offset + equals + 1)
+		}
+
+		switch name {
+		case CANONICAL_EXTENSION:
+			number.Extension = proto.String(unescaped)
+		case CANONICAL_ITALIAN_LEADING_ZERO:
+			if value != "0" && value != "1" {
+				return nil, invalid(offset + equals + 1)
+			}
+			number.ItalianLeadingZero = proto.Bool(value == "1")
+		case CANONICAL_LEADING_ZEROS:
+			zeros, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, invalid(offset + equals + 1)
+			}
+			number.NumberOfLeadingZeros = proto.Int32(int32(zeros))
+		case CANONICAL_CARRIER_CODE:
+			number.PreferredDomesticCarrierCode = proto.String(unescaped)
+		case CANONICAL_COUNTRY_CODE_SOURCE:
+			source, err := strconv.ParseInt(value, 10, 32)
+			if _, known := PhoneNumber_CountryCodeSource_name[int32(source)]; err != nil || !known {
+				return nil, invalid(offset + equals + 1)
+			}
+			number.CountryCodeSource = PhoneNumber_CountryCodeSource(source).Enum()
+		case CANONICAL_RAW_INPUT:
+			number.RawInput = proto.String(unescaped)
+		default:
+			return nil, invalid(offset)
+		}
+		offset += len(param) + 1
+	}
+
+	// anything which parsed but isn't exactly what we'd have written, such as duplicate or out of
+	// order parameters, or different escaping, isn't canonical
+	if formatted := FormatCanonical(number); formatted != canonical {
+		return nil, invalid(firstDifference(formatted, canonical))
+	}
+	return number, nil
+}
+
+// returns the offset of the first byte which differs between the passed in strings
+func firstDifference(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,43 @@
+// Package carrier maps phone numbers to the carrier they were originally allocated to.
+//
+// The carrier data is large, so it lives in this package rather than in phonenumbers itself
+// and is only linked into programs which import it.
+package carrier
+
+import (
+	"embed"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/nyaruka/phonenumbers/internal/prefixmap"
+)
+
+// our gzipped prefix maps, one file per language
+//
+//go:embed data/*.gz
+var carrierMapData embed.FS
+
+// our prefix maps, one per language, decoded on first use
+var carrierMaps = prefixmap.NewLanguageMaps(carrierMapData, "data")
+
+func init() {
+	prefixmap.CarrierForE164 = carrierForE164
+}
+
+// GetCarrierForNumber returns the carrier we believe the number belongs to. Note due
+// to number porting this is only a guess, there is no guarantee to its accuracy.
+func GetCarrierForNumber(number *phonenumbers.PhoneNumber, lang string) (string, error) {
+	return carrierForE164(phonenumbers.Format(number, phonenumbers.E164), lang)
+}
+
+func carrierForE164(e164 string, lang string) (string, error) {
+	carrier, err := carrierMaps.Lookup(lang, 10, e164)
+	if err != nil {
+		return "", err
+	}
+	if carrier != "" {
+		return carrier, nil
+	}
+
+	// fallback to english
+	return carrierMaps.Lookup("en", 10, e164)
+}
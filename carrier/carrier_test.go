@@ -0,0 +1,52 @@
+package carrier
+
+import (
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/nyaruka/phonenumbers/internal/prefixmap"
+)
+
+func TestGetCarrierForNumber(t *testing.T) {
+	tests := []struct {
+		num      string
+		lang     string
+		expected string
+	}{
+		{num: "+8613702032331", lang: "en", expected: "China Mobile"},
+		{num: "+8613702032331", lang: "zh", expected: "中国移动"},
+		{num: "+6281377468527", lang: "en", expected: "Telkomsel"},
+		{num: "+8613323241342", lang: "en", expected: "China Telecom"},
+		{num: "+61491570156", lang: "en", expected: "Telstra"},
+		{num: "+917999999543", lang: "en", expected: "Reliance Jio"},
+		{num: "+593992218722", lang: "en", expected: "Claro"},
+	}
+	for _, test := range tests {
+		number, err := phonenumbers.Parse(test.num, "ZZ")
+		if err != nil {
+			t.Errorf("Failed to parse number %s: %s", test.num, err)
+		}
+		carrier, err := GetCarrierForNumber(number, test.lang)
+		if err != nil {
+			t.Errorf("Failed to getCarrier for the number %s: %s", test.num, err)
+		}
+		if test.expected != carrier {
+			t.Errorf("Expected '%s', got '%s' for '%s'", test.expected, carrier, test.num)
+		}
+	}
+}
+
+func BenchmarkLoadPrefixMap(b *testing.B) {
+	data, err := carrierMapData.ReadFile("data/en.gz")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := prefixmap.LoadPrefixMap(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
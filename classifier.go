@@ -0,0 +1,207 @@
+package phonenumbers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"sync"
+
+	"github.com/nyaruka/phonenumbers/internal/digitnfa"
+	"github.com/nyaruka/phonenumbers/internal/prefixmap"
+)
+
+// the most states we allow in the classifier for a single region, the largest currently has
+// a few hundred
+const maxClassifierStates = 1 << 16
+
+// the bit in a classifier mask for each of a region's patterns, in the order they're compiled
+const (
+	classifyGeneral = 1 << iota
+	classifyFixedLine
+	classifyMobile
+	classifyTollFree
+	classifyPremiumRate
+	classifySharedCost
+	classifyPersonalNumber
+	classifyVoip
+	classifyPager
+	classifyUan
+	classifyVoicemail
+	classifyLeadingDigits
+)
+
+// returns the number descs of the passed in metadata in the order of their classifier bits
+func classifierDescs(metadata *PhoneMetadata) []*PhoneNumberDesc {
+	return []*PhoneNumberDesc{
+		metadata.GetGeneralDesc(),
+		metadata.GetFixedLine(),
+		metadata.GetMobile(),
+		metadata.GetTollFree(),
+		metadata.GetPremiumRate(),
+		metadata.GetSharedCost(),
+		metadata.GetPersonalNumber(),
+		metadata.GetVoip(),
+		metadata.GetPager(),
+		metadata.GetUan(),
+		metadata.GetVoicemail(),
+	}
+}
+
+// returns a checksum of the patterns of the passed in metadata, so we can tell whether a
+// classifier was built from the same metadata we're using
+func classifierFingerprint(metadata *PhoneMetadata) uint32 {
+	patterns := &bytes.Buffer{}
+	for _, desc := range classifierDescs(metadata) {
+		patterns.WriteString(desc.GetNationalNumberPattern())
+		patterns.WriteByte(0)
+	}
+	patterns.WriteString(metadata.GetLeadingDigits())
+	return crc32.ChecksumIEEE(patterns.Bytes())
+}
+
+// BuildClassifier compiles the national number patterns and leading digits of the passed in
+// metadata into a DFA which classifies a national number in a single pass over its digits, and
+// returns it encoded for embedding. The encoding is the checksum of the patterns it was built
+// from followed by the DFA.
+func BuildClassifier(metadata *PhoneMetadata) ([]byte, error) {
+	constraints := make([]digitnfa.Constraint, 0, 12)
+	for _, desc := range classifierDescs(metadata) {
+		machine, err := digitnfa.Compile("^(?:" + desc.GetNationalNumberPattern() + ")$") // Strictly match
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, digitnfa.Constraint{Machine: machine})
+	}
+	machine, err := digitnfa.Compile("^(?:" + metadata.GetLeadingDigits() + ")")
+	if err != nil {
+		return nil, err
+	}
+	constraints = append(constraints, digitnfa.Constraint{Machine: machine, Prefix: true})
+
+	dfa, err := digitnfa.Determinize(maxClassifierStates, constraints...)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := dfa.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 4, 4+len(encoded))
+	binary.LittleEndian.PutUint32(data, classifierFingerprint(metadata))
+	return append(data, encoded...), nil
+}
+
+var (
+	// The index of the encoded classifiers in classifierData, laid out as our metadata is
+	regionToClassifierEntry            = make(map[string]*metadataEntry)
+	countryCodeToNonGeoClassifierEntry = make(map[int]*metadataEntry)
+
+	// The classifier for each metadata, decoded the first time it's used, nil if we don't have
+	// one which matches the metadata
+	classifiers      = make(map[*PhoneMetadata]*digitnfa.DFA)
+	classifiersMutex sync.RWMutex
+)
+
+func loadClassifierIndex(data []byte) error {
+	entries, err := decodeMetadataIndex(data)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.id == REGION_CODE_FOR_NON_GEO_ENTITY {
+			countryCodeToNonGeoClassifierEntry[entry.countryCode] = entry
+		} else {
+			regionToClassifierEntry[entry.id] = entry
+		}
+	}
+	return nil
+}
+
+// returns the classifier for the passed in metadata, or nil if we don't have one for it
+func getClassifier(metadata *PhoneMetadata) *digitnfa.DFA {
+	classifiersMutex.RLock()
+	dfa, found := classifiers[metadata]
+	classifiersMutex.RUnlock()
+	if found {
+		return dfa
+	}
+
+	dfa, err := decodeClassifier(metadata)
+	if err != nil {
+		// not being able to classify a region isn't fatal, we just use its regexes
+		dfa = nil
+	}
+
+	classifiersMutex.Lock()
+	classifiers[metadata] = dfa
+	classifiersMutex.Unlock()
+	return dfa
+}
+
+var errStaleClassifier = errors.New("classifier was built from different metadata")
+
+// decodes the embedded classifier for the passed in metadata, checking it was built from the
+// same patterns
+func decodeClassifier(metadata *PhoneMetadata) (*digitnfa.DFA, error) {
+	var entry *metadataEntry
+	if metadata.GetId() == REGION_CODE_FOR_NON_GEO_ENTITY {
+		entry = countryCodeToNonGeoClassifierEntry[int(metadata.GetCountryCode())]
+	} else {
+		entry = regionToClassifierEntry[metadata.GetId()]
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	data, err := prefixmap.DecodeUnzip(classifierData[entry.offset : entry.offset+entry.length])
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 4 || binary.LittleEndian.Uint32(data) != classifierFingerprint(metadata) {
+		return nil, errStaleClassifier
+	}
+
+	dfa := &digitnfa.DFA{}
+	if err := dfa.UnmarshalBinary(data[4:]); err != nil {
+		return nil, err
+	}
+	return dfa, nil
+}
+
+// descMatcher matches a national number against the patterns of a region, using the region's
+// classifier if it has one, otherwise its regular expressions
+type descMatcher struct {
+	nationalNumber string
+
+	// which patterns the number matches if it was classified
+	mask       uint64
+	classified bool
+}
+
+func newDescMatcher(nationalNumber string, metadata *PhoneMetadata) descMatcher {
+	m := descMatcher{nationalNumber: nationalNumber}
+	if dfa := getClassifier(metadata); dfa != nil {
+		m.mask, m.classified = dfa.Match(nationalNumber)
+	}
+	return m
+}
+
+// returns whether the number matches the passed in desc, which has the passed in classifier bit
+func (m descMatcher) matches(numberDesc *PhoneNumberDesc, bit uint64) bool {
+	if !m.classified {
+		return isNumberMatchingDesc(m.nationalNumber, numberDesc)
+	}
+	return isPossibleLengthForDesc(m.nationalNumber, numberDesc) && m.mask&bit != 0
+}
+
+// returns whether the number starts with the leading digits of the passed in metadata
+func (m descMatcher) matchesLeadingDigits(metadata *PhoneMetadata) bool {
+	if !m.classified {
+		pat := regexFor("^(?:" + metadata.GetLeadingDigits() + ")") // Non capturing grouping to support OR'ed alternatives (e.g. 555|1[78]|2)
+		return pat.MatchString(m.nationalNumber)
+	}
+	return m.mask&classifyLeadingDigits != 0
+}
@@ -3,8 +3,8 @@ package main
 import (
 	"bufio"
 	"compress/gzip"
-	"encoding/base64"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -27,34 +27,33 @@ import (
 type prefixBuild struct {
 	url     string
 	dir     string
-	srcPath string
-	varName string
+	dataDir string
 }
 
 const (
 	metadataURL  = "https://raw.githubusercontent.com/googlei18n/libphonenumber/master/resources/PhoneNumberMetadata.xml"
-	metadataPath = "metadata_bin.go"
+	metadataPath = "data/metadata.bin"
 
 	tzURL  = "https://raw.githubusercontent.com/googlei18n/libphonenumber/master/resources/timezones/map_data.txt"
-	tzPath = "prefix_to_timezone_bin.go"
-	tzVar  = "timezoneMapData"
+	tzPath = "timezone/data/prefix_to_timezone.gz"
 
-	regionPath = "countrycode_to_region_bin.go"
-	regionVar  = "regionMapData"
+	regionPath = "data/countrycode_to_region.gz"
+
+	classifierPath = "data/classifier.bin"
 )
 
+// prefix data is exported outside of the repo as the carrier and geocoder package
+// directories would otherwise be replaced by the export
 var carrier = prefixBuild{
 	url:     "https://github.com/googlei18n/libphonenumber/trunk/resources/carrier",
-	dir:     "carrier",
-	srcPath: "prefix_to_carriers_bin.go",
-	varName: "carrierMapData",
+	dir:     filepath.Join(os.TempDir(), "phonenumbers-carrier"),
+	dataDir: "carrier/data",
 }
 
 var geocoding = prefixBuild{
 	url:     "https://github.com/googlei18n/libphonenumber/trunk/resources/geocoding",
-	dir:     "geocoding",
-	srcPath: "prefix_to_geocodings_bin.go",
-	varName: "geocodingMapData",
+	dir:     filepath.Join(os.TempDir(), "phonenumbers-geocoding"),
+	dataDir: "geocoder/data",
 }
 
 func fetchURL(url string) []byte {
@@ -76,7 +75,7 @@ func svnExport(dir string, url string) {
 	cmd := exec.Command(
 		"/bin/bash",
 		"-c",
-		fmt.Sprintf("svn export %s --force", url),
+		fmt.Sprintf("svn export %s %s --force", url, dir),
 	)
 
 	stdout, err := cmd.StdoutPipe()
@@ -113,9 +112,9 @@ func svnExport(dir string, url string) {
 }
 
 func writeFile(filePath string, data []byte) {
-	// file should already exist (likely running from wrong directory)
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		log.Fatalf("no such file: %s make sure you are running from the root of the repo directory", filePath)
+	// directory should already exist (likely running from wrong directory)
+	if _, err := os.Stat(filepath.Dir(filePath)); os.IsNotExist(err) {
+		log.Fatalf("no such directory: %s make sure you are running from the root of the repo directory", filepath.Dir(filePath))
 	}
 
 	fmt.Printf("Writing new %s\n", filePath)
@@ -128,7 +127,7 @@ func writeFile(filePath string, data []byte) {
 func buildRegions(metadata *phonenumbers.PhoneMetadataCollection) {
 	log.Println("Building region map")
 	regionMap := phonenumbers.BuildCountryCodeToRegionMap(metadata)
-	writeIntStringArrayMap(regionPath, regionVar, regionMap)
+	writeIntStringArrayMap(regionPath, regionMap)
 }
 
 func buildTimezones() {
@@ -165,10 +164,10 @@ func buildTimezones() {
 	}
 
 	// then write our file
-	writeIntStringArrayMap(tzPath, tzVar, prefixMap)
+	writeIntStringArrayMap(tzPath, prefixMap)
 }
 
-func writeIntStringArrayMap(path string, varName string, prefixMap map[int][]string) {
+func writeIntStringArrayMap(path string, prefixMap map[int][]string) {
 	// build lists of our keys and values
 	keys := make([]int, 0, len(prefixMap))
 	values := make([]string, 0, 255)
@@ -240,7 +239,7 @@ func writeIntStringArrayMap(path string, varName string, prefixMap map[int][]str
 	}
 
 	// then write our file
-	writeFile(path, generateBinFile(varName, data.Bytes()))
+	writeFile(path, gzipData(data.Bytes()))
 }
 
 func buildMetadata() *phonenumbers.PhoneMetadataCollection {
@@ -253,35 +252,69 @@ func buildMetadata() *phonenumbers.PhoneMetadataCollection {
 		log.Fatalf("Error converting XML: %s", err)
 	}
 
-	// write it out as a protobuf
-	data, err := proto.Marshal(collection)
-	if err != nil {
-		log.Fatalf("Error marshalling metadata: %v", err)
+	writeFile(metadataPath, encodeMetadata(collection))
+	return collection
+}
+
+// builds the classifier for each region from the passed in metadata
+func buildClassifiers(collection *phonenumbers.PhoneMetadataCollection) {
+	log.Println("Building classifiers")
+	writeFile(classifierPath, encodeEntries(collection, phonenumbers.BuildClassifier))
+}
+
+// encodes our metadata as an index followed by the gzipped protobuf of each region, so that
+// each region can be decoded independently as it is first used
+func encodeMetadata(collection *phonenumbers.PhoneMetadataCollection) []byte {
+	return encodeEntries(collection, func(metadata *phonenumbers.PhoneMetadata) ([]byte, error) {
+		return proto.Marshal(metadata)
+	})
+}
+
+// encodes an index followed by the gzipped data for each region which the passed in function
+// builds from its metadata
+func encodeEntries(collection *phonenumbers.PhoneMetadataCollection, build func(*phonenumbers.PhoneMetadata) ([]byte, error)) []byte {
+	index := &bytes.Buffer{}
+	blobs := &bytes.Buffer{}
+
+	metadataList := collection.GetMetadata()
+	if err := binary.Write(index, binary.LittleEndian, uint32(len(metadataList))); err != nil {
+		log.Fatal(err)
 	}
 
-	log.Println("Writing new metadata_bin.go")
-	writeFile(metadataPath, generateBinFile("metadataData", data))
-	return collection
+	for _, metadata := range metadataList {
+		data, err := build(metadata)
+		if err != nil {
+			log.Fatalf("Error encoding %s: %v", metadata.GetId(), err)
+		}
+		compressed := gzipData(data)
+
+		// each index entry is our id, country code and the length of our gzipped data
+		id := metadata.GetId()
+		if err := binary.Write(index, binary.LittleEndian, uint8(len(id))); err != nil {
+			log.Fatal(err)
+		}
+		index.WriteString(id)
+		if err := binary.Write(index, binary.LittleEndian, uint32(metadata.GetCountryCode())); err != nil {
+			log.Fatal(err)
+		}
+		if err := binary.Write(index, binary.LittleEndian, uint32(len(compressed))); err != nil {
+			log.Fatal(err)
+		}
+
+		blobs.Write(compressed)
+	}
+
+	index.Write(blobs.Bytes())
+	return index.Bytes()
 }
 
-// generates the file contents for a data file
-func generateBinFile(variableName string, data []byte) []byte {
+// gzips the passed in data, which is how all our data files are embedded
+func gzipData(data []byte) []byte {
 	var compressed bytes.Buffer
 	w := gzip.NewWriter(&compressed)
 	w.Write(data)
 	w.Close()
-	encoded := base64.StdEncoding.EncodeToString(compressed.Bytes())
-
-	// create our output
-	output := &bytes.Buffer{}
-
-	// write our header
-	output.WriteString("package phonenumbers\n\nvar ")
-	output.WriteString(variableName)
-	output.WriteString(" = ")
-	output.WriteString(strconv.Quote(string(encoded)))
-	output.WriteString("\n")
-	return output.Bytes()
+	return compressed.Bytes()
 }
 
 func buildPrefixData(build *prefixBuild) {
@@ -304,19 +337,23 @@ func buildPrefixData(build *prefixBuild) {
 			continue
 		}
 
-		// get our language code
-		parts := strings.Split(dir, "/")
-
 		// build a map for that directory
 		mappings := readMappingsForDir(dir)
 
 		// save it for our language
-		languageMappings[parts[1]] = mappings
+		languageMappings[filepath.Base(dir)] = mappings
 	}
 
-	output := bytes.Buffer{}
-	output.WriteString("package phonenumbers\n\n")
-	output.WriteString(fmt.Sprintf("var %s = map[string]string {\n", build.varName))
+	// remove our existing files so languages which no longer exist don't linger
+	existing, err := filepath.Glob(filepath.Join(build.dataDir, "*.gz"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, path := range existing {
+		if err := os.Remove(path); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	for lang, mappings := range languageMappings {
 		// iterate through our map, creating our full set of values and prefixes
@@ -385,20 +422,8 @@ func buildPrefixData(build *prefixBuild) {
 			last = prefix
 		}
 
-		var compressed bytes.Buffer
-		w := gzip.NewWriter(&compressed)
-		w.Write(data.Bytes())
-		w.Close()
-		c := base64.StdEncoding.EncodeToString(compressed.Bytes())
-		output.WriteString("\t")
-		output.WriteString(strconv.Quote(lang))
-		output.WriteString(": ")
-		output.WriteString(strconv.Quote(c))
-		output.WriteString(",\n")
+		writeFile(filepath.Join(build.dataDir, lang+".gz"), gzipData(data.Bytes()))
 	}
-
-	output.WriteString("}")
-	writeFile(build.srcPath, output.Bytes())
 }
 
 func readMappingsForDir(dir string) map[int]string {
@@ -414,11 +439,6 @@ func readMappingsForDir(dir string) map[int]string {
 		if err != nil {
 			log.Fatal(err)
 		}
-		items := strings.Split(file, "/")
-		if len(items) != 3 {
-			log.Fatalf("file name %s not correct", file)
-		}
-
 		for _, line := range strings.Split(string(body), "\n") {
 			if strings.HasPrefix(line, "#") {
 				continue
@@ -453,8 +473,71 @@ func readMappingsForDir(dir string) map[int]string {
 	return mappings
 }
 
+// lints the metadata currently compiled into phonenumbers, writing any findings to stdout as JSON
+func lint() {
+	findings := phonenumbers.LintMetadata()
+
+	output, err := json.MarshalIndent(findings, "", "  ")
+	if err != nil {
+		log.Fatalf("Error marshalling lint findings: %s", err)
+	}
+	fmt.Println(string(output))
+
+	if len(findings) > 0 {
+		log.Printf("Found %d problems in metadata", len(findings))
+		os.Exit(1)
+	}
+}
+
+// writes the metadata currently compiled into phonenumbers as libphonenumber-js metadata files
+// in the passed in directory
+func exportJS(dir string) {
+	collection, err := phonenumbers.MetadataCollection()
+	if err != nil {
+		log.Fatalf("Error reading metadata: %s", err)
+	}
+
+	flavours := map[string]phonenumbers.JSMetadataFlavour{
+		"metadata.min.json": phonenumbers.JS_METADATA_MIN,
+		"metadata.max.json": phonenumbers.JS_METADATA_MAX,
+	}
+	for filename, flavour := range flavours {
+		data, err := phonenumbers.BuildJSMetadata(collection, flavour)
+		if err != nil {
+			log.Fatalf("Error building %s: %s", filename, err)
+		}
+		writeFile(filepath.Join(dir, filename), data)
+	}
+}
+
 func main() {
+	// `buildmetadata lint` checks the current metadata rather than rebuilding it
+	if len(os.Args) > 1 && os.Args[1] == "lint" {
+		lint()
+		return
+	}
+
+	// `buildmetadata js <dir>` exports the current metadata for libphonenumber-js
+	if len(os.Args) > 1 && os.Args[1] == "js" {
+		if len(os.Args) != 3 {
+			log.Fatalf("Usage: buildmetadata js <dir>")
+		}
+		exportJS(os.Args[2])
+		return
+	}
+
+	// `buildmetadata classifier` rebuilds the classifiers from the current metadata
+	if len(os.Args) > 1 && os.Args[1] == "classifier" {
+		collection, err := phonenumbers.MetadataCollection()
+		if err != nil {
+			log.Fatalf("Error reading metadata: %s", err)
+		}
+		buildClassifiers(collection)
+		return
+	}
+
 	metadata := buildMetadata()
+	buildClassifiers(metadata)
 	buildRegions(metadata)
 	buildTimezones()
 	buildPrefixData(&carrier)
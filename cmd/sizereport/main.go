@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// a variant is a program we build to see how much a set of packages adds to a binary
+type variant struct {
+	name    string
+	imports []string
+	body    string
+}
+
+var variants = []variant{
+	{
+		name: "none",
+		body: `fmt.Println("")`,
+	},
+	{
+		name:    "phonenumbers",
+		imports: []string{"github.com/nyaruka/phonenumbers"},
+		body:    `n, _ := phonenumbers.Parse("+12067799191", "US"); fmt.Println(phonenumbers.Format(n, phonenumbers.E164))`,
+	},
+	{
+		name:    "phonenumbers + carrier",
+		imports: []string{"github.com/nyaruka/phonenumbers", "github.com/nyaruka/phonenumbers/carrier"},
+		body:    `n, _ := phonenumbers.Parse("+12067799191", "US"); fmt.Println(carrier.GetCarrierForNumber(n, "en"))`,
+	},
+	{
+		name:    "phonenumbers + geocoder",
+		imports: []string{"github.com/nyaruka/phonenumbers", "github.com/nyaruka/phonenumbers/geocoder"},
+		body:    `n, _ := phonenumbers.Parse("+12067799191", "US"); fmt.Println(geocoder.GetGeocodingForNumber(n, "en"))`,
+	},
+	{
+		name:    "phonenumbers + timezone",
+		imports: []string{"github.com/nyaruka/phonenumbers", "github.com/nyaruka/phonenumbers/timezone"},
+		body:    `n, _ := phonenumbers.Parse("+12067799191", "US"); fmt.Println(timezone.GetTimezonesForNumber(n))`,
+	},
+	{
+		name: "phonenumbers + all",
+		imports: []string{
+			"github.com/nyaruka/phonenumbers",
+			"github.com/nyaruka/phonenumbers/carrier",
+			"github.com/nyaruka/phonenumbers/geocoder",
+			"github.com/nyaruka/phonenumbers/timezone",
+		},
+		body: `n, _ := phonenumbers.Parse("+12067799191", "US")
+	fmt.Println(carrier.GetCarrierForNumber(n, "en"))
+	fmt.Println(geocoder.GetGeocodingForNumber(n, "en"))
+	fmt.Println(timezone.GetTimezonesForNumber(n))`,
+	},
+}
+
+// builds a program for each variant and prints a markdown table of their binary sizes,
+// must be run from the root of the repo
+func main() {
+	// directories starting with _ are ignored by ./... so our programs won't be picked up by other builds
+	dir, err := ioutil.TempDir(".", "_sizereport")
+	if err != nil {
+		log.Fatalf("error creating temp directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fmt.Println("| Imports | Binary Size | Added |")
+	fmt.Println("|---------|------------:|------:|")
+
+	var baseline int64
+	for i, v := range variants {
+		size := buildVariant(dir, i, &v)
+		if i == 0 {
+			baseline = size
+		}
+		fmt.Printf("| %s | %s | %s |\n", v.name, humanSize(size), humanSize(size-baseline))
+	}
+}
+
+func buildVariant(dir string, index int, v *variant) int64 {
+	src := &strings.Builder{}
+	src.WriteString("package main\n\nimport (\n\t\"fmt\"\n")
+	for _, imp := range v.imports {
+		src.WriteString(fmt.Sprintf("\t%q\n", imp))
+	}
+	src.WriteString(")\n\nfunc main() {\n\t")
+	src.WriteString(v.body)
+	src.WriteString("\n}\n")
+
+	pkgDir := filepath.Join(dir, fmt.Sprintf("variant%d", index))
+	if err := os.Mkdir(pkgDir, 0755); err != nil {
+		log.Fatalf("error creating directory for %s: %s", v.name, err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(pkgDir, "main.go"), []byte(src.String()), 0644); err != nil {
+		log.Fatalf("error writing program for %s: %s", v.name, err)
+	}
+
+	binPath := filepath.Join(dir, fmt.Sprintf("variant%d.bin", index))
+	cmd := exec.Command("go", "build", "-o", binPath, "./"+pkgDir)
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		log.Fatalf("error building %s: %s", v.name, err)
+	}
+
+	info, err := os.Stat(binPath)
+	if err != nil {
+		log.Fatalf("error reading size of %s: %s", v.name, err)
+	}
+	return info.Size()
+}
+
+func humanSize(size int64) string {
+	return fmt.Sprintf("%.2f MB", float64(size)/(1024*1024))
+}
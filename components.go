@@ -0,0 +1,125 @@
+package phonenumbers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// NumberComponents are the structural parts of a phone number
+type NumberComponents struct {
+	// CountryCode is the country calling code, e.g. 44
+	CountryCode int32
+
+	// NationalPrefix is the prefix dialled before the number within its country, e.g. 0, or
+	// empty if numbers like this one are dialled without one
+	NationalPrefix string
+
+	// MobileToken is the digit inserted before the area code of mobile numbers in some
+	// countries, e.g. 9 in Argentina, if this number has one
+	MobileToken string
+
+	// NationalDestinationCode is the area code or mobile network code, without any mobile token
+	NationalDestinationCode string
+
+	// SubscriberNumber is the rest of the national significant number
+	SubscriberNumber string
+
+	// Extension is the extension, if any
+	Extension string
+
+	// ItalianLeadingZeros are the zeros at the start of the national significant number, e.g. 0
+	// for Italian fixed line numbers. These are included in the national destination code or
+	// subscriber number, and are only given here for convenience.
+	ItalianLeadingZeros string
+
+	// Groups are the groups of digits of the national significant number, as they are
+	// separated when formatted in international format
+	Groups []string
+
+	// NationalDigits are the digits of the number formatted in NATIONAL format, without the
+	// extension. These are usually the national prefix followed by the national significant
+	// number, but not always, e.g. Argentinian mobile numbers are dialled with 15 rather than
+	// the mobile token.
+	NationalDigits string
+}
+
+// Components decomposes the passed in number into its country calling code, national prefix,
+// mobile token, national destination code, subscriber number and extension. See
+// GetLengthOfNationalDestinationCode for the caveats of determining the national destination
+// code, which is empty if it can't be determined.
+func Components(number *PhoneNumber) *NumberComponents {
+	nationalSignificantNumber := GetNationalSignificantNumber(number)
+	countryCode := number.GetCountryCode()
+
+	components := &NumberComponents{
+		CountryCode:      countryCode,
+		SubscriberNumber: nationalSignificantNumber,
+		Extension:        number.GetExtension(),
+		Groups:           []string{},
+	}
+	if number.GetItalianLeadingZero() {
+		components.ItalianLeadingZeros = strings.Repeat("0", int(number.GetNumberOfLeadingZeros()))
+	}
+	if !hasValidCountryCallingCode(int(countryCode)) {
+		components.Groups = append(components.Groups, nationalSignificantNumber)
+		return components
+	}
+
+	// we don't want the extension when formatting the groups
+	withoutExtension := number
+	if number.GetExtension() != "" {
+		withoutExtension = &PhoneNumber{}
+		proto.Merge(withoutExtension, number)
+		withoutExtension.Extension = nil
+	}
+
+	// the first group of the international format is the country code
+	groups := DIGITS_PATTERN.FindAllString(Format(withoutExtension, INTERNATIONAL), -1)
+	if len(groups) > 1 && strings.Join(groups[1:], "") == nationalSignificantNumber {
+		components.Groups = groups[1:]
+	} else {
+		components.Groups = append(components.Groups, nationalSignificantNumber)
+	}
+
+	ndcLength := GetLengthOfNationalDestinationCode(number)
+	start := 0
+	if token := GetCountryMobileToken(int(countryCode)); token != "" && GetNumberType(number) == MOBILE &&
+		ndcLength > len(token) && strings.HasPrefix(nationalSignificantNumber, token) {
+		components.MobileToken = token
+		start = len(token)
+	}
+	components.NationalDestinationCode = nationalSignificantNumber[start:ndcLength]
+	components.SubscriberNumber = nationalSignificantNumber[ndcLength:]
+
+	// the national prefix is whatever comes before the national significant number when
+	// formatted nationally, which in some regions isn't simply a prefix, e.g. Argentinian
+	// mobile numbers, in which case we leave it empty
+	components.NationalDigits = NormalizeDigitsOnly(Format(withoutExtension, NATIONAL))
+	if strings.HasSuffix(components.NationalDigits, nationalSignificantNumber) {
+		components.NationalPrefix = components.NationalDigits[:len(components.NationalDigits)-len(nationalSignificantNumber)]
+	}
+
+	return components
+}
+
+// NationalSignificantNumber returns the national significant number these components make up
+func (c *NumberComponents) NationalSignificantNumber() string {
+	return c.MobileToken + c.NationalDestinationCode + c.SubscriberNumber
+}
+
+// E164 returns the number these components make up in E164 format, without the extension
+func (c *NumberComponents) E164() string {
+	return "+" + strconv.Itoa(int(c.CountryCode)) + c.NationalSignificantNumber()
+}
+
+// National returns the digits dialled to reach this number from within its country, without
+// the extension, which are NationalDigits if set, otherwise the national prefix followed by the
+// national significant number
+func (c *NumberComponents) National() string {
+	if c.NationalDigits != "" {
+		return c.NationalDigits
+	}
+	return c.NationalPrefix + c.NationalSignificantNumber()
+}
@@ -0,0 +1,200 @@
+package phonenumbers
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CorrectionKind is the kind of edit a suggested correction makes to the input
+type CorrectionKind string
+
+const (
+	// CORRECTION_TRUNK_PREFIX means a national prefix after the country code was removed
+	CORRECTION_TRUNK_PREFIX CorrectionKind = "trunk_prefix"
+	// CORRECTION_REPEATED_COUNTRY_CODE means a country code typed twice was removed
+	CORRECTION_REPEATED_COUNTRY_CODE CorrectionKind = "repeated_country_code"
+	// CORRECTION_IDD_PREFIX means a leading 00 was treated as an international dialing prefix
+	CORRECTION_IDD_PREFIX CorrectionKind = "idd_prefix"
+	// CORRECTION_MISSING_DIGIT means a digit was inserted
+	CORRECTION_MISSING_DIGIT CorrectionKind = "missing_digit"
+	// CORRECTION_EXTRA_DIGIT means a digit was removed
+	CORRECTION_EXTRA_DIGIT CorrectionKind = "extra_digit"
+	// CORRECTION_OTHER_REGION means the input was parsed as if from another region sharing the calling code
+	CORRECTION_OTHER_REGION CorrectionKind = "other_region"
+)
+
+// Correction is a valid number suggested as a correction of an invalid input
+type Correction struct {
+	// Number is the corrected number
+	Number *PhoneNumber
+
+	// Kind is the kind of edit which was made to the input
+	Kind CorrectionKind
+
+	// Region is the region the corrected number is valid for
+	Region string
+
+	// Type is the type of the corrected number
+	Type PhoneNumberType
+
+	// Distance is the edit distance between the digits of the input and the digits of the correction
+	Distance int
+}
+
+// a candidate correction, which is some text to parse as if from region
+type correctionCandidate struct {
+	kind   CorrectionKind
+	text   string
+	region string
+}
+
+// SuggestCorrections suggests valid numbers which the passed in input might have been meant
+// to be, for use when it isn't a valid number itself. Candidates are generated by fixing common
+// mistakes such as a national prefix after the country code, a missing or extra digit, or a
+// country code typed twice. Numbers in national format which are valid for another region
+// sharing the default region's calling code, but not for the default region itself, are
+// suggested as being from that region. Only valid candidates are returned, closest to the input
+// first, preferring fixed line and mobile numbers when they are equally close. Returns nil if the
+// input is already a valid number.
+func SuggestCorrections(numberToParse, defaultRegion string) []*Correction {
+	original, err := ParseAndKeepRawInput(numberToParse, defaultRegion)
+	if err == nil && IsValidNumber(original) && (original.GetCountryCodeSource() != PhoneNumber_FROM_DEFAULT_COUNTRY || IsValidNumberForRegion(original, defaultRegion)) {
+		return nil
+	}
+
+	digits := normalize(extractPossibleNumber(numberToParse))
+	digits = strings.TrimLeft(digits, "+")
+	if digits == "" {
+		return nil
+	}
+
+	// work out which part of the number we should be editing, which is the national significant
+	// number if the input was in international format, or all of the digits otherwise
+	prefix, body, countryCode := "", digits, 0
+	if err == nil {
+		countryCode = int(original.GetCountryCode())
+		if original.GetCountryCodeSource() != PhoneNumber_FROM_DEFAULT_COUNTRY {
+			prefix = "+" + strconv.Itoa(countryCode)
+			body = GetNationalSignificantNumber(original)
+		}
+	} else if strings.ContainsAny(extractPossibleNumber(numberToParse), "+＋") {
+		prefix = "+"
+	}
+	if countryCode == 0 {
+		countryCode = GetCountryCodeForRegion(defaultRegion)
+	}
+
+	candidates := make([]*correctionCandidate, 0)
+	add := func(kind CorrectionKind, text, region string) {
+		candidates = append(candidates, &correctionCandidate{kind, text, region})
+	}
+
+	if prefix != "" && prefix != "+" {
+		countryCodeStr := strconv.Itoa(countryCode)
+		metadata := getMetadataForRegionOrCallingCode(countryCode, GetRegionCodeForCountryCode(countryCode))
+		if nationalPrefix := metadata.GetNationalPrefix(); nationalPrefix != "" && strings.HasPrefix(body, nationalPrefix) {
+			add(CORRECTION_TRUNK_PREFIX, prefix+body[len(nationalPrefix):], defaultRegion)
+		}
+		if strings.HasPrefix(body, countryCodeStr) {
+			add(CORRECTION_REPEATED_COUNTRY_CODE, prefix+body[len(countryCodeStr):], defaultRegion)
+		}
+	}
+
+	if strings.HasPrefix(digits, "00") {
+		add(CORRECTION_IDD_PREFIX, "+"+digits[2:], defaultRegion)
+	}
+
+	for i := 0; i <= len(body); i++ {
+		for d := '0'; d <= '9'; d++ {
+			add(CORRECTION_MISSING_DIGIT, prefix+body[:i]+string(d)+body[i:], defaultRegion)
+		}
+		if i < len(body) {
+			add(CORRECTION_EXTRA_DIGIT, prefix+body[:i]+body[i+1:], defaultRegion)
+		}
+	}
+
+	for _, region := range GetRegionCodesForCountryCode(countryCode) {
+		if region != defaultRegion {
+			add(CORRECTION_OTHER_REGION, numberToParse, region)
+		}
+	}
+
+	corrections := make([]*Correction, 0)
+	for _, candidate := range candidates {
+		number, err := Parse(candidate.text, candidate.region)
+		if err != nil {
+			continue
+		}
+
+		// a number from another region is the same number, only valid for that region
+		region := GetRegionCodeForNumber(number)
+		if candidate.kind == CORRECTION_OTHER_REGION {
+			if !IsValidNumberForRegion(number, candidate.region) {
+				continue
+			}
+			region = candidate.region
+		} else if !IsValidNumber(number) || (original != nil && isNumberMatchWithNumbers(original, number) == EXACT_MATCH) {
+			continue
+		}
+
+		candidateDigits := strings.TrimLeft(normalize(candidate.text), "+")
+		corrections = append(corrections, &Correction{
+			Number:   number,
+			Kind:     candidate.kind,
+			Region:   region,
+			Type:     GetNumberType(number),
+			Distance: editDistance(digits, candidateDigits),
+		})
+	}
+
+	sort.SliceStable(corrections, func(i, j int) bool {
+		if corrections[i].Distance != corrections[j].Distance {
+			return corrections[i].Distance < corrections[j].Distance
+		}
+		return isFixedLineOrMobile(corrections[i].Type) && !isFixedLineOrMobile(corrections[j].Type)
+	})
+
+	// the same number can be reached by more than one edit, we keep the closest
+	seen := make(map[string]bool)
+	unique := corrections[:0]
+	for _, correction := range corrections {
+		e164 := Format(correction.Number, E164)
+		if !seen[e164] {
+			seen[e164] = true
+			unique = append(unique, correction)
+		}
+	}
+	return unique
+}
+
+// returns the Levenshtein distance between the two passed in strings of digits
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
@@ -0,0 +1,22 @@
+package phonenumbers
+
+import (
+	// embed is needed for our gzipped data files
+	_ "embed"
+)
+
+// our metadata for all regions, an index followed by a gzipped PhoneMetadata protocol buffer
+// for each region, see decodeMetadataIndex
+//
+//go:embed data/metadata.bin
+var metadataData []byte
+
+// our gzipped map from country calling codes to the regions which use them
+//
+//go:embed data/countrycode_to_region.gz
+var regionMapData []byte
+
+// our gzipped classifier for each region, laid out as our metadata is, see BuildClassifier
+//
+//go:embed data/classifier.bin
+var classifierData []byte
@@ -0,0 +1,250 @@
+package phonenumbers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	// ErrNotDiallable is returned when a number can't be dialled from the caller's region
+	ErrNotDiallable = errors.New("the phone number can't be dialled from the caller's region")
+
+	// ErrCarrierCodeRequired is returned when a number can only be dialled with a carrier
+	// selection code and neither the number nor the dialer has one
+	ErrCarrierCodeRequired = errors.New("a carrier selection code is required to dial the phone number")
+
+	// ErrUnknownCallerRegion is returned when dialling from a dialer without a valid region
+	ErrUnknownCallerRegion = errors.New("the caller's region is unknown")
+)
+
+// DEFAULT_DTMF_PAUSE is the pause dialled before the extension of a number, which is a two
+// second pause on most phones
+const DEFAULT_DTMF_PAUSE = ","
+
+// DialMode is how a dial string reaches its destination
+type DialMode int
+
+const (
+	// DIAL_LOCAL means the number is dialled without its area code
+	DIAL_LOCAL DialMode = iota
+	// DIAL_DOMESTIC means the number is dialled in national format, possibly with a carrier code
+	DIAL_DOMESTIC
+	// DIAL_INTERNATIONAL means the number is dialled in E164 format, with a leading +
+	DIAL_INTERNATIONAL
+	// DIAL_IDD means the number is dialled with the caller's international dialling prefix
+	DIAL_IDD
+)
+
+// Dialer generates the dial strings for calling numbers from a particular caller
+type Dialer struct {
+	// Region is the region the caller is in. If empty, the region of Number is used.
+	Region string
+
+	// Number is the caller's own number, if known, which is used to tell whether a destination
+	// is in the same area
+	Number *PhoneNumber
+
+	// CarrierCode is the carrier selection code used for calls within, and from, regions which
+	// need one such as Brazil and Colombia, if the destination doesn't have a preferred one
+	CarrierCode string
+
+	// UseIDD is whether international numbers are dialled with the region's international
+	// dialling prefix, e.g. from landlines, rather than with a leading +
+	UseIDD bool
+
+	// OmitAreaCode is whether numbers in the same area as the caller's number are dialled
+	// without their area code, in regions where numbers can be dialled locally
+	OmitAreaCode bool
+
+	// Pause is what's dialled between connecting and dialling an extension, defaulting to
+	// DEFAULT_DTMF_PAUSE. Common values are "," for a pause, ";" or "w" to wait for
+	// confirmation, or several pauses for systems which are slow to answer.
+	Pause string
+}
+
+// DialString is what a caller needs to dial to reach a number
+type DialString struct {
+	// Number is the digits dialled to connect the call, which may start with a +
+	Number string
+
+	// PostDial is the DTMF sequence dialled once connected, such as a pause and an extension
+	PostDial string
+
+	// Mode is how the number is reached
+	Mode DialMode
+}
+
+// String returns the full dial string, including the post-dial sequence
+func (s *DialString) String() string {
+	return s.Number + s.PostDial
+}
+
+// NewDialer creates a new dialer for a caller in the passed in region
+func NewDialer(region string) *Dialer {
+	return &Dialer{Region: region}
+}
+
+// Dial returns the dial string for calling the passed in number from this dialer. Calls within
+// the caller's country are dialled in national format, with a carrier selection code where one
+// is needed. Calls to other countries are dialled in E164 format, or with the caller's
+// international dialling prefix if UseIDD is set. Any extension is dialled after connecting,
+// following a pause.
+func (d *Dialer) Dial(number *PhoneNumber) (*DialString, error) {
+	countryCode := int(number.GetCountryCode())
+	if !hasValidCountryCallingCode(countryCode) {
+		return nil, ErrInvalidCountryCode
+	}
+
+	callerRegion := d.Region
+	if callerRegion == "" && d.Number != nil {
+		callerRegion = GetRegionCodeForNumber(d.Number)
+	}
+	if !isValidRegionCode(callerRegion) {
+		return nil, ErrUnknownCallerRegion
+	}
+
+	// the extension can't be dialled with the number, so is dialled once connected
+	numberNoExt := &PhoneNumber{}
+	proto.Merge(numberNoExt, number)
+	numberNoExt.Extension = nil
+
+	dial := &DialString{}
+	if number.GetExtension() != "" {
+		dial.PostDial = d.pause() + number.GetExtension()
+	}
+
+	var err error
+	if countryCode == GetCountryCodeForRegion(callerRegion) {
+		dial.Number, dial.Mode, err = d.dialDomestic(numberNoExt, callerRegion)
+	} else {
+		dial.Number, dial.Mode, err = d.dialInternational(numberNoExt, callerRegion)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return dial, nil
+}
+
+// returns the dial string for a number in the caller's own country
+func (d *Dialer) dialDomestic(number *PhoneNumber, callerRegion string) (string, DialMode, error) {
+	countryCode := int(number.GetCountryCode())
+	regionCode := GetRegionCodeForCountryCode(countryCode)
+	numberType := GetNumberType(number)
+	nationalSignificantNumber := GetNationalSignificantNumber(number)
+
+	if local := d.localNumber(number); local != "" {
+		return local, DIAL_LOCAL, nil
+	}
+
+	// some regions need a carrier selection code for calls between fixed line and mobile numbers
+	carrierCode := number.GetPreferredDomesticCarrierCode()
+	if carrierCode == "" {
+		carrierCode = d.CarrierCode
+	}
+	switch {
+	case regionCode == "CO" && numberType == FIXED_LINE:
+		if carrierCode == "" {
+			carrierCode = COLOMBIA_MOBILE_TO_FIXED_LINE_PREFIX
+		}
+		return normalizeDiallableCharsOnly(FormatNationalNumberWithCarrierCode(number, carrierCode)), DIAL_DOMESTIC, nil
+	case regionCode == "BR" && isFixedLineOrMobile(numberType):
+		if carrierCode == "" {
+			return "", DIAL_DOMESTIC, ErrCarrierCodeRequired
+		}
+		return normalizeDiallableCharsOnly(FormatNationalNumberWithCarrierCode(number, carrierCode)), DIAL_DOMESTIC, nil
+	case regionCode == "HU" && numberType != UNKNOWN:
+		// the national prefix is left out when numbers are written down, but must be dialled
+		return GetNddPrefixForRegion(regionCode, true) + nationalSignificantNumber, DIAL_DOMESTIC, nil
+	case countryCode == NANPA_COUNTRY_CODE && numberType != UNKNOWN:
+		// long distance calls within NANPA are dialled with the country code
+		return strconv.Itoa(countryCode) + nationalSignificantNumber, DIAL_DOMESTIC, nil
+	case !d.UseIDD && (regionCode == REGION_CODE_FOR_NON_GEO_ENTITY ||
+		(regionCode == "MX" || regionCode == "CL" || regionCode == "UZ") && isFixedLineOrMobile(numberType)) &&
+		canBeInternationallyDialled(number):
+		// these are dialled most reliably in international format, see FormatNumberForMobileDialing
+		return Format(number, E164), DIAL_INTERNATIONAL, nil
+	}
+
+	return normalizeDiallableCharsOnly(Format(number, NATIONAL)), DIAL_DOMESTIC, nil
+}
+
+// returns the dial string for a number in another country
+func (d *Dialer) dialInternational(number *PhoneNumber, callerRegion string) (string, DialMode, error) {
+	if GetNumberType(number) == UNKNOWN || !canBeInternationallyDialled(number) {
+		return "", DIAL_INTERNATIONAL, ErrNotDiallable
+	}
+	if !d.UseIDD {
+		return Format(number, E164), DIAL_INTERNATIONAL, nil
+	}
+
+	prefix := d.internationalPrefix(callerRegion)
+	if prefix == "" {
+		// we can't tell which prefix to use so fall back to a leading +
+		return Format(number, E164), DIAL_INTERNATIONAL, nil
+	}
+
+	return prefix + strconv.Itoa(int(number.GetCountryCode())) + GetNationalSignificantNumber(number), DIAL_IDD, nil
+}
+
+// returns the international dialling prefix of the passed in region, using the carrier code if
+// the region has a prefix for each carrier, or empty if we can't tell which to use
+func (d *Dialer) internationalPrefix(region string) string {
+	metadata := getMetadataForRegion(region)
+	prefix := metadata.GetInternationalPrefix()
+	if !UNIQUE_INTERNATIONAL_PREFIX.MatchString(prefix) {
+		prefix = metadata.GetPreferredInternationalPrefix()
+	}
+	if prefix == "" && d.CarrierCode != "" {
+		for _, candidate := range []string{"00" + d.CarrierCode, "0" + d.CarrierCode} {
+			if regexFor("^(?:" + metadata.GetInternationalPrefix() + ")$").MatchString(candidate) {
+				prefix = candidate
+				break
+			}
+		}
+	}
+
+	// replace any wait for a dial tone with a pause
+	pause := d.pause()
+	return strings.NewReplacer("~", pause, "\u2053", pause, "\u223C", pause, "\uFF5E", pause).Replace(prefix)
+}
+
+// returns what we dial to pause
+func (d *Dialer) pause() string {
+	if d.Pause == "" {
+		return DEFAULT_DTMF_PAUSE
+	}
+	return d.Pause
+}
+
+// returns the number to dial if the passed in number is in the same area as the caller's own
+// number and can be dialled without its area code, otherwise empty
+func (d *Dialer) localNumber(number *PhoneNumber) string {
+	if !d.OmitAreaCode || d.Number == nil || d.Number.GetCountryCode() != number.GetCountryCode() {
+		return ""
+	}
+	areaCodeLength := GetLengthOfGeographicalAreaCode(number)
+	if areaCodeLength == 0 || areaCodeLength != GetLengthOfGeographicalAreaCode(d.Number) {
+		return ""
+	}
+
+	nationalSignificantNumber := GetNationalSignificantNumber(number)
+	if nationalSignificantNumber[:areaCodeLength] != GetNationalSignificantNumber(d.Number)[:areaCodeLength] {
+		return ""
+	}
+
+	// only if the region lets numbers of this length be dialled locally
+	subscriberNumber := nationalSignificantNumber[areaCodeLength:]
+	metadata := getMetadataForRegion(GetRegionCodeForNumber(number))
+	if metadata == nil {
+		return ""
+	}
+	for _, length := range metadata.GetGeneralDesc().GetPossibleLengthLocalOnly() {
+		if int(length) == len(subscriberNumber) {
+			return subscriberNumber
+		}
+	}
+	return ""
+}
@@ -0,0 +1,214 @@
+package phonenumbers
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// the keywords which mark an extension in each language, these are matched case-insensitively
+// when parsing, in addition to the ones in EXTN_PATTERNS_FOR_PARSING
+var extensionKeywords = map[string][]string{
+	"cs": {"linka", "klapka", "kl."},
+	"de": {"durchwahl", "nebenstelle", "nst."},
+	"en": {"extension", "ext.", "ext"},
+	"es": {"extensión", "extension", "anexo", "interno"},
+	"fr": {"poste"},
+	"it": {"interno", "int."},
+	"ja": {"内線"},
+	"ko": {"내선"},
+	"nl": {"toestel", "tst."},
+	"pl": {"wewnętrzny", "wew."},
+	"pt": {"ramal"},
+	"ru": {"добавочный", "доб."},
+	"sv": {"anknytning", "ankn."},
+	"tr": {"dahili"},
+	"uk": {"додатковий", "дод."},
+	"zh": {"分机", "分機", "转", "轉"},
+}
+
+// the label put before an extension in each language when formatting
+var extensionLabels = map[string]string{
+	"cs": " linka ",
+	"de": " Durchwahl ",
+	"en": DEFAULT_EXTN_PREFIX,
+	"es": " ext. ",
+	"fr": " poste ",
+	"it": " int. ",
+	"ja": " 内線 ",
+	"ko": " 내선 ",
+	"nl": " toestel ",
+	"pl": " wew. ",
+	"pt": " ramal ",
+	"ru": " доб. ",
+	"sv": " ankn. ",
+	"tr": " dahili ",
+	"uk": " дод. ",
+	"zh": " 转 ",
+}
+
+var (
+	// guards the keywords and labels above, parsing doesn't take it as it only reads the
+	// compiled patterns
+	extensionsMutex sync.RWMutex
+
+	// the current *extensionPatterns, swapped out whenever keywords are registered
+	extensionPatternsValue atomic.Value
+)
+
+// like EXTN_PATTERN and VALID_PHONE_NUMBER_PATTERN, but including the registered keywords
+type extensionPatterns struct {
+	extn        *regexp.Regexp
+	validNumber *regexp.Regexp
+}
+
+func init() {
+	compileExtensionPatterns()
+}
+
+// RegisterExtensionKeywords adds keywords which mark an extension in the passed in language,
+// e.g. "durchwahl" for German. Keywords are matched case-insensitively when parsing, and can be
+// followed by an optional colon or full stop. The language is only used to group keywords, once
+// registered they're recognized when parsing numbers of every region, so registering a keyword
+// which is a word in another language affects the parsing of numbers from that language too.
+func RegisterExtensionKeywords(lang string, keywords ...string) {
+	extensionsMutex.Lock()
+	defer extensionsMutex.Unlock()
+
+	lang = normalizeLanguage(lang)
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword != "" {
+			extensionKeywords[lang] = append(extensionKeywords[lang], keyword)
+		}
+	}
+	compileExtensionPatterns()
+}
+
+// UnregisterExtensionKeywords removes keywords previously registered for the passed in language
+func UnregisterExtensionKeywords(lang string, keywords ...string) {
+	extensionsMutex.Lock()
+	defer extensionsMutex.Unlock()
+
+	lang = normalizeLanguage(lang)
+	remove := make(map[string]bool, len(keywords))
+	for _, keyword := range keywords {
+		remove[strings.ToLower(strings.TrimSpace(keyword))] = true
+	}
+
+	kept := make([]string, 0, len(extensionKeywords[lang]))
+	for _, keyword := range extensionKeywords[lang] {
+		if !remove[keyword] {
+			kept = append(kept, keyword)
+		}
+	}
+	if len(kept) > 0 {
+		extensionKeywords[lang] = kept
+	} else {
+		delete(extensionKeywords, lang)
+	}
+	compileExtensionPatterns()
+}
+
+// RegisterExtensionLabel sets the label put before an extension when formatting for the passed
+// in language, e.g. " Durchwahl " for German. The label should include any spacing required
+// between the number and the extension.
+func RegisterExtensionLabel(lang, label string) {
+	extensionsMutex.Lock()
+	defer extensionsMutex.Unlock()
+
+	extensionLabels[normalizeLanguage(lang)] = label
+}
+
+// UnregisterExtensionLabel removes the label for the passed in language, so that formatting falls
+// back to the base language or the default label
+func UnregisterExtensionLabel(lang string) {
+	extensionsMutex.Lock()
+	defer extensionsMutex.Unlock()
+
+	delete(extensionLabels, normalizeLanguage(lang))
+}
+
+// GetExtensionKeywords returns the keywords which mark an extension in the passed in language
+func GetExtensionKeywords(lang string) []string {
+	extensionsMutex.RLock()
+	defer extensionsMutex.RUnlock()
+
+	keywords := extensionKeywords[normalizeLanguage(lang)]
+	if keywords == nil {
+		keywords = extensionKeywords[baseLanguage(lang)]
+	}
+	return append([]string(nil), keywords...)
+}
+
+// GetExtensionLabel returns the label put before an extension when formatting for the passed
+// in language, falling back to the base language for tags like pt-BR. Returns false if there
+// is no label for the language.
+func GetExtensionLabel(lang string) (string, bool) {
+	extensionsMutex.RLock()
+	defer extensionsMutex.RUnlock()
+
+	label, ok := extensionLabels[normalizeLanguage(lang)]
+	if !ok {
+		label, ok = extensionLabels[baseLanguage(lang)]
+	}
+	return label, ok
+}
+
+// returns the patterns we use to strip extensions and check whether a number is viable
+func getExtensionPatterns() (*regexp.Regexp, *regexp.Regexp) {
+	patterns := extensionPatternsValue.Load().(*extensionPatterns)
+	return patterns.extn, patterns.validNumber
+}
+
+// rebuilds our extension patterns from the registered keywords, must be called with the lock held
+func compileExtensionPatterns() {
+	seen := make(map[string]bool)
+	keywords := make([]string, 0)
+	for _, langKeywords := range extensionKeywords {
+		for _, keyword := range langKeywords {
+			if !seen[keyword] {
+				seen[keyword] = true
+				keywords = append(keywords, keyword)
+			}
+		}
+	}
+
+	// longest first so that keywords which are prefixes of other keywords don't win, e.g. ext
+	// before extension
+	sort.Slice(keywords, func(i, j int) bool {
+		if len(keywords[i]) != len(keywords[j]) {
+			return len(keywords[i]) > len(keywords[j])
+		}
+		return keywords[i] < keywords[j]
+	})
+	for i, keyword := range keywords {
+		keywords[i] = regexp.QuoteMeta(keyword)
+	}
+
+	patterns := EXTN_PATTERNS_FOR_PARSING
+	if len(keywords) > 0 {
+		patterns += "|[ \u00A0\\t,]*(?i:" + strings.Join(keywords, "|") + ")" +
+			"[:\\.\uFF0E]?[ \u00A0\\t,-]*" + CAPTURING_EXTN_DIGITS + "#?"
+	}
+
+	extensionPatternsValue.Store(&extensionPatterns{
+		extn:        regexp.MustCompile("(?:" + patterns + ")$"),
+		validNumber: regexp.MustCompile("^(" + VALID_PHONE_NUMBER + "(?:" + patterns + ")?)$"),
+	})
+}
+
+func normalizeLanguage(lang string) string {
+	return strings.ToLower(strings.Replace(lang, "_", "-", -1))
+}
+
+// returns the language part of a tag like pt-BR
+func baseLanguage(lang string) string {
+	lang = normalizeLanguage(lang)
+	if dash := strings.IndexByte(lang, '-'); dash >= 0 {
+		return lang[:dash]
+	}
+	return lang
+}
@@ -0,0 +1,133 @@
+package phonenumbers
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// NumberingSystem is a system of digits numbers can be written in, named as in CLDR
+type NumberingSystem string
+
+const (
+	NUMBERING_LATN     NumberingSystem = "latn"     // ASCII digits 0123456789
+	NUMBERING_ARAB     NumberingSystem = "arab"     // Arabic-Indic digits ٠١٢٣٤٥٦٧٨٩
+	NUMBERING_ARABEXT  NumberingSystem = "arabext"  // Extended Arabic-Indic digits used for Persian and Urdu ۰۱۲۳۴۵۶۷۸۹
+	NUMBERING_DEVA     NumberingSystem = "deva"     // Devanagari digits ०१२३४५६७८९
+	NUMBERING_BENG     NumberingSystem = "beng"     // Bengali digits ০১২৩৪৫৬৭৮৯
+	NUMBERING_THAI     NumberingSystem = "thai"     // Thai digits ๐๑๒๓๔๕๖๗๘๙
+	NUMBERING_MYMR     NumberingSystem = "mymr"     // Myanmar digits ၀၁၂၃၄၅၆၇၈၉
+	NUMBERING_FULLWIDE NumberingSystem = "fullwide" // Full-width digits ０１２３４５６７８９
+)
+
+// the zero digit of each numbering system, the other digits follow it
+var numberingSystemZeros = map[NumberingSystem]rune{
+	NUMBERING_LATN:     '0',
+	NUMBERING_ARAB:     '٠',
+	NUMBERING_ARABEXT:  '۰',
+	NUMBERING_DEVA:     '०',
+	NUMBERING_BENG:     '০',
+	NUMBERING_THAI:     '๐',
+	NUMBERING_MYMR:     '၀',
+	NUMBERING_FULLWIDE: '０',
+}
+
+// BidiWrapping is how a formatted number is wrapped with bidi control characters so that it
+// displays correctly when embedded in right-to-left text
+type BidiWrapping int
+
+const (
+	// BIDI_NONE doesn't add any control characters
+	BIDI_NONE BidiWrapping = iota
+	// BIDI_ISOLATE wraps the number in a left-to-right isolate (LRI ... PDI)
+	BIDI_ISOLATE
+	// BIDI_MARKS wraps the number in left-to-right marks (LRM ... LRM), for renderers which
+	// don't support isolates
+	BIDI_MARKS
+)
+
+// the bidi control characters numbers can be wrapped with
+const (
+	LEFT_TO_RIGHT_MARK      = '\u200E'
+	LEFT_TO_RIGHT_ISOLATE   = '\u2066'
+	POP_DIRECTIONAL_ISOLATE = '\u2069'
+)
+
+// FormatOptions are the options for formatting a number with FormatWithOptions
+type FormatOptions struct {
+	// Language is the language extension labels are written in, e.g. "de"
+	Language string
+
+	// ExtensionLabel is the label put before an extension, overriding the one for Language
+	ExtensionLabel string
+
+	// NumberingSystem is the digits the number is written in, defaulting to ASCII digits
+	NumberingSystem NumberingSystem
+
+	// Bidi is how the number is wrapped for embedding in right-to-left text
+	Bidi BidiWrapping
+}
+
+// FormatWithOptions formats the passed in number like Format, but according to the passed in
+// options.
+//
+// Extensions are labelled with the extension label, or the registered label for the language.
+// If neither is given, the extension is labelled as it is by Format. Extensions are always
+// written as ";ext=" in RFC3966 format and are never included in E164 format.
+//
+// Digits are written in the given numbering system, and the result wrapped with bidi control
+// characters if requested. Neither applies to RFC3966 format, which is always ASCII.
+func FormatWithOptions(number *PhoneNumber, numberFormat PhoneNumberFormat, opts FormatOptions) string {
+	formatted := formatWithExtensionLabel(number, numberFormat, opts)
+	if numberFormat == RFC3966 {
+		return formatted
+	}
+	return wrapBidi(TransliterateDigits(formatted, opts.NumberingSystem), opts.Bidi)
+}
+
+// formats the passed in number with its extension labelled according to the passed in options
+func formatWithExtensionLabel(number *PhoneNumber, numberFormat PhoneNumberFormat, opts FormatOptions) string {
+	label := opts.ExtensionLabel
+	if label == "" && opts.Language != "" {
+		label, _ = GetExtensionLabel(opts.Language)
+	}
+	if number.GetExtension() == "" || label == "" || numberFormat == E164 || numberFormat == RFC3966 {
+		return Format(number, numberFormat)
+	}
+
+	withoutExtension := &PhoneNumber{}
+	proto.Merge(withoutExtension, number)
+	withoutExtension.Extension = nil
+
+	return Format(withoutExtension, numberFormat) + label + number.GetExtension()
+}
+
+// TransliterateDigits replaces the digits in the passed in text, in any of the numbering systems
+// we support, with those of the passed in numbering system. Unknown numbering systems are
+// treated as ASCII.
+func TransliterateDigits(text string, system NumberingSystem) string {
+	zero, ok := numberingSystemZeros[system]
+	if !ok {
+		zero = numberingSystemZeros[NUMBERING_LATN]
+	}
+
+	return strings.Map(func(r rune) rune {
+		for _, otherZero := range numberingSystemZeros {
+			if r >= otherZero && r <= otherZero+9 {
+				return zero + (r - otherZero)
+			}
+		}
+		return r
+	}, text)
+}
+
+// wraps the passed in formatted number with bidi control characters
+func wrapBidi(formatted string, bidi BidiWrapping) string {
+	switch bidi {
+	case BIDI_ISOLATE:
+		return string(LEFT_TO_RIGHT_ISOLATE) + formatted + string(POP_DIRECTIONAL_ISOLATE)
+	case BIDI_MARKS:
+		return string(LEFT_TO_RIGHT_MARK) + formatted + string(LEFT_TO_RIGHT_MARK)
+	}
+	return formatted
+}
@@ -0,0 +1,295 @@
+package phonenumbers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFormatTemplate is returned when compiling a format template with invalid syntax
+var ErrInvalidFormatTemplate = errors.New("invalid format template")
+
+// a placeholder and what can follow the colon in it
+var formatTemplateFields = map[string]string{
+	"cc":     "",       // the country calling code
+	"np":     "",       // the national prefix
+	"mt":     "",       // the mobile token
+	"ndc":    "",       // the national destination code
+	"sn":     "groups", // the subscriber number
+	"nsn":    "groups", // the national significant number
+	"ext":    "",       // the extension
+	"groups": "sep",    // the groups the number is usually formatted in
+}
+
+// FormatTemplate is a compiled template for formatting numbers, see CompileFormatTemplate
+type FormatTemplate struct {
+	source   string
+	sections []*templateSection
+}
+
+// a run of a template which is either required, or optional if it was in square brackets
+type templateSection struct {
+	optional bool
+	parts    []*templatePart
+}
+
+// a part of a template, which is either literal text or a placeholder
+type templatePart struct {
+	literal   string
+	field     string
+	groups    []int // the length of each group, with 0 meaning the rest of the digits
+	separator []string
+}
+
+// CompileFormatTemplate compiles a template for formatting numbers, such as "+{cc} {ndc}-{sn}"
+// or "({ndc}) {sn:3-4}". Placeholders in braces are replaced with the parts of the number as
+// returned by Components:
+//
+//	{cc}      the country calling code
+//	{np}      the national prefix
+//	{mt}      the mobile token
+//	{ndc}     the national destination code
+//	{sn}      the subscriber number
+//	{nsn}     the national significant number
+//	{ext}     the extension
+//	{groups}  the groups the national significant number is usually formatted in
+//
+// The digits of {sn} and {nsn} can be grouped by giving the length of each group and the
+// separators between them, e.g. {sn:3-4} or {nsn:2 2 2 2}, where the last length can be * for
+// the rest of the digits. {groups} can be given a separator, e.g. {groups:.}, the default being
+// a space. Parts of a template in square brackets are optional, e.g. "[ ext. {ext}]", and are
+// left out if any of their placeholders are empty. Braces, brackets and backslashes can be
+// escaped with a backslash.
+func CompileFormatTemplate(template string) (*FormatTemplate, error) {
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%w %q: %s", ErrInvalidFormatTemplate, template, fmt.Sprintf(format, args...))
+	}
+
+	compiled := &FormatTemplate{source: template}
+	section := &templateSection{}
+	literal := &strings.Builder{}
+
+	flushLiteral := func() {
+		if literal.Len() > 0 {
+			section.parts = append(section.parts, &templatePart{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+	flushSection := func() {
+		flushLiteral()
+		if len(section.parts) > 0 {
+			compiled.sections = append(compiled.sections, section)
+		}
+	}
+
+	runes := []rune(template)
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case '\\':
+			if i+1 == len(runes) {
+				return nil, invalid("trailing backslash")
+			}
+			i++
+			literal.WriteRune(runes[i])
+		case '[':
+			if section.optional {
+				return nil, invalid("optional sections can't be nested")
+			}
+			flushSection()
+			section = &templateSection{optional: true}
+		case ']':
+			if !section.optional {
+				return nil, invalid("unexpected ]")
+			}
+			flushSection()
+			section = &templateSection{}
+		case '{':
+			end := strings.IndexRune(string(runes[i+1:]), '}')
+			if end < 0 {
+				return nil, invalid("unclosed {")
+			}
+			placeholder := string(runes[i+1:])[:end]
+			i += len([]rune(placeholder)) + 1
+
+			part, err := compileTemplatePlaceholder(placeholder)
+			if err != nil {
+				return nil, invalid("%s", err)
+			}
+			flushLiteral()
+			section.parts = append(section.parts, part)
+		case '}':
+			return nil, invalid("unexpected }")
+		default:
+			literal.WriteRune(r)
+		}
+	}
+	if section.optional {
+		return nil, invalid("unclosed [")
+	}
+	flushSection()
+
+	return compiled, nil
+}
+
+// MustCompileFormatTemplate is like CompileFormatTemplate but panics if the template is invalid
+func MustCompileFormatTemplate(template string) *FormatTemplate {
+	compiled, err := CompileFormatTemplate(template)
+	if err != nil {
+		panic(err)
+	}
+	return compiled
+}
+
+// compiles a placeholder like sn:3-4
+func compileTemplatePlaceholder(placeholder string) (*templatePart, error) {
+	name, spec, hasSpec := placeholder, "", false
+	if colon := strings.IndexByte(placeholder, ':'); colon >= 0 {
+		name, spec, hasSpec = placeholder[:colon], placeholder[colon+1:], true
+	}
+	specKind, ok := formatTemplateFields[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown placeholder {%s}", name)
+	}
+
+	part := &templatePart{field: name}
+	if !hasSpec {
+		if specKind == "sep" {
+			part.separator = []string{" "}
+		}
+		return part, nil
+	}
+
+	switch specKind {
+	case "sep":
+		part.separator = []string{spec}
+	case "groups":
+		// alternating group lengths and the separators between them, e.g. 3-4
+		for i := 0; ; {
+			j := i
+			for j < len(spec) && (isDigit(spec[j]) || spec[j] == '*') {
+				j++
+			}
+			if spec[i:j] == "*" {
+				part.groups = append(part.groups, 0)
+			} else if n, err := strconv.Atoi(spec[i:j]); err == nil && n > 0 {
+				part.groups = append(part.groups, n)
+			} else {
+				return nil, fmt.Errorf("invalid group length in {%s}", placeholder)
+			}
+			if j == len(spec) {
+				break
+			}
+
+			k := j
+			for k < len(spec) && !isDigit(spec[k]) && spec[k] != '*' {
+				k++
+			}
+			if k == len(spec) || part.groups[len(part.groups)-1] == 0 {
+				return nil, fmt.Errorf("invalid groups in {%s}", placeholder)
+			}
+			part.separator = append(part.separator, spec[j:k])
+			i = k
+		}
+	default:
+		return nil, fmt.Errorf("{%s} doesn't take any options", name)
+	}
+	return part, nil
+}
+
+// String returns the source of this template
+func (t *FormatTemplate) String() string {
+	return t.source
+}
+
+// Format formats the passed in number using this template. If the template can't be applied
+// to the number, for example because the number has no national destination code or its
+// subscriber number doesn't have as many digits as the template's groups, the number is
+// formatted in INTERNATIONAL format instead.
+func (t *FormatTemplate) Format(number *PhoneNumber) string {
+	if !hasValidCountryCallingCode(int(number.GetCountryCode())) {
+		return Format(number, INTERNATIONAL)
+	}
+
+	components := Components(number)
+	formatted := &strings.Builder{}
+	for _, section := range t.sections {
+		rendered, ok := section.render(components)
+		if !ok {
+			if section.optional {
+				continue
+			}
+			return Format(number, INTERNATIONAL)
+		}
+		formatted.WriteString(rendered)
+	}
+	return formatted.String()
+}
+
+// renders this section, returning false if any of its placeholders are empty
+func (s *templateSection) render(components *NumberComponents) (string, bool) {
+	rendered := &strings.Builder{}
+	for _, part := range s.parts {
+		if part.field == "" {
+			rendered.WriteString(part.literal)
+			continue
+		}
+
+		var value string
+		switch part.field {
+		case "cc":
+			value = strconv.Itoa(int(components.CountryCode))
+		case "np":
+			value = components.NationalPrefix
+		case "mt":
+			value = components.MobileToken
+		case "ndc":
+			value = components.NationalDestinationCode
+		case "sn":
+			value = components.SubscriberNumber
+		case "nsn":
+			value = components.NationalSignificantNumber()
+		case "ext":
+			value = components.Extension
+		case "groups":
+			value = strings.Join(components.Groups, part.separator[0])
+		}
+
+		if len(part.groups) > 0 {
+			var ok bool
+			if value, ok = part.applyGroups(value); !ok {
+				return "", false
+			}
+		}
+		if value == "" {
+			return "", false
+		}
+		rendered.WriteString(value)
+	}
+	return rendered.String(), true
+}
+
+// splits the passed in digits into this part's groups, returning false if the number of digits
+// doesn't match the groups
+func (p *templatePart) applyGroups(digits string) (string, bool) {
+	grouped := &strings.Builder{}
+	for i, length := range p.groups {
+		if length == 0 {
+			length = len(digits)
+			if length == 0 {
+				return "", false
+			}
+		} else if length > len(digits) {
+			return "", false
+		}
+		if i > 0 {
+			grouped.WriteString(p.separator[i-1])
+		}
+		grouped.WriteString(digits[:length])
+		digits = digits[length:]
+	}
+	if digits != "" {
+		return "", false
+	}
+	return grouped.String(), true
+}
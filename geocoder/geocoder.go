@@ -0,0 +1,43 @@
+// Package geocoder maps phone numbers to the location they were originally allocated in.
+//
+// The geocoding data is large, so it lives in this package rather than in phonenumbers itself
+// and is only linked into programs which import it.
+package geocoder
+
+import (
+	"embed"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/nyaruka/phonenumbers/internal/prefixmap"
+)
+
+// our gzipped prefix maps, one file per language
+//
+//go:embed data/*.gz
+var geocodingMapData embed.FS
+
+// our prefix maps, one per language, decoded on first use
+var geocodingMaps = prefixmap.NewLanguageMaps(geocodingMapData, "data")
+
+func init() {
+	prefixmap.GeocodingForE164 = geocodingForE164
+}
+
+// GetGeocodingForNumber returns the location we think the number was first acquired in. This is
+// just our best guess, there is no guarantee to its accuracy.
+func GetGeocodingForNumber(number *phonenumbers.PhoneNumber, lang string) (string, error) {
+	return geocodingForE164(phonenumbers.Format(number, phonenumbers.E164), lang)
+}
+
+func geocodingForE164(e164 string, lang string) (string, error) {
+	geocoding, err := geocodingMaps.Lookup(lang, 10, e164)
+	if err != nil {
+		return "", err
+	}
+	if geocoding != "" {
+		return geocoding, nil
+	}
+
+	// fallback to english
+	return geocodingMaps.Lookup("en", 10, e164)
+}
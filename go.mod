@@ -2,4 +2,4 @@ module github.com/nyaruka/phonenumbers
 
 require github.com/golang/protobuf v1.3.2
 
-go 1.13
+go 1.16
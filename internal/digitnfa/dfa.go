@@ -0,0 +1,226 @@
+package digitnfa
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrTooManyStates is returned when a DFA would need more states than allowed
+var ErrTooManyStates = errors.New("too many states")
+
+// ErrTooManyConstraints is returned when building a DFA from more constraints than fit in a mask
+var ErrTooManyConstraints = errors.New("too many constraints")
+
+// ErrInvalidDFA is returned when decoding data which isn't an encoded DFA
+var ErrInvalidDFA = errors.New("invalid DFA")
+
+// DFA is a deterministic automaton over the digits 0-9 built from a list of constraints, which
+// tells us which of the constraints a number satisfies in a single pass over its digits
+type DFA struct {
+	// Transitions is the next state for each state and digit. State 0 is the dead state which
+	// all transitions lead to once no constraint can be satisfied, and state 1 is the start.
+	Transitions [][10]uint32
+
+	// Accepts is a mask for each state of which constraints are satisfied by a number ending in
+	// that state, where bit i is set for the i-th constraint
+	Accepts []uint64
+}
+
+// the state of a DFA while we build it, the threads of each constraint and which are satisfied
+type dfaState struct {
+	threads [][]uint32
+	accepts uint64
+}
+
+// Determinize builds a DFA from the passed in constraints, returning ErrTooManyStates if it would
+// have more than maxStates states
+func Determinize(maxStates int, constraints ...Constraint) (*DFA, error) {
+	if len(constraints) > 64 {
+		return nil, ErrTooManyConstraints
+	}
+
+	start := dfaState{threads: make([][]uint32, len(constraints))}
+	for i, c := range constraints {
+		start.advance(i, c, c.Machine.start)
+	}
+
+	dfa := &DFA{Transitions: make([][10]uint32, 1), Accepts: make([]uint64, 1)}
+	ids := make(map[string]uint32)
+	states := make([]dfaState, 1)
+	add := func(s dfaState) (uint32, error) {
+		key := s.key()
+		if id, found := ids[key]; found {
+			return id, nil
+		}
+		if len(states) >= maxStates {
+			return 0, ErrTooManyStates
+		}
+		id := uint32(len(states))
+		ids[key] = id
+		states = append(states, s)
+		dfa.Transitions = append(dfa.Transitions, [10]uint32{})
+		dfa.Accepts = append(dfa.Accepts, s.accepts)
+		return id, nil
+	}
+	if _, err := add(start); err != nil {
+		return nil, err
+	}
+
+	// states are appended as we find them so this visits every state once
+	for id := 1; id < len(states); id++ {
+		for d := 0; d < 10; d++ {
+			next := dfaState{threads: make([][]uint32, len(constraints))}
+			for i, c := range constraints {
+				threads := states[id].threads[i]
+				if len(threads) == 1 && threads[0] == done {
+					next.threads[i] = threads
+					next.accepts |= 1 << uint(i)
+					continue
+				}
+
+				merged := step{}
+				for _, pc := range threads {
+					s := c.Machine.next(pc, d)
+					merged.threads = append(merged.threads, s.threads...)
+					merged.matchEnd = merged.matchEnd || s.matchEnd
+					merged.matchMid = merged.matchMid || s.matchMid
+				}
+				next.advance(i, c, merged)
+			}
+			if next.isDead() {
+				continue
+			}
+
+			nextID, err := add(next)
+			if err != nil {
+				return nil, err
+			}
+			dfa.Transitions[id][d] = nextID
+		}
+	}
+	return dfa, nil
+}
+
+// sets the threads of the i-th constraint from the step it just took
+func (s *dfaState) advance(i int, c Constraint, st step) {
+	if c.Prefix && st.matchMid {
+		s.threads[i] = []uint32{done}
+		s.accepts |= 1 << uint(i)
+		return
+	}
+	if st.matchEnd {
+		s.accepts |= 1 << uint(i)
+	}
+	s.threads[i] = uniqueThreads(st.threads)
+}
+
+// returns whether no constraint can be satisfied from this state or any state after it
+func (s *dfaState) isDead() bool {
+	if s.accepts != 0 {
+		return false
+	}
+	for _, threads := range s.threads {
+		if len(threads) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *dfaState) key() string {
+	parts := make([]string, len(s.threads)+1)
+	for i, threads := range s.threads {
+		parts[i] = stateKey(threads)
+	}
+	parts[len(s.threads)] = strconv.FormatUint(s.accepts, 16)
+	return strings.Join(parts, "|")
+}
+
+// returns a copy of the passed in threads sorted and without duplicates
+func uniqueThreads(threads []uint32) []uint32 {
+	sorted := append([]uint32(nil), threads...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	unique := sorted[:0]
+	for i, pc := range sorted {
+		if i == 0 || pc != sorted[i-1] {
+			unique = append(unique, pc)
+		}
+	}
+	return unique
+}
+
+// Match returns the mask of which constraints the passed in number satisfies, returning false if
+// the number contains anything other than the digits 0-9
+func (d *DFA) Match(number string) (uint64, bool) {
+	state := uint32(1)
+	for i := 0; i < len(number); i++ {
+		c := number[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		state = d.Transitions[state][c-'0']
+	}
+	return d.Accepts[state], true
+}
+
+// MarshalBinary encodes the DFA as the number of states, then for each state its accepts mask
+// and its transitions, all as uvarints
+func (d *DFA) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, len(d.Transitions)*12)
+	buf := make([]byte, binary.MaxVarintLen64)
+	write := func(v uint64) {
+		data = append(data, buf[:binary.PutUvarint(buf, v)]...)
+	}
+
+	write(uint64(len(d.Transitions)))
+	for s := range d.Transitions {
+		write(d.Accepts[s])
+		for _, next := range d.Transitions[s] {
+			write(uint64(next))
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes a DFA encoded by MarshalBinary
+func (d *DFA) UnmarshalBinary(data []byte) error {
+	read := func() (uint64, error) {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, ErrInvalidDFA
+		}
+		data = data[n:]
+		return v, nil
+	}
+
+	count, err := read()
+	if err != nil || count < 2 || count > uint64(len(data)) {
+		return ErrInvalidDFA
+	}
+	transitions := make([][10]uint32, count)
+	accepts := make([]uint64, count)
+	for s := range transitions {
+		if accepts[s], err = read(); err != nil {
+			return err
+		}
+		for digit := range transitions[s] {
+			next, err := read()
+			if err != nil {
+				return err
+			}
+			if next >= count {
+				return ErrInvalidDFA
+			}
+			transitions[s][digit] = uint32(next)
+		}
+	}
+	if len(data) > 0 || accepts[0] != 0 || transitions[0] != [10]uint32{} {
+		return ErrInvalidDFA
+	}
+
+	d.Transitions, d.Accepts = transitions, accepts
+	return nil
+}
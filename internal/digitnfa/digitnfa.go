@@ -0,0 +1,255 @@
+// Package digitnfa compiles the regular expressions used in phone number metadata to NFAs
+// over the digits 0-9, which lets us answer questions about the set of numbers a pattern
+// can match (which lengths, whether two patterns overlap) without enumerating every number.
+package digitnfa
+
+import (
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// noRune is used as the context before the first and after the last digit of a number
+const noRune = -1
+
+// anyDigit is used as the context when we only know that a digit is coming next, all digits
+// are word characters so the choice of digit doesn't change how empty width ops behave
+const anyDigit = '0'
+
+// step is the result of following a thread through a single digit
+type step struct {
+	threads  []uint32 // the rune instructions we can reach if more digits follow
+	matchEnd bool     // whether we reach a match if the number ends here
+	matchMid bool     // whether we reach a match if more digits follow
+}
+
+// Machine is a regular expression compiled to an NFA over the digits 0-9. Threads are
+// identified by the index of the rune instruction they are waiting on.
+type Machine struct {
+	prog  *syntax.Prog
+	start step
+	steps map[uint32]*[10]step
+}
+
+// Compile compiles the passed in pattern to a machine, the pattern is not anchored so callers
+// should wrap it in ^(?:...)$ if they want it to match the whole number
+func Compile(pattern string) (*Machine, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Machine{prog: prog, steps: make(map[uint32]*[10]step)}
+	m.start = m.closure([]uint32{uint32(prog.Start)}, noRune)
+	return m, nil
+}
+
+// closure follows the empty transitions from the passed in instructions, where before is the
+// rune just consumed (or noRune if we are at the start of the number)
+func (m *Machine) closure(pcs []uint32, before rune) step {
+	// an item is an instruction along with whether we got to it by assuming the number ends
+	// here (end) or that more digits follow (mid)
+	type item struct {
+		pc       uint32
+		end, mid bool
+	}
+
+	items := make([]item, 0, len(pcs))
+	for _, pc := range pcs {
+		items = append(items, item{pc: pc})
+	}
+
+	result := step{}
+	visited := make(map[item]bool)
+	added := make(map[uint32]bool)
+
+	for len(items) > 0 {
+		it := items[len(items)-1]
+		items = items[:len(items)-1]
+		if visited[it] {
+			continue
+		}
+		visited[it] = true
+
+		inst := &m.prog.Inst[it.pc]
+		switch inst.Op {
+		case syntax.InstAlt, syntax.InstAltMatch:
+			items = append(items, item{inst.Out, it.end, it.mid}, item{inst.Arg, it.end, it.mid})
+		case syntax.InstCapture, syntax.InstNop:
+			items = append(items, item{inst.Out, it.end, it.mid})
+		case syntax.InstEmptyWidth:
+			if !it.mid && inst.MatchEmptyWidth(before, noRune) {
+				items = append(items, item{inst.Out, true, false})
+			}
+			if !it.end && inst.MatchEmptyWidth(before, anyDigit) {
+				items = append(items, item{inst.Out, false, true})
+			}
+		case syntax.InstMatch:
+			result.matchEnd = result.matchEnd || !it.mid
+			result.matchMid = result.matchMid || !it.end
+		case syntax.InstFail:
+		default:
+			// threads which assumed the number ends here can't consume any more digits
+			if !it.end && !added[it.pc] {
+				added[it.pc] = true
+				result.threads = append(result.threads, it.pc)
+			}
+		}
+	}
+
+	sort.Slice(result.threads, func(i, j int) bool { return result.threads[i] < result.threads[j] })
+	return result
+}
+
+// next returns the result of the thread waiting on the instruction at pc consuming digit
+func (m *Machine) next(pc uint32, digit int) *step {
+	steps, found := m.steps[pc]
+	if !found {
+		steps = &[10]step{}
+		inst := &m.prog.Inst[pc]
+		for d := 0; d < 10; d++ {
+			r := rune('0' + d)
+			if inst.MatchRune(r) {
+				steps[d] = m.closure([]uint32{inst.Out}, r)
+			}
+		}
+		m.steps[pc] = steps
+	}
+	return &steps[digit]
+}
+
+// MatchableLengths returns all the lengths up to maxLength for which there is at least one
+// string of digits which the machine matches completely
+func (m *Machine) MatchableLengths(maxLength int) []int {
+	lengths := make([]int, 0)
+	threads := m.start.threads
+	for length := 1; length <= maxLength && len(threads) > 0; length++ {
+		next := make(map[uint32]bool)
+		matched := false
+		for _, pc := range threads {
+			for d := 0; d < 10; d++ {
+				s := m.next(pc, d)
+				matched = matched || s.matchEnd
+				for _, t := range s.threads {
+					next[t] = true
+				}
+			}
+		}
+		if matched {
+			lengths = append(lengths, length)
+		}
+
+		threads = make([]uint32, 0, len(next))
+		for pc := range next {
+			threads = append(threads, pc)
+		}
+	}
+	return lengths
+}
+
+// Constraint is a machine along with how it must match in an intersection
+type Constraint struct {
+	Machine *Machine
+
+	// Prefix is true if the machine only needs to match a prefix of the number, as is the
+	// case for leading digits patterns
+	Prefix bool
+}
+
+// done is the thread for a prefix constraint which has already matched
+const done = ^uint32(0)
+
+// Intersects returns whether there is a string of digits no longer than maxLength which
+// satisfies all the passed in constraints
+func Intersects(maxLength int, constraints ...Constraint) bool {
+	if len(constraints) == 0 {
+		return true
+	}
+
+	// the threads for each constraint at the start of the number
+	initial := make([][]uint32, len(constraints))
+	for i, c := range constraints {
+		if c.Prefix && c.Machine.start.matchMid {
+			initial[i] = []uint32{done}
+		} else {
+			initial[i] = c.Machine.start.threads
+		}
+	}
+
+	visited := make(map[string]bool)
+	current := product(initial, visited)
+	for length := 1; length <= maxLength && len(current) > 0; length++ {
+		next := make([][]uint32, 0)
+		for _, state := range current {
+			for d := 0; d < 10; d++ {
+				options := make([][]uint32, len(constraints))
+				accepts := true
+				for i, pc := range state {
+					c := constraints[i]
+					if pc == done {
+						options[i] = []uint32{done}
+						continue
+					}
+
+					s := c.Machine.next(pc, d)
+					if c.Prefix && (s.matchMid || s.matchEnd) {
+						options[i] = []uint32{done}
+						continue
+					}
+
+					accepts = accepts && s.matchEnd
+					options[i] = s.threads
+				}
+
+				if accepts {
+					return true
+				}
+				next = append(next, product(options, visited)...)
+			}
+		}
+		current = next
+	}
+	return false
+}
+
+// product returns every combination of the passed in thread options which we haven't yet visited
+func product(options [][]uint32, visited map[string]bool) [][]uint32 {
+	states := [][]uint32{{}}
+	for _, opts := range options {
+		if len(opts) == 0 {
+			return nil
+		}
+		expanded := make([][]uint32, 0, len(states)*len(opts))
+		for _, state := range states {
+			for _, pc := range opts {
+				s := make([]uint32, len(state), len(state)+1)
+				copy(s, state)
+				expanded = append(expanded, append(s, pc))
+			}
+		}
+		states = expanded
+	}
+
+	unvisited := states[:0]
+	for _, state := range states {
+		key := stateKey(state)
+		if !visited[key] {
+			visited[key] = true
+			unvisited = append(unvisited, state)
+		}
+	}
+	return unvisited
+}
+
+func stateKey(state []uint32) string {
+	parts := make([]string, len(state))
+	for i, pc := range state {
+		parts[i] = strconv.FormatUint(uint64(pc), 10)
+	}
+	return strings.Join(parts, ",")
+}
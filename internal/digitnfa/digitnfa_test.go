@@ -0,0 +1,127 @@
+package digitnfa
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatchableLengths(t *testing.T) {
+	tests := []struct {
+		pattern   string
+		maxLength int
+		expected  []int
+	}{
+		{pattern: `^(?:\d{3})$`, maxLength: 17, expected: []int{3}},
+		{pattern: `^(?:[13]\d{6}(?:\d{2,5})?|285\d{9}|[19]\d{7})$`, maxLength: 17, expected: []int{7, 8, 9, 10, 11, 12}},
+		{pattern: `^(?:[13]\d{6}(?:\d{2,5})?|285\d{9}|[19]\d{7})$`, maxLength: 9, expected: []int{7, 8, 9}},
+		{pattern: `^(?:80[08]\d{7}|800\d{6}|8001111)$`, maxLength: 17, expected: []int{7, 9, 10}},
+		{pattern: `^(?:1(?:2$|34))$`, maxLength: 17, expected: []int{2, 3}},
+		{pattern: `^(?:\d*)$`, maxLength: 5, expected: []int{1, 2, 3, 4, 5}},
+		{pattern: `^(?:a\d)$`, maxLength: 17, expected: []int{}},
+	}
+	for i, test := range tests {
+		m, err := Compile(test.pattern)
+		if err != nil {
+			t.Errorf("[test %d] unexpected error compiling %s: %s", i, test.pattern, err)
+			continue
+		}
+		if lengths := m.MatchableLengths(test.maxLength); !reflect.DeepEqual(lengths, test.expected) {
+			t.Errorf("[test %d] expected lengths %v for %s, got %v", i, test.expected, test.pattern, lengths)
+		}
+	}
+
+	if _, err := Compile(`[`); err == nil {
+		t.Errorf("expected error compiling invalid pattern")
+	}
+}
+
+func TestIntersects(t *testing.T) {
+	tests := []struct {
+		full     []string
+		prefix   []string
+		expected bool
+	}{
+		{full: []string{`^(?:2\d{3})$`}, prefix: []string{`^(?:3)`}, expected: false},
+		{full: []string{`^(?:2\d{3})$`}, prefix: []string{`^(?:2[0-5])`}, expected: true},
+		{full: []string{`^(?:2\d{3})$`, `^(?:\d{5})$`}, expected: false},
+		{full: []string{`^(?:[2-4]\d{3})$`, `^(?:[4-6]\d{3})$`}, prefix: []string{`^(?:4[19])`, `^(?:49)`}, expected: true},
+		{full: []string{`^(?:[2-4]\d{3})$`, `^(?:[4-6]\d{3})$`}, prefix: []string{`^(?:4[19])`, `^(?:48)`}, expected: false},
+		{full: []string{`^(?:1\d|2)$`}, prefix: []string{`^(?:2)`}, expected: true},
+		{prefix: []string{`^(?:)`}, expected: true},
+	}
+	for i, test := range tests {
+		constraints := make([]Constraint, 0)
+		for _, p := range test.full {
+			m, _ := Compile(p)
+			constraints = append(constraints, Constraint{Machine: m})
+		}
+		for _, p := range test.prefix {
+			m, _ := Compile(p)
+			constraints = append(constraints, Constraint{Machine: m, Prefix: true})
+		}
+		if result := Intersects(17, constraints...); result != test.expected {
+			t.Errorf("[test %d] expected intersects to be %t, got %t", i, test.expected, result)
+		}
+	}
+}
+
+func TestDeterminize(t *testing.T) {
+	full := []string{`^(?:[2-4]\d{3})$`, `^(?:4\d{3}|5\d{2})$`, `^(?:)$`}
+	prefix := []string{`^(?:4[19])`, `^(?:1(?:2$|34))`}
+
+	constraints := make([]Constraint, 0)
+	for _, p := range full {
+		m, _ := Compile(p)
+		constraints = append(constraints, Constraint{Machine: m})
+	}
+	for _, p := range prefix {
+		m, _ := Compile(p)
+		constraints = append(constraints, Constraint{Machine: m, Prefix: true})
+	}
+
+	dfa, err := Determinize(100, constraints...)
+	if err != nil {
+		t.Fatalf("unexpected error determinizing: %s", err)
+	}
+
+	// should survive being encoded and decoded
+	encoded, _ := dfa.MarshalBinary()
+	decoded := &DFA{}
+	if err := decoded.UnmarshalBinary(encoded); err != nil {
+		t.Fatalf("unexpected error decoding: %s", err)
+	}
+	if !reflect.DeepEqual(decoded, dfa) {
+		t.Errorf("decoded DFA doesn't match encoded DFA")
+	}
+
+	tests := []struct {
+		number   string
+		expected uint64
+		valid    bool
+	}{
+		{"", 0b00100, true},
+		{"2000", 0b00001, true},
+		{"4100", 0b01011, true},
+		{"4200", 0b00011, true},
+		{"419", 0b01000, true},
+		{"500", 0b00010, true},
+		{"5000", 0b00000, true},
+		{"12", 0b10000, true},
+		{"123", 0b00000, true},
+		{"1345", 0b10000, true},
+		{"41a", 0, false},
+	}
+	for i, test := range tests {
+		mask, valid := decoded.Match(test.number)
+		if mask != test.expected || valid != test.valid {
+			t.Errorf("[test %d] expected %05b %t for %s, got %05b %t", i, test.expected, test.valid, test.number, mask, valid)
+		}
+	}
+
+	if _, err := Determinize(3, constraints...); err != ErrTooManyStates {
+		t.Errorf("expected ErrTooManyStates, got %v", err)
+	}
+	if err := decoded.UnmarshalBinary(encoded[:len(encoded)-1]); err != ErrInvalidDFA {
+		t.Errorf("expected ErrInvalidDFA, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package prefixmap
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// LanguageMaps is a set of prefix maps, one per language, which are only decoded the
+// first time they are looked up
+type LanguageMaps struct {
+	files embed.FS
+	dir   string
+	onces map[string]*sync.Once
+	maps  map[string]*IntStringMap
+	mutex sync.RWMutex
+}
+
+// NewLanguageMaps creates a new set of language maps from the gzipped files in the passed in
+// directory, which are named by their language, e.g. en.gz
+func NewLanguageMaps(files embed.FS, dir string) *LanguageMaps {
+	entries, err := files.ReadDir(dir)
+	if err != nil {
+		panic(err)
+	}
+
+	onces := make(map[string]*sync.Once, len(entries))
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), ".gz") {
+			onces[strings.TrimSuffix(entry.Name(), ".gz")] = &sync.Once{}
+		}
+	}
+	return &LanguageMaps{
+		files: files,
+		dir:   dir,
+		onces: onces,
+		maps:  make(map[string]*IntStringMap, len(onces)),
+	}
+}
+
+// Lookup returns the value of the longest prefix of the passed in E164 number (up to
+// maxLength characters including the +) in the map for the passed in language. An empty
+// string is returned if we have no data for that language or no prefix matched.
+func (l *LanguageMaps) Lookup(language string, maxLength int, e164 string) (string, error) {
+	// do we have data for this language
+	once, existing := l.onces[language]
+	if !existing {
+		return "", nil
+	}
+
+	// load it into our map
+	once.Do(func() {
+		data, err := l.files.ReadFile(path.Join(l.dir, language+".gz"))
+		if err != nil {
+			return
+		}
+		prefixMap, err := LoadPrefixMap(data)
+		if err == nil {
+			l.mutex.Lock()
+			l.maps[language] = prefixMap
+			l.mutex.Unlock()
+		}
+	})
+
+	// do we have a map for this language?
+	l.mutex.RLock()
+	prefixMap, ok := l.maps[language]
+	l.mutex.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("error loading language map for %s", language)
+	}
+
+	length := len(e164)
+	if maxLength > length {
+		maxLength = length
+	}
+	for i := maxLength; i > 1; i-- {
+		index, err := strconv.Atoi(e164[0:i])
+		if err != nil {
+			return "", err
+		}
+		if value, has := prefixMap.Map[index]; has {
+			return value, nil
+		}
+	}
+	return "", nil
+}
@@ -1,24 +1,27 @@
-package phonenumbers
+// Package prefixmap contains the decoding of the binary prefix maps which are generated by
+// buildmetadata and shared between phonenumbers and its carrier, geocoder and timezone packages.
+package prefixmap
 
 import (
 	"bytes"
 	"compress/gzip"
-	"encoding/base64"
 	"encoding/binary"
 	fmt "fmt"
 	"io/ioutil"
 	"strings"
+	"sync"
 )
 
-// intStringMap is our data structure for maps from prefixes to a single string
+// IntStringMap is our data structure for maps from prefixes to a single string
 // this is used for our carrier and geocoding maps
-type intStringMap struct {
+type IntStringMap struct {
 	Map       map[int]string
 	MaxLength int
 }
 
-func loadPrefixMap(data string) (*intStringMap, error) {
-	rawBytes, err := decodeUnzipString(data)
+// LoadPrefixMap decodes a prefix map of single string values
+func LoadPrefixMap(data []byte) (*IntStringMap, error) {
+	rawBytes, err := DecodeUnzip(data)
 	if err != nil {
 		return nil, err
 	}
@@ -75,21 +78,22 @@ func loadPrefixMap(data string) (*intStringMap, error) {
 	}
 
 	// return our values
-	return &intStringMap{
+	return &IntStringMap{
 		Map:       mappings,
 		MaxLength: maxLength,
 	}, nil
 }
 
-// intStringArrayMap is our map from an int to an array of strings
+// IntStringArrayMap is our map from an int to an array of strings
 // this is used for our timezone and region maps
-type intStringArrayMap struct {
+type IntStringArrayMap struct {
 	Map       map[int][]string
 	MaxLength int
 }
 
-func loadIntStringArrayMap(data string) (*intStringArrayMap, error) {
-	rawBytes, err := decodeUnzipString(data)
+// LoadIntStringArrayMap decodes a map from an int to an array of strings
+func LoadIntStringArrayMap(data []byte) (*IntStringArrayMap, error) {
+	rawBytes, err := DecodeUnzip(data)
 	if err != nil {
 		return nil, err
 	}
@@ -154,22 +158,31 @@ func loadIntStringArrayMap(data string) (*intStringArrayMap, error) {
 	}
 
 	// return our values
-	return &intStringArrayMap{
+	return &IntStringArrayMap{
 		Map:       mappings,
 		MaxLength: maxLength,
 	}, nil
 }
 
-func decodeUnzipString(data string) ([]byte, error) {
-	decodedBytes, err := base64.StdEncoding.DecodeString(data)
-	if err != nil {
-		return nil, err
-	}
+// gzip readers are expensive to create and we decode many small payloads, so we reuse them
+var zipReaders sync.Pool
 
-	zipReader, err := gzip.NewReader(bytes.NewReader(decodedBytes))
-	if err != nil {
-		return nil, err
+// DecodeUnzip decompresses the passed in gzipped data
+func DecodeUnzip(data []byte) ([]byte, error) {
+	var zipReader *gzip.Reader
+	if pooled, ok := zipReaders.Get().(*gzip.Reader); ok {
+		zipReader = pooled
+		if err := zipReader.Reset(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		zipReader, err = gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
 	}
+	defer zipReaders.Put(zipReader)
 
 	rawBytes, err := ioutil.ReadAll(zipReader)
 	if err != nil {
@@ -0,0 +1,15 @@
+package prefixmap
+
+// These hooks are set by the carrier, geocoder and timezone packages when they are imported,
+// and allow the deprecated lookup functions in the phonenumbers package to keep working
+// without phonenumbers itself linking in any of the prefix data.
+var (
+	// CarrierForE164 looks up the carrier for an E164 formatted number in the passed in language
+	CarrierForE164 func(e164 string, lang string) (string, error)
+
+	// GeocodingForE164 looks up the location for an E164 formatted number in the passed in language
+	GeocodingForE164 func(e164 string, lang string) (string, error)
+
+	// TimezonesForPrefix looks up the timezones for a number prefix
+	TimezonesForPrefix func(number string) ([]string, error)
+)
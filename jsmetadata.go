@@ -0,0 +1,232 @@
+package phonenumbers
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+)
+
+// JSMetadataFlavour is which of the metadata flavours of libphonenumber-js to build
+type JSMetadataFlavour int
+
+const (
+	// JS_METADATA_MIN is the smallest flavour, without the patterns for each number type
+	JS_METADATA_MIN JSMetadataFlavour = iota
+	// JS_METADATA_MAX includes the patterns for each number type
+	JS_METADATA_MAX
+)
+
+// the version of the libphonenumber-js compact metadata format we build
+const jsMetadataVersion = 4
+
+// the number types in the order libphonenumber-js expects them
+var jsMetadataTypes = []func(*PhoneMetadata) *PhoneNumberDesc{
+	(*PhoneMetadata).GetFixedLine,
+	(*PhoneMetadata).GetMobile,
+	(*PhoneMetadata).GetTollFree,
+	(*PhoneMetadata).GetPremiumRate,
+	(*PhoneMetadata).GetPersonalNumber,
+	(*PhoneMetadata).GetVoicemail,
+	(*PhoneMetadata).GetUan,
+	(*PhoneMetadata).GetPager,
+	(*PhoneMetadata).GetVoip,
+	(*PhoneMetadata).GetSharedCost,
+}
+
+// BuildJSMetadata builds the compact JSON metadata used by libphonenumber-js from the passed in
+// metadata collection, so that browsers and servers can validate numbers against exactly the
+// same metadata.
+func BuildJSMetadata(metadataCollection *PhoneMetadataCollection, flavour JSMetadataFlavour) ([]byte, error) {
+	callingCodes := make(map[string][]string)
+	for code, regions := range BuildCountryCodeToRegionMap(metadataCollection) {
+		callingCodes[strconv.Itoa(code)] = regions
+	}
+
+	countries := make(map[string][]interface{})
+	nonGeographic := make(map[string][]interface{})
+	for _, metadata := range metadataCollection.GetMetadata() {
+		if metadata.GetId() == REGION_CODE_FOR_NON_GEO_ENTITY {
+			nonGeographic[strconv.Itoa(int(metadata.GetCountryCode()))] = buildJSCountry(metadata, flavour)
+		} else {
+			countries[metadata.GetId()] = buildJSCountry(metadata, flavour)
+		}
+	}
+
+	output := &bytes.Buffer{}
+	encoder := json.NewEncoder(output)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(map[string]interface{}{
+		"version":               jsMetadataVersion,
+		"country_calling_codes": callingCodes,
+		"countries":             countries,
+		"nonGeographic":         nonGeographic,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(output.Bytes()), nil
+}
+
+// builds the array for a single region, which is made up of its calling code, IDD prefix,
+// national number pattern, possible lengths, formats, national prefix, national prefix
+// formatting rule, national prefix for parsing, national prefix transform rule, whether the
+// national prefix is optional when formatting, leading digits, types, default IDD prefix
+// and extension prefix
+func buildJSCountry(metadata *PhoneMetadata, flavour JSMetadataFlavour) []interface{} {
+	general := metadata.GetGeneralDesc()
+
+	// formats already carry the national prefix formatting rule and whether it is optional, so
+	// we don't need to include defaults for the whole region
+	country := []interface{}{
+		strconv.Itoa(int(metadata.GetCountryCode())),
+		jsString(metadata.GetInternationalPrefix()),
+		jsString(general.GetNationalNumberPattern()),
+		jsLengths(general.GetPossibleLength()),
+		buildJSFormats(metadata),
+		jsString(metadata.GetNationalPrefix()),
+		nil,
+		nil,
+		jsString(metadata.GetNationalPrefixTransformRule()),
+		nil,
+		jsString(metadata.GetLeadingDigits()),
+		nil,
+		jsString(metadata.GetPreferredInternationalPrefix()),
+		jsString(metadata.GetPreferredExtnPrefix()),
+	}
+
+	// libphonenumber-js falls back to the national prefix when there's no prefix for parsing
+	if prefix := metadata.GetNationalPrefixForParsing(); prefix != metadata.GetNationalPrefix() {
+		country[7] = jsString(prefix)
+	}
+
+	if flavour == JS_METADATA_MAX {
+		types := make([]interface{}, len(jsMetadataTypes))
+		for i, getDesc := range jsMetadataTypes {
+			types[i] = buildJSType(getDesc(metadata), general)
+		}
+		country[11] = trimJSArray(types)
+	}
+
+	return trimJSArray(country)
+}
+
+// builds the array for each format, made up of its pattern, format, leading digits patterns,
+// national prefix formatting rule, whether the national prefix is optional when formatting
+// and international format
+func buildJSFormats(metadata *PhoneMetadata) interface{} {
+	if len(metadata.GetNumberFormat()) == 0 {
+		return nil
+	}
+
+	// international formats are only present if at least one differs from its national format,
+	// formats which can't be used internationally have no international format at all
+	intlFormats := metadata.GetIntlNumberFormat()
+	nextIntl := 0
+
+	formats := make([]interface{}, 0, len(metadata.GetNumberFormat()))
+	for _, format := range metadata.GetNumberFormat() {
+		var intlFormat interface{}
+		if len(intlFormats) > 0 {
+			if nextIntl < len(intlFormats) && isSameJSFormat(format, intlFormats[nextIntl]) {
+				if intlFormats[nextIntl].GetFormat() != format.GetFormat() {
+					intlFormat = intlFormats[nextIntl].GetFormat()
+				}
+				nextIntl++
+			} else {
+				intlFormat = "NA"
+			}
+		}
+
+		var leadingDigits interface{}
+		if len(format.GetLeadingDigitsPattern()) > 0 {
+			leadingDigits = format.GetLeadingDigitsPattern()
+		}
+
+		formats = append(formats, trimJSArray([]interface{}{
+			format.GetPattern(),
+			format.GetFormat(),
+			leadingDigits,
+			jsString(format.GetNationalPrefixFormattingRule()),
+			jsBool(format.GetNationalPrefixOptionalWhenFormatting()),
+			intlFormat,
+		}))
+	}
+	return formats
+}
+
+// whether the passed in international format is for the same numbers as the national format
+func isSameJSFormat(format *NumberFormat, intlFormat *NumberFormat) bool {
+	return format.GetPattern() == intlFormat.GetPattern() &&
+		reflect.DeepEqual(format.GetLeadingDigitsPattern(), intlFormat.GetLeadingDigitsPattern())
+}
+
+// builds the array for a number type, made up of its pattern and possible lengths if they
+// differ from those of the region
+func buildJSType(desc *PhoneNumberDesc, general *PhoneNumberDesc) interface{} {
+	pattern := desc.GetNationalNumberPattern()
+	if pattern == "" || pattern == "NA" {
+		return nil
+	}
+
+	var lengths interface{}
+	if len(desc.GetPossibleLength()) > 0 && !reflect.DeepEqual(desc.GetPossibleLength(), general.GetPossibleLength()) {
+		lengths = jsLengths(desc.GetPossibleLength())
+	}
+	return trimJSArray([]interface{}{pattern, lengths})
+}
+
+func jsString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func jsBool(value bool) interface{} {
+	if !value {
+		return nil
+	}
+	return true
+}
+
+func jsLengths(lengths []int32) interface{} {
+	if len(lengths) == 0 {
+		return nil
+	}
+	return lengths
+}
+
+// trims any empty values from the end of the passed in array, then replaces any remaining
+// empty values with 0 and booleans with 1 or 0, as libphonenumber-js does
+func trimJSArray(values []interface{}) []interface{} {
+	for len(values) > 0 && isEmptyJSValue(values[len(values)-1]) {
+		values = values[:len(values)-1]
+	}
+
+	for i, value := range values {
+		switch v := value.(type) {
+		case bool:
+			if v {
+				values[i] = 1
+			} else {
+				values[i] = 0
+			}
+		default:
+			if isEmptyJSValue(value) {
+				values[i] = 0
+			}
+		}
+	}
+	return values
+}
+
+func isEmptyJSValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Slice && v.IsNil() {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,342 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/nyaruka/phonenumbers/internal/digitnfa"
+)
+
+// LintCheck is the name of one of the checks run by LintMetadata
+type LintCheck string
+
+const (
+	// LINT_REGEX means a pattern doesn't compile
+	LINT_REGEX LintCheck = "regex"
+	// LINT_EXAMPLE_NUMBER means an example number isn't of the type of the desc it belongs to
+	LINT_EXAMPLE_NUMBER LintCheck = "example_number"
+	// LINT_POSSIBLE_LENGTH means possible_length disagrees with national_number_pattern
+	LINT_POSSIBLE_LENGTH LintCheck = "possible_length"
+	// LINT_LEADING_DIGITS means a format can never be chosen for a valid number
+	LINT_LEADING_DIGITS LintCheck = "leading_digits"
+	// LINT_FORMAT means an example number doesn't survive being formatted and parsed again
+	LINT_FORMAT LintCheck = "format"
+)
+
+// LintFinding is a single inconsistency found in the metadata for a region
+type LintFinding struct {
+	Region      string    `json:"region"`
+	CountryCode int32     `json:"country_code"`
+	Check       LintCheck `json:"check"`
+	Field       string    `json:"field"`
+	Value       string    `json:"value,omitempty"`
+	Message     string    `json:"message"`
+}
+
+func (f *LintFinding) String() string {
+	return fmt.Sprintf("%s (+%d) %s %s: %s", f.Region, f.CountryCode, f.Check, f.Field, f.Message)
+}
+
+// the typed descs of a region, in the order they are checked
+var lintDescs = []struct {
+	field string
+	typ   PhoneNumberType
+	desc  func(*PhoneMetadata) *PhoneNumberDesc
+}{
+	{"fixed_line", FIXED_LINE, (*PhoneMetadata).GetFixedLine},
+	{"mobile", MOBILE, (*PhoneMetadata).GetMobile},
+	{"toll_free", TOLL_FREE, (*PhoneMetadata).GetTollFree},
+	{"premium_rate", PREMIUM_RATE, (*PhoneMetadata).GetPremiumRate},
+	{"shared_cost", SHARED_COST, (*PhoneMetadata).GetSharedCost},
+	{"personal_number", PERSONAL_NUMBER, (*PhoneMetadata).GetPersonalNumber},
+	{"voip", VOIP, (*PhoneMetadata).GetVoip},
+	{"pager", PAGER, (*PhoneMetadata).GetPager},
+	{"uan", UAN, (*PhoneMetadata).GetUan},
+	{"voicemail", VOICEMAIL, (*PhoneMetadata).GetVoicemail},
+}
+
+// LintMetadata checks the metadata of every region and non-geographical entity for
+// inconsistencies, such as example numbers which don't validate, possible lengths
+// which the national number pattern can't match, or formats which can never be used.
+// Findings are returned sorted by region.
+func LintMetadata() []*LintFinding {
+	regions := make([]string, 0, len(regionToMetadataEntry))
+	for region := range regionToMetadataEntry {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	codes := make([]int, 0, len(countryCodeToNonGeoMetadataEntry))
+	for code := range countryCodeToNonGeoMetadataEntry {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+
+	findings := make([]*LintFinding, 0)
+	for _, region := range regions {
+		metadata, _ := readFromRegionToMetadataMap(region)
+		findings = append(findings, lintRegion(metadata)...)
+	}
+	for _, code := range codes {
+		metadata, _ := readFromCountryCodeToNonGeographicalMetadataMap(code)
+		findings = append(findings, lintRegion(metadata)...)
+	}
+	return findings
+}
+
+// regionLinter collects the findings for a single region
+type regionLinter struct {
+	metadata *PhoneMetadata
+	findings []*LintFinding
+	machines map[string]*digitnfa.Machine
+}
+
+func lintRegion(metadata *PhoneMetadata) []*LintFinding {
+	l := &regionLinter{
+		metadata: metadata,
+		findings: make([]*LintFinding, 0),
+		machines: make(map[string]*digitnfa.Machine),
+	}
+
+	l.checkRegexes()
+	l.checkPossibleLengths()
+	l.checkExampleNumbers()
+	l.checkLeadingDigits()
+	return l.findings
+}
+
+func (l *regionLinter) addFinding(check LintCheck, field string, value string, message string, args ...interface{}) {
+	l.findings = append(l.findings, &LintFinding{
+		Region:      l.metadata.GetId(),
+		CountryCode: l.metadata.GetCountryCode(),
+		Check:       check,
+		Field:       field,
+		Value:       value,
+		Message:     fmt.Sprintf(message, args...),
+	})
+}
+
+// checkRegex compiles the passed in pattern the same way it is compiled when used, that is
+// through regexFor, and records a finding if it fails
+func (l *regionLinter) checkRegex(field string, raw string, pattern string) bool {
+	if _, err := tryRegexFor(pattern); err != nil {
+		l.addFinding(LINT_REGEX, field, raw, "pattern doesn't compile: %s", err)
+		return false
+	}
+	return true
+}
+
+// machineFor returns the digit NFA for the passed in pattern, or nil if it doesn't compile
+func (l *regionLinter) machineFor(pattern string) *digitnfa.Machine {
+	m, found := l.machines[pattern]
+	if !found {
+		m, _ = digitnfa.Compile(pattern)
+		l.machines[pattern] = m
+	}
+	return m
+}
+
+func (l *regionLinter) checkRegexes() {
+	m := l.metadata
+
+	l.checkRegex("general_desc", m.GetGeneralDesc().GetNationalNumberPattern(), "^(?:"+m.GetGeneralDesc().GetNationalNumberPattern()+")$")
+	for _, d := range lintDescs {
+		pattern := d.desc(m).GetNationalNumberPattern()
+		if pattern != "" {
+			l.checkRegex(d.field, pattern, "^(?:"+pattern+")$")
+		}
+	}
+	if pattern := m.GetNoInternationalDialling().GetNationalNumberPattern(); pattern != "" {
+		l.checkRegex("no_international_dialling", pattern, "^(?:"+pattern+")$")
+	}
+
+	if prefix := m.GetInternationalPrefix(); prefix != "" {
+		l.checkRegex("international_prefix", prefix, prefix)
+	}
+	if prefix := m.GetNationalPrefixForParsing(); prefix != "" {
+		l.checkRegex("national_prefix_for_parsing", prefix, "^(?:"+prefix+")")
+	}
+	if leading := m.GetLeadingDigits(); leading != "" {
+		l.checkRegex("leading_digits", leading, "^(?:"+leading+")")
+	}
+
+	l.checkFormatRegexes("number_format", m.GetNumberFormat())
+	l.checkFormatRegexes("intl_number_format", m.GetIntlNumberFormat())
+}
+
+func (l *regionLinter) checkFormatRegexes(field string, formats []*NumberFormat) {
+	for i, format := range formats {
+		formatField := fmt.Sprintf("%s[%d]", field, i)
+		if l.checkRegex(formatField+".pattern", format.GetPattern(), format.GetPattern()) {
+			l.checkRegex(formatField+".pattern", format.GetPattern(), "^(?:"+format.GetPattern()+")$")
+		}
+		for j, leading := range format.GetLeadingDigitsPattern() {
+			l.checkRegex(fmt.Sprintf("%s.leading_digits_pattern[%d]", formatField, j), leading, leading)
+		}
+	}
+}
+
+// checkPossibleLengths enumerates the lengths each national number pattern can match and
+// compares them to the possible lengths of its desc
+func (l *regionLinter) checkPossibleLengths() {
+	general := l.metadata.GetGeneralDesc()
+	l.checkPossibleLengthsForDesc("general_desc", general, general.GetPossibleLength())
+
+	for _, d := range lintDescs {
+		desc := d.desc(l.metadata)
+		lengths := desc.GetPossibleLength()
+
+		// descs without any possible lengths inherit those of the general desc
+		if len(lengths) == 0 {
+			lengths = general.GetPossibleLength()
+		}
+		l.checkPossibleLengthsForDesc(d.field, desc, lengths)
+	}
+}
+
+func (l *regionLinter) checkPossibleLengthsForDesc(field string, desc *PhoneNumberDesc, possible []int32) {
+	pattern := desc.GetNationalNumberPattern()
+
+	// nothing to check if this desc has no numbers
+	if pattern == "" || pattern == "NA" || (len(possible) == 1 && possible[0] == -1) {
+		return
+	}
+
+	machine := l.machineFor("^(?:" + pattern + ")$")
+	if machine == nil {
+		return
+	}
+
+	matchable := make(map[int32]bool)
+	for _, length := range machine.MatchableLengths(MAX_LENGTH_FOR_NSN) {
+		matchable[int32(length)] = true
+	}
+	listed := make(map[int32]bool)
+	for _, length := range possible {
+		listed[length] = true
+		if !matchable[length] {
+			l.addFinding(LINT_POSSIBLE_LENGTH, field, pattern, "possible length %d is never matched by national_number_pattern", length)
+		}
+	}
+	for length := int32(1); length <= MAX_LENGTH_FOR_NSN; length++ {
+		if matchable[length] && !listed[length] {
+			l.addFinding(LINT_POSSIBLE_LENGTH, field, pattern, "national_number_pattern matches numbers of length %d which isn't a possible length", length)
+		}
+	}
+}
+
+// checkExampleNumbers validates each example number is of the type of the desc it belongs to,
+// and that fixed line and mobile examples survive being formatted and parsed again
+func (l *regionLinter) checkExampleNumbers() {
+	for _, d := range lintDescs {
+		example := d.desc(l.metadata).GetExampleNumber()
+		if example == "" {
+			continue
+		}
+
+		nationalNumber, err := strconv.ParseUint(example, 10, 64)
+		if err != nil {
+			l.addFinding(LINT_EXAMPLE_NUMBER, d.field, example, "example number isn't a number")
+			continue
+		}
+
+		number := &PhoneNumber{
+			CountryCode:    proto.Int32(l.metadata.GetCountryCode()),
+			NationalNumber: proto.Uint64(nationalNumber),
+		}
+		setItalianLeadingZerosForPhoneNumber(example, number)
+
+		// classify with the metadata we're linting rather than the metadata we ship with
+		numberType := getNumberTypeHelper(example, l.metadata)
+		if numberType != d.typ && !(numberType == FIXED_LINE_OR_MOBILE && (d.typ == FIXED_LINE || d.typ == MOBILE)) {
+			l.addFinding(LINT_EXAMPLE_NUMBER, d.field, example, "example number has type %s", lintTypeName(numberType))
+			continue
+		}
+
+		if d.typ == FIXED_LINE || d.typ == MOBILE {
+			l.checkReparse(d.field, example, number)
+		}
+	}
+}
+
+// checkReparse formats the passed in number in each format and checks that parsing the result
+// gives us back the same number
+func (l *regionLinter) checkReparse(field string, example string, number *PhoneNumber) {
+	region := l.metadata.GetId()
+
+	for _, format := range []PhoneNumberFormat{E164, INTERNATIONAL, NATIONAL} {
+		parseRegion := UNKNOWN_REGION
+		if format == NATIONAL {
+			// non-geographical entities have no national format we could parse
+			if region == REGION_CODE_FOR_NON_GEO_ENTITY {
+				continue
+			}
+			parseRegion = region
+		}
+
+		formatted := Format(number, format)
+		parsed, err := Parse(formatted, parseRegion)
+		if err != nil {
+			l.addFinding(LINT_FORMAT, field, example, "%s format %q doesn't parse: %s", lintFormatName(format), formatted, err)
+		} else if isNumberMatchWithNumbers(number, parsed) != EXACT_MATCH {
+			l.addFinding(LINT_FORMAT, field, example, "%s format %q parses as %s", lintFormatName(format), formatted, Format(parsed, E164))
+		}
+	}
+}
+
+// checkLeadingDigits checks that every leading digits pattern of every format matches the start
+// of at least one number the format's pattern applies to. Note that we don't require those
+// numbers to be valid as some formats exist only for short codes.
+func (l *regionLinter) checkLeadingDigits() {
+	l.checkLeadingDigitsForFormats("number_format", l.metadata.GetNumberFormat())
+	l.checkLeadingDigitsForFormats("intl_number_format", l.metadata.GetIntlNumberFormat())
+}
+
+func (l *regionLinter) checkLeadingDigitsForFormats(field string, formats []*NumberFormat) {
+	for i, format := range formats {
+		pattern := l.machineFor("^(?:" + format.GetPattern() + ")$")
+		if pattern == nil {
+			continue
+		}
+
+		for j, leading := range format.GetLeadingDigitsPattern() {
+			// leading digits are matched from the start of the number but needn't match all of it
+			machine := l.machineFor("^(?:" + leading + ")")
+			if machine == nil {
+				continue
+			}
+
+			if !digitnfa.Intersects(MAX_LENGTH_FOR_NSN, digitnfa.Constraint{Machine: pattern}, digitnfa.Constraint{Machine: machine, Prefix: true}) {
+				l.addFinding(LINT_LEADING_DIGITS, fmt.Sprintf("%s[%d].leading_digits_pattern[%d]", field, i, j), leading, "leading digits pattern never matches a number matched by pattern %q", format.GetPattern())
+			}
+		}
+	}
+}
+
+func lintTypeName(typ PhoneNumberType) string {
+	for _, d := range lintDescs {
+		if d.typ == typ {
+			return strings.ToUpper(d.field)
+		}
+	}
+	if typ == FIXED_LINE_OR_MOBILE {
+		return "FIXED_LINE_OR_MOBILE"
+	}
+	return "UNKNOWN"
+}
+
+func lintFormatName(format PhoneNumberFormat) string {
+	switch format {
+	case E164:
+		return "E164"
+	case INTERNATIONAL:
+		return "INTERNATIONAL"
+	case NATIONAL:
+		return "NATIONAL"
+	default:
+		return "RFC3966"
+	}
+}
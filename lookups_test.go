@@ -0,0 +1,32 @@
+package phonenumbers_test
+
+import (
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	_ "github.com/nyaruka/phonenumbers/carrier"
+	_ "github.com/nyaruka/phonenumbers/geocoder"
+	_ "github.com/nyaruka/phonenumbers/timezone"
+)
+
+func TestDeprecatedLookups(t *testing.T) {
+	number, err := phonenumbers.Parse("+8613702032331", "ZZ")
+	if err != nil {
+		t.Fatalf("Failed to parse number: %s", err)
+	}
+
+	carrier, err := phonenumbers.GetCarrierForNumber(number, "en")
+	if err != nil || carrier != "China Mobile" {
+		t.Errorf("Expected 'China Mobile', got '%s' (%v)", carrier, err)
+	}
+
+	geocoding, err := phonenumbers.GetGeocodingForNumber(number, "zh")
+	if err != nil || geocoding != "天津市" {
+		t.Errorf("Expected '天津市', got '%s' (%v)", geocoding, err)
+	}
+
+	timezones, err := phonenumbers.GetTimezonesForNumber(number)
+	if err != nil || len(timezones) == 0 || timezones[0] != "Asia/Shanghai" {
+		t.Errorf("Expected 'Asia/Shanghai', got '%v' (%v)", timezones, err)
+	}
+}
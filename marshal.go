@@ -0,0 +1,280 @@
+package phonenumbers
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// ErrNullNumber is returned when scanning a NULL database value into a PhoneNumber
+var ErrNullNumber = errors.New("can't scan NULL into a phone number, scan into a **PhoneNumber instead")
+
+// the options used to parse numbers when unmarshalling them
+var (
+	unmarshalOptions      ParseOptions
+	unmarshalOptionsMutex sync.RWMutex
+)
+
+// the name of each number type in the rich JSON form
+var numberTypeNames = map[PhoneNumberType]string{
+	FIXED_LINE:           "FIXED_LINE",
+	MOBILE:               "MOBILE",
+	FIXED_LINE_OR_MOBILE: "FIXED_LINE_OR_MOBILE",
+	TOLL_FREE:            "TOLL_FREE",
+	PREMIUM_RATE:         "PREMIUM_RATE",
+	SHARED_COST:          "SHARED_COST",
+	VOIP:                 "VOIP",
+	PERSONAL_NUMBER:      "PERSONAL_NUMBER",
+	PAGER:                "PAGER",
+	UAN:                  "UAN",
+	VOICEMAIL:            "VOICEMAIL",
+	UNKNOWN:              "UNKNOWN",
+}
+
+// SetUnmarshalOptions sets the options PhoneNumber, TextPhoneNumber and RichPhoneNumber parse
+// numbers with when they're unmarshalled from text, JSON or database values, e.g. the default
+// region for numbers which aren't in international format. By default numbers must be in
+// international format, and any number which can be parsed is accepted, even if it isn't
+// valid. Set RequireValid to reject numbers which aren't valid. Use a NumberDecoder to decode
+// with options which differ from these.
+func SetUnmarshalOptions(opts ParseOptions) {
+	unmarshalOptionsMutex.Lock()
+	defer unmarshalOptionsMutex.Unlock()
+
+	unmarshalOptions = opts
+}
+
+// returns a decoder which parses with the options set with SetUnmarshalOptions
+func defaultDecoder() NumberDecoder {
+	unmarshalOptionsMutex.RLock()
+	defer unmarshalOptionsMutex.RUnlock()
+
+	return NumberDecoder{Options: unmarshalOptions}
+}
+
+// NumberDecoder decodes numbers from JSON and database values, parsing them with its own
+// options rather than those set with SetUnmarshalOptions, e.g. a default region for numbers
+// which aren't in international format.
+type NumberDecoder struct {
+	Options ParseOptions
+}
+
+// DecodeJSON decodes a JSON string, or the object written by RichPhoneNumber, into the passed
+// in number, e.g. from the UnmarshalJSON method of your own type. A JSON null sets the number to
+// nil, and text which can't be parsed returns a ParseError and leaves the number untouched.
+func (d NumberDecoder) DecodeJSON(data []byte, number **PhoneNumber) error {
+	text, err := unmarshalJSONNumber(data)
+	if err != nil {
+		return err
+	}
+	if text == nil {
+		*number = nil
+		return nil
+	}
+	return d.parse(*text, number)
+}
+
+// DecodeValue decodes a string or []byte database value into the passed in number, e.g. from
+// the Scan method of your own type. A NULL value sets the number to nil, and text which can't
+// be parsed returns a ParseError and leaves the number untouched.
+func (d NumberDecoder) DecodeValue(value interface{}, number **PhoneNumber) error {
+	if value == nil {
+		*number = nil
+		return nil
+	}
+	text, err := scanNumber(value)
+	if err != nil {
+		return err
+	}
+	return d.parse(text, number)
+}
+
+// parses the passed in text with our options, only setting the number if it can be parsed
+func (d NumberDecoder) parse(text string, number **PhoneNumber) error {
+	parsed, err := ParseWithOptions(text, d.Options)
+	if err != nil {
+		return err
+	}
+	*number = parsed
+	return nil
+}
+
+// returns the passed in number in E164 format, followed by its extension as in RFC3966
+func marshalNumber(number *PhoneNumber) string {
+	formatted := Format(number, E164)
+	if number.GetExtension() != "" {
+		formatted += RFC3966_EXTN_PREFIX + number.GetExtension()
+	}
+	return formatted
+}
+
+// sets the passed in number to the passed in parsed number
+func setNumber(number, parsed *PhoneNumber) {
+	number.Reset()
+	proto.Merge(number, parsed)
+}
+
+// MarshalJSON implements json.Marshaler, writing this number as a string in E164 format followed
+// by its extension if it has one, e.g. "+16502530000;ext=1234", or null if this number is nil.
+// See RichPhoneNumber for a form which includes the number's region, type and formats.
+func (n *PhoneNumber) MarshalJSON() ([]byte, error) {
+	if n == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(marshalNumber(n))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either a string or the object written by
+// RichPhoneNumber, which are parsed with the options set with SetUnmarshalOptions. A JSON null
+// leaves this number untouched, and nil *PhoneNumber fields are left nil.
+func (n *PhoneNumber) UnmarshalJSON(data []byte) error {
+	var parsed *PhoneNumber
+	if err := defaultDecoder().DecodeJSON(data, &parsed); err != nil || parsed == nil {
+		return err
+	}
+	setNumber(n, parsed)
+	return nil
+}
+
+// returns the text of a number written as a JSON string or rich object, or nil if it's null
+func unmarshalJSONNumber(data []byte) (*string, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return &v, nil
+	case map[string]interface{}:
+		rich := &richPhoneNumberJSON{}
+		if err := json.Unmarshal(data, rich); err != nil {
+			return nil, err
+		}
+		text := rich.E164
+		if rich.Extension != "" {
+			text += RFC3966_EXTN_PREFIX + rich.Extension
+		}
+		return &text, nil
+	}
+	return nil, fmt.Errorf("can't unmarshal %s into a phone number", data)
+}
+
+// Scan implements sql.Scanner, parsing string and []byte values with the options set with
+// SetUnmarshalOptions. NULL values return ErrNullNumber, so nullable columns should be scanned
+// into a **PhoneNumber, which is set to nil for NULL values.
+func (n *PhoneNumber) Scan(value interface{}) error {
+	if value == nil {
+		return ErrNullNumber
+	}
+	var parsed *PhoneNumber
+	if err := defaultDecoder().DecodeValue(value, &parsed); err != nil {
+		return err
+	}
+	setNumber(n, parsed)
+	return nil
+}
+
+// returns the text of a string or []byte database value
+func scanNumber(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	}
+	return "", fmt.Errorf("can't scan %T into a phone number", value)
+}
+
+// Value implements driver.Valuer, writing this number as a string as MarshalJSON does, or NULL
+// if this number is nil
+func (n *PhoneNumber) Value() (driver.Value, error) {
+	if n == nil {
+		return nil, nil
+	}
+	return marshalNumber(n), nil
+}
+
+// RichPhoneNumber wraps a number so that it's marshalled to JSON as an object with the number's
+// region, type and formats as well as its E164 format and extension, e.g.
+//
+//	{"e164":"+16502530000","extension":"1234","region":"US","type":"FIXED_LINE_OR_MOBILE",
+//	 "national":"(650) 253-0000 ext. 1234","international":"+1 650-253-0000 ext. 1234","valid":true}
+//
+// When unmarshalling, only e164 and extension are read, and plain strings are also accepted.
+type RichPhoneNumber struct {
+	Number *PhoneNumber
+}
+
+// the JSON form of RichPhoneNumber
+type richPhoneNumberJSON struct {
+	E164          string `json:"e164"`
+	Extension     string `json:"extension,omitempty"`
+	Region        string `json:"region,omitempty"`
+	Type          string `json:"type,omitempty"`
+	National      string `json:"national,omitempty"`
+	International string `json:"international,omitempty"`
+	Valid         bool   `json:"valid"`
+}
+
+// MarshalJSON implements json.Marshaler, writing the number as an object, or null if it's nil
+func (r RichPhoneNumber) MarshalJSON() ([]byte, error) {
+	if r.Number == nil {
+		return []byte("null"), nil
+	}
+
+	withoutExtension := &PhoneNumber{}
+	proto.Merge(withoutExtension, r.Number)
+	withoutExtension.Extension = nil
+
+	return json.Marshal(&richPhoneNumberJSON{
+		E164:          Format(withoutExtension, E164),
+		Extension:     r.Number.GetExtension(),
+		Region:        GetRegionCodeForNumber(r.Number),
+		Type:          numberTypeNames[GetNumberType(r.Number)],
+		National:      Format(r.Number, NATIONAL),
+		International: Format(r.Number, INTERNATIONAL),
+		Valid:         IsValidNumber(r.Number),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either the object written by
+// MarshalJSON or a string, and parsing them as PhoneNumber.UnmarshalJSON does. A JSON null sets
+// the number to nil.
+func (r *RichPhoneNumber) UnmarshalJSON(data []byte) error {
+	return defaultDecoder().DecodeJSON(data, &r.Number)
+}
+
+// TextPhoneNumber wraps a number so that it implements encoding.TextMarshaler and
+// encoding.TextUnmarshaler, e.g. for use as a JSON object key, or with encodings other than JSON.
+// PhoneNumber doesn't implement these itself, as the proto text format would then use them in
+// place of its own.
+type TextPhoneNumber struct {
+	Number *PhoneNumber
+}
+
+// MarshalText implements encoding.TextMarshaler, writing the number in E164 format followed by
+// its extension if it has one, e.g. +16502530000;ext=1234, or empty text if the number is nil
+func (t TextPhoneNumber) MarshalText() ([]byte, error) {
+	if t.Number == nil {
+		return []byte{}, nil
+	}
+	return []byte(marshalNumber(t.Number)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing the text with the options set with
+// SetUnmarshalOptions. Empty text sets the number to nil, and text which can't be parsed returns
+// a ParseError and leaves the number untouched.
+func (t *TextPhoneNumber) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		t.Number = nil
+		return nil
+	}
+	return defaultDecoder().parse(string(text), &t.Number)
+}
@@ -0,0 +1,97 @@
+package phonenumbers
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// DEFAULT_MASK_CHAR is the character masked digits are replaced with if none is given
+const DEFAULT_MASK_CHAR = '•'
+
+// MaskOptions are the options for masking a number with FormatMasked
+type MaskOptions struct {
+	// MaskChar is the character masked digits are replaced with, defaulting to DEFAULT_MASK_CHAR
+	MaskChar rune
+
+	// RevealDigits is the number of digits at the end of the subscriber number which are left
+	// unmasked. The whole subscriber number is never revealed, so at least one digit is always
+	// masked, and negative values reveal nothing.
+	RevealDigits int
+
+	// RevealExtensionDigits is the number of digits at the end of the extension which are left
+	// unmasked, which can be the whole extension, and negative values reveal nothing
+	RevealExtensionDigits int
+}
+
+// FormatMasked formats the passed in number like Format, but with the digits of its subscriber
+// number masked, so that it can be shown to people who shouldn't see the full number or written
+// to logs. The country code and national destination code are kept, as are the last
+// RevealDigits digits, and the number keeps the grouping it has when formatted normally, e.g.
+// "+44 20 •••• 0000". The extension is masked separately, keeping only its last
+// RevealExtensionDigits digits.
+//
+// If the national destination code can't be determined, all but the last RevealDigits digits of
+// the national significant number are masked.
+func FormatMasked(number *PhoneNumber, numberFormat PhoneNumberFormat, opts MaskOptions) string {
+	mask := opts.MaskChar
+	if mask == 0 || !utf8.ValidRune(mask) {
+		mask = DEFAULT_MASK_CHAR
+	}
+
+	withoutExtension := &PhoneNumber{}
+	proto.Merge(withoutExtension, number)
+	withoutExtension.Extension = nil
+	formatted := Format(withoutExtension, numberFormat)
+
+	// the subscriber number is always the digits at the end of the formatted number
+	nationalSignificantNumber := GetNationalSignificantNumber(number)
+	subscriberLength := len(nationalSignificantNumber)
+	if hasValidCountryCallingCode(int(number.GetCountryCode())) {
+		subscriberLength -= GetLengthOfNationalDestinationCode(number)
+	}
+	reveal := opts.RevealDigits
+	if reveal >= subscriberLength {
+		reveal = subscriberLength - 1
+	}
+	if reveal < 0 {
+		reveal = 0
+	}
+	masked := maskTrailingDigits(formatted, mask, reveal, subscriberLength-reveal)
+
+	if number.GetExtension() == "" || numberFormat == E164 {
+		return masked
+	}
+
+	// format the number with its extension masked so it's labelled as it would be by Format
+	extension := number.GetExtension()
+	reveal = opts.RevealExtensionDigits
+	if reveal > len(extension) {
+		reveal = len(extension)
+	} else if reveal < 0 {
+		reveal = 0
+	}
+	withMaskedExtension := &PhoneNumber{}
+	proto.Merge(withMaskedExtension, withoutExtension)
+	withMaskedExtension.Extension = proto.String(maskTrailingDigits(extension, mask, reveal, len(extension)-reveal))
+
+	return masked + strings.TrimPrefix(Format(withMaskedExtension, numberFormat), formatted)
+}
+
+// masks count digits in the passed in text, after skipping the last skip digits
+func maskTrailingDigits(text string, mask rune, skip int, count int) string {
+	runes := []rune(text)
+	for i := len(runes) - 1; i >= 0 && count > 0; i-- {
+		if runes[i] < '0' || runes[i] > '9' {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		runes[i] = mask
+		count--
+	}
+	return string(runes)
+}
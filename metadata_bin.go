@@ -1,3 +0,0 @@
-package phonenumbers
-
-var metadataData = "H4sIAAAAAAAA/+y9CbCkWVYeFn++JV/mq+rldnVXVVZ1d/Xr6u76X7/suvtSDFMz1Vt2zZbT08Mw9VdaEtN2SDJhy8YRkrvvs6RBluwBC4wlZEoRYD3ANiOsGI2NQh4EsmXJEkgIhMAySxAwiMELOACPBtkSsuOec+79/8yXb6vuGZYpIuh5lf9213PP8p3vDP9qNXyUnb1y/VrDhfFhdvv12Gg7q2+//qbenaxN1tk5dto2cqytm91+/U21K9es9D5M1kYPsnUN98k1zYVUk7WtVdZ7/7u34b8S/nsN/nsD/nuz9+7np79Z3epx/skKfvlb+D8/XF1m565cv8YbMQ6z2FBLsBFy3WjJhZisfxbvHn66N3ySXbhy/ZqIFu9yu7ERyozTU2+a3cn6ZGMyYA+zQeM8/iTXnZBKm8n66An2kA389utv2t3YKGPzDYpumAy2RuyU8LzhcoZN2BCepx7qycZ2eqsI+aFADy3t7QvTf95Lvb1TXRredwVGr6b/YWuXxaXLctSndt+pRniLxlt0e0sl7lSXhw/PP59fswH3XLqsRpVdHFT4nzxov7gyfIHJNNF6nGYyhoaPrQ8zGj/P05DInbAbcbahiw7WwKSfxnMynGxOTrEtxvINjRzD8NpduSHTOFjnJxvT/uhRdp9puNRj6/EtcmBg/Ix1k8HWJfaQ5jQD7XflgAbZ2O0L7FTgeQLMrhwEzvPj8gI75dJFky86Tg9OBgsz8OL07/fTDHxNxd+o+J3q8eEDnQFMXy3DvGp59HcqM2RXbr/eHWY9N8wPNFLZWWx07v/WCr8s7lRPwXP0DDxn5p5bgyfuVDXOpNw9+BOVgXcuTucFdspy3sjSbcu5pG7nWf5vesMH2LCRMMVv+t3JYNpnjl1J8y7NrOFjP4uN0ukvPYu24WMzqxuJO8fuygFNY+DT/uhhtu7gNWmIafqm/SM3+UvTWXfMH5tb+52FvQbb/U41PnpI1qBLMCx7J7z/0F3xy6vDx9n5K9evSetjY9JqTVuDc9wVk+G0zxR7Ulp/5fo1feX6tTRiys+i13U06R+SZKUcSuu1hWGa9kcvMUsPWR1dklWNAtkm0zeiSrvDzmK6mPfh3Hs0vmdLsCfTWziPSkWtozHR2uhc9L47bUOf10LaO6fT3ulcDOUizdf/UbGfqUySBgpWxljbcZg1HJ+JBs4D+jX9O62W9HddR00POL94v9Lpbwn7Psw6D0Xbke34SB0DPIMiOz00NrOoQ02jEI2EVZruFvk78DsOhmyEnNGQLB0Pk+WFv/EYuy8NqW+4mHWG2NNU3ey9++VpdWuFC/E1lXjjfhGvNNo4fFF9+ePVmrQ+LSN6D29s6L6HW3zPZ6oVaf3SZfYnetXwh9uVpg9aaZxdkjYNsAwy4mpL3QzpFOssD63hk2baH02YpyeUwSdCdLaGYVJNGv3oGzHWsxoWoQzRye6bpDL3FtrbtdCy/Huls5weSMtJLq4nndbTBbYprXZSt9PhpO4sJn3gYvqR1eE7GL9y/ZpLp2e7jtRu9Olw2HG7EYfPzFodKR/k0zX2b7BX4boyftYIHH3oVdRacBqa9o70HrpMr3XjQL+k19d4InWVgLXp+rQ/epqdTXOSFjDeHbAx6aSxjtbKZLD1MNtARUDvyn7Wtfrb51ANENC+26/L9QCXJuvyHDvlue9c8dzDlWsX2GnXrsqkvDlalZONMkXvnf5ir3tGXZ7TC9RO55Ra9TwGOuSfPUI5WNeNHNusEphl59S80na6M8BRF1Wie2CarrIolXFF5TiOKmGPPAPTevquVdKok6xAKyDtfx6dw9nCCWV/gH3wyvVrcJrxsUxNTtdj2t8SNvcsqkYkHQMGAjZnOoijxU2ZJszN6nSTnMm8agTpFukTI84uJ2mhom6E0ukNBnddDI1IRygtnw2HCr3dOpPXTnpXViFx6TRcZPV+I9AVXDogFMszAq6QGvMupi2HHjmf+mNg3aNsaIVNbCwovzHQdpEblkto083eu983/cLc+npuTn+Xy5bCWuPDjG+t8ktpbp85cA1IHL2ttSv8sqjT0r2v807bWbgialdue/Kg29ZAMTnWMvmhaniaDRoZsnLJnmNPyNuvo/kIYtSg7EVxSxM8kJJ2+ug8OxXS0IdsFgSpaBqP0io/MP18hcbUM0cbQ6upkYdqfj/+lcNn2AUhoCck6qwvAirsJnMnybDpBvvuAfuzA+V9UiFUNjIjnKdXrl8z6WSoYzrgXFqp1tR1tozMLv4OW0aYWfSijumclSIt8KQBhrSw5ayuk93dvjn/f2NAKEcj8RPSRq3rqJ2ik83NZJSNTFtPR23rOiolXd1os/A2r6PiMlrjYpAc35bEuW+km8Ug62i0iM6Y6J2HLrj5N+DXraxlVLLhKm1/B63QMnpV4yuVDHhKaDdLd0cfkqqs4Y2dHqYhESgsrONFjMAJrs0sCrg9qkaN7Qxf3XA7SwqzNrM66qDxvNdwq0t2jZ3Bo5qe8fgmGCfhZo0eg+Xm8a6Ad1GrrYZW51dJZeh+0PTpChhPHi+gtiHxg7pOerwN+GaPagr+A74MXUlaH30ThVjpMawGqUBOpmcciKfowT0RQyOtoyEQNm1/BSJWRdMoncQQ9AUGxZncLJvGIUkw0whj/Qw1Ia6SSqPh/IY3GbiPp54ZO4NGeFnXet9KNA4WL6zBtNBgxcArRZQqpgkuS0rL6AQOmzRRuTQj2s3gR9gLPrXKpYGAAdn3MZ7WtNLWpcaJ1EYDg2kb7C41RKRFFqWJ6WIycGOwaRDSHEcrTQyKto3yjUxHJ0wyT6eXxhGA30Dhj8YngxjMNJcWNJw46XvUk0ZIDavM4zjpRibDLTqYXuqZTeNrYGxxKQoFjU+yxeE579NuJTHQmLTcVCOxHWkWo4FGpSNTpT/Sv1wMOg2WzoMlh0JkU30yRDk1+tSA3RmEfYIqHFNShbsQVeHtlVXhrQur8EWRVtaZdNa3QuvLTF6RwAq/4yVW+JKKrHBPZp1AZm2GVmihyNo6l5V3h76F7CQebj/G7rO84dpkT7Qc2s71pTriq1Obte6/tsqvJykgRLtP5fUIXZNpPTrQ6sUY5gks9NR8qce+s5pFmmqQemU9p7eIBqY5iRXc87i+Gx1o6xjafPBUmj6XhimNTMB9mAS0TW+QsGk1rJHrsPJh8pLRSc4MlfZkmEUJfkOBt3OZdsh1+BA4ctH6cvJ6mio1u57lBBoyyU6PtJzqhfWEHo7GQAN0apCD2R/DBywZPi6NAawD5cLsehqTcWehCfCUaFhEUjl4kyBLyWIbHbbdw4K6Xqef068oJ0MSoLDrl69FQfMix2miPDSmJsEl8BlalKmL8HWFE6HH2A0xVihDXKNwsD31OcAmqetamPr6x6uVkAywdwz72azoXRajMY+CRk3Bl8BZZGANQHN12pEwukGI4vSWc07vccfpvdQrjjekpVNu0EvfkG74+T7aQXrOqGzt//FlNXoNz2WeJQcfwxHtYPDhTOES5hDWSiNhQ6M4Meito90Aa9eDV6Ue/Wgl0WCapSOaYgu0hrPDTWY5DQsVhFN6oJ77FNwJoSRagHnd5XWGi6uRsN1wZdECqjvNoltCmX+a9jzVNNN1nT0VNBqCRqMefUdvaY/Swa8jul/n+4V/W4/jBJL06B5Cy9JZA2edr4/opTq4k9jHdPzBm2xWDQ7oX1TweDrPHQ6Ly5Oum6Q5wGnlW2VA+ZmJRkdnSYCP/s8vxQilMxvaxMdp2QQ84mt8fzrrDhswah6pc+ng5XXMr1NpRcOJf/iYgiqQ5I1Ct5VPH3tL42t09DBVoDUXfUNZnMC6M+BltMEbw6s71bNzLj69bItXoty9s8wxQneP8e7Vxhb/4XOH3H4p3y5V25q/uDE8n/2f+6XO5mV5SZjxZTW+rEePhOITDKgUw9Ez+mjRmN5moVSPfrLK7/5dKJlqUq2XyKbv6R3Yr9/Z8gm2BIko3MG4MeBe3FLU9kO6j1bpcaXU579UY3WEpDruIL4tMitbMIti620b/GOJqzuVaKXDfoE1Jx16QdAzvH1mvxyae6YKFP6/p/fc03vu6T2/l/WevRPpPXsn03v2Tqr37FXfeoTeQ1Gne3rPPb3nnt7zZaT3JMlzqMrTEQy9IJJYO1Tb6dxehcXo9Tm24UXxlIrWE9oJ0H8yg8wKwMx6vQAy0+yy9Rpc7rLFehWIRSh4xnQXBu6n/dF1dtV6TWtMe3CZohRBH7ICP53j4IpvX+CUuocve7vxZR9ahCtK24WXWa8LlOMz1Yr1y3Fkwx88Nfzeiv1nlQDEk5C7UaaXwF9aYQSN46BJwt2onYB36R2zGzHYgd3x8KPdjQH+cLt1NPBXelu66OCvdNXDX7Cb4PGosyu6xifE7mR1EXY+OT1dYb/UYz/RExCDu/16noHUdrFbpxcqYaO2Kv1pBAynqiW2W/ASXy7Oe3TaGwBVYeyiPWI9OpnBFx4aoU0+l0UWSEmUwUGqMR6ShFCJgcDjamyzt13iK5VJL1d0nGrj2jANXG6gJboRGl+IR6dtlKPFJyAYNtZ4l8oHNHzHzX0H4FN48FpsVekbRDS0IUhpGho5FC3ue2V0gz2TPmbA2Q2xA4onx8YVYA8+OLAIVTZ2fra2ttgmBnYs3ldiO+0925ydxyAHF6APkfChJ8L+J6RnjycpIjj43X17e/TSE9qoyMZueyhQ9B62Y9o9LFxsXO6PhNe4dOR1mu18ftm+BXmz9+7Xps92UV7PgsueIIRCthir07hoccnWZPo+MRcB6Ny9YoSje55GXGIGjO10gGDrpuEuZNv7AQxXWApXVOJO9drwwTlMo+Dtw3C6i6hrG9NOmZvsBmUdLCcelUxneTpYi9fwgc45p7opFPdRnhDpEwU0eXYJDHJnac7D4t35NE0CZf/deyca8b1jjPjecUd874szvHsnHd69Ew3v4t2HD+9RcMDv3xy+yJyA829MUdodvxt9luegdNSRTllE2gn6Xnc/sb/XY3+t50Fsp92JMkFRcFLDq5O8zaex7wBKOQBKRdFrEb0qGg7w03S0GIx387F2MPKS4niojo71LFKIOimE1BuDcg4Fn8GjiQDWWc/mY0lahcImdSCi9AyBQMaKMuugNa5tvcNoYl4OIGTzlyW8tCa1FfN2nKfELbT8csZOlt2TwXRj9O+yjwFORlGiSlCQ6oNQTNLlx4paAyMCQ4rATdtwSw2TeXhRCQrpL9fCbwe6/erW0+yM8BzVhTREeOamU6WV+n0M6YsAgWeb1RvRSvmhtGxLQIid3qNNabvajUKRXilUeWZ9sjEZkmw37GmhyT4RDU9HdkxGRoOHtxMlo3AgtMNDYjK42Xv3h6dfeSu9shEaMnGiQGSN0BTXFQ7NHBsdgDe8rznoX/yNTR6vCA+I3PoT1Wr6+U61tSBDOmjynrAF5d11cbT/09mHPaHuVBcOSh3siZA+dUBy1YrwfLQqPJd3qkcXZIpeeMnOMhEiF0RI2/Kl7pYF/K3QBc7uWtD8PkcOgOaTTegzoHl74/Lzz1+6Apjlq3OO3qUfOpU2rOIgQeu944z83sm6e4B36aDu7t11d/dO3N1PVo+zobAKWm93ZV9YsLZa0fqpqjpQev9Ab3gRsm4baVB63349ek5o9kmfPc7OgG6afgZ5Rbunb1DijH4few2QhTzpmCguCUjGo5So1qfzDu0kkHBoWrmGO3JLWYK26XGb4tQ3mEa39dCSrJDth9hGKD+S9JgDA11kDwKCMJkuXJR3Isj/Zu/dH5l+D2XlPn7Q5llHBPuhAPJ/uTL8GPsgGkNhNyqTzaLUKz43mskucpCXIwRaPkLngwMhMoZu9PvzbZ9k9wlPkMM3VZrngfDZ+KdbR8+yM+Q88d2XdcV0eevWo2xIwwpv68rojclg+zF2urFuhikVcEfBXaXrS4FXXz39XC/J0BjQHBbgqDHUVQHGEnju0+FCrgaezjBUnT9RtTnhn6l6ok2j+6aKfbySnPoTLU+SlhoGS4qHkAfeoM4Aw5T0DskbOTa0smFWLD4CDj8RFWUuYbJUsilCmpLUxGQgmGLoeExSgTHI0/+59eEVtqWsQWAk9FQSWC1ans4I9LkMpn32x9gfAn+LzIpSVBZ9DXOuCdQF2uwrRHEm1cJmfHw612YyCsBOgWshKhUtOu3oaBY5p2LaH73C3gFeLGN0DIFM5nwQYqvtjIMugJoLYDnT9qQEDZ0dTVtnWN97+tXnFbh9jm0GziUd2ZioDctl6Tq5Nf3sXD72lWV5MXIhLwYBU3p4cemt+YniPrsE3jZOp88fw8cWhP0Bj92Hwy3T9ERt9/37cuffnenrTF7UtqTc+JN8eh2zm+gQuavO7v32dXbv7jt7lJXx6fXhM+xx2+bpFCVfhwiJI5CfNhlM19l3VuxbquwM5HCsodUgGo3GV9IHUaPVYE35kF0x2KEACEOSXUl+aDBCFL2nkQCkRfqDqBt8ASS5ZVAtKMv06pIYqXKm/sZ0fWTZtuWag26L+9AizhkwihYg9K7kSW5YAs1unWND3/DCuFA8ExvbI3YqYEY5XQvlmoSnUGjCUznpcmN+a9549/TrVw5O21fzAEXckEemN56yhF914+I/UIc/Nr6sRpuIKbZJ5y5PXVy6E/LDc8ursmUb3UUT9+6qiXt31cTFxf8O9pzj4FmckQ3kMBUR3MoKDla0RUrWoeNKY3ZrZ9N8fi1nm2t7ULb5n6jYH5EYCCjZjg4NLkot4AhOlsX6Jm8lj6TIGQ0ORZWTC9KNHiIuZoa5FiJpqtGqNulcZ4fhtD+asne1LYCN3ZrJuB9NOk0DelgxBATnMiRwtG+Uht99mGGHXcSBCs7GNuBQrMVO1GHa/3KMO4xYGmMlKPEI/i7kCTdu7ItG5Jdgsru23WiE1LYs9peYpdkP6ZBxtOoo0YMLEITWUYDJRjO/ihS1obPsP3H/8DU2EZC/CXEK9EeV4AQkEIEKlvM8QatEy8QC+ZFB1cckVbx1UH39kH1hkEOCAjaTlqqORkpydly5fg23+/XolZKNHbeKJ/g0FIRxpdQUEZAQnrXUqWSq5lu1BC+DkSH69BGA60sXjTQRjmLpo6/raKyMVhqHSRzgZCShlObWYQ6KD3WUsjE+zIyNSkYtrTPRYKwYjtL0pAU3pNJulvavIO4J62bRGXhHkMrpOvo20UvJ2lhK0UnnqrFRQrpB+o5TdVSipAZo/D6uTlV6ytMiTTqGTBIjKqmjlrIMEESMZSMVphGULCEDsxSDSTNgpMX9IdNKNqaOHo2+nTal0OWUdcraGEtPCAyJTkIB4ZaxolAHCELhKGvH4+6TkOQF4RMA/eeEJHQ0CkoxIIQG5rp7dJzZnMqEFrFA8h3ZQPgIE8ZSnzCEzYXKPjgQeknmo+RQ5BtNEgJ0G/Jf1lF4kcYQtrRsMLzv80rjEmwaSWOtJNgQ0UiFQ+qi0XUdraWYcBpVhxOAfdSywRemSap9GQ+QUhBRS59HeedCWkAamgI9kllS6ZlEGFFU0sMktUaIxAzAdHzQUqYkI/hvHbU00eeFwZWKGP8zLpmWSlKITeLakKbkuqXpMJAEiJlLKnU1Bkl4FUOjo7THAYIsIJWew/QgXG7g1RDJsE4jI3X00pJMlqqQH0ASFOw/X8M7IPqm0UYzuKXLm6h5GnOP0uSm45deA+3LyVlcRgm9VeDIFnl3S3iOEjQdcgLgcIk6KaB6JupurrXCjCSTyTswbSy9shZZkW0oomZ2Y9rhIrN6OAEq6OjdTKBjVJF3XOvWfqa0NEgxLQeOAydw8UEMty6wTc8brmbLUr72J/+/g+0EPPw0pghxiH2qRoFa0VjYKM5nt/QwWJ7jkcObvRsvTH9tfZ6HoesfTGdCUWvvUwIBT41OfSe988erBWYQ1z7xVys4/C3tUoUaC4pOwEaAmw4GFZZamrk2A0x6CPTOoqkxrwlMEQqHlsSsNJfK+FmkKQRUl4R1DTPpcSIhxGEh5gwB67Q2RHomz4dQoErMhxWLQ7gzDF3TLBkBp7MzMx2l5a5KHs2t9f1rw7PsQY2GGzG1JN1zYzJgM/YBzzupxySfbTHRiENEzaLJYRhat04gjUi2swohSFllG6MR29SNKUtwoF0JJW9dYKd94ZQBDTqvwcnG9n9asY9XwKuTtW5YbRwEQxrLkOSyAVhT4G1CmE9rExP60r9RrnOBpiqSoWBe6xiD8RzTJF3GEATAUrQkYIWtZLIhz7KB8y5kdd+7gOr+gv324vRSd6WLoxlHkkLjeQx19pVc2wdPOsiA32ykCjRD9LA/xPxZ5gAwLXeeOom3o9JLl94LzDrcPQaz8yD+g0qWwq9F7dF0Ag+XnxULyguOY92lxOkNH2Cn8KTNC5f9ITaTdAjrAMgRMgHTMS9sEQC6MUh+A/YT3gEaRYHDkf3E08V0xOW2SK5hekc1e5SOWYSC5HTS1i+Q1/RRzCk3Xpp+lphT9EnmqE+dP3ST/4+D4Zg9ntPv7Y7bBaEHf3vUwU1LPLU6XWP/Jvsq2eGo0pTS6ngbYyDNLW0oMAcJh5NmtuURwrzccVbX7a7syxIV7E820sfSiaVVw12XtEX76AnHAqqJh5iqbSOqG9q37u6tR5bRCwEPaJ9YROfcLY+wDVdud+X2hSl5efrLc/xAS+Ab4+EjtBkP3w6SNtH4oGDG/QtDTPdfGZ5ZxCIuggbyq9+3PGA2L02ebAhfTLPllkxVSRQ5lmxyPPoim8SyKNy8p3gAHU2fpmf0caARQ0i8Dw1f5NU6lGw1FC7Kk8zS3glnae/4s7T3Ns/S3l3M0uIzx5mlvbubpb0TzNJRHuZvXwFRj3ZXFvWR/REyRTLnRFJCQJCDCEY4XgP8YtFwjvYApuhLdOGiJ8P7GsULiJhiqwsRXTK1wdGUpb8ggTH6fexD2bckKGLkC4KFPq6Knyvpm2TzqIybwYCsQ+uzfEBRKG/rIdb3fMGRvP0EezjJQwenmTatWl0EnEzP6fwcadoLsm0y/ZF+Juo6gNL4vjTYDgEm+vATJk3Q/1INz7KHwGGBiFElstufPcT6UhYOYonD9yx7HNkuFdpLnggMTJc/Lhig0Tzy+Hxl+icpXnyYh3eJiiOVPeL0/Mbe8BxEbpGVI62ktmfPsseJVkQ0XIHjAvkVbLbSkrog0TRDAm0DjNgwfV2qvKRMHtrVc+yUNw79xKgSG2cwXH7j5vSX7k57QOfSYv/TAhJ5AVFkIw/HT1XD87AETeDRhtQTZ2k8BuwKu2ACEfOhfzRtNO8yqMcE4t8bXWVbNuB2vP16RHeCzCk3Rdca2EC80QeOy3l2KjhLxLFAQ+0QmmBu9m68d/oPV9sz/Kg1/G0Zmq+1OIRpWGtAoIEzkhzDmgLKoXg/tRatD32HXaSHAFeSQ8khr5F0t3JvwT9+D4a/FIZ/4337Hd++4/jWWnQd31qLA9fGr1RwAKE9j8u9z66xq4gXmHXcZBmGTeZDBu7RVPepiaMnQRpICcEXhA2WcEbfHWE2PMyGBj8A7zT4zpu9G++f/qW1ZXT2eo6hFDpxqNT7U6vDJzBLJZcVQKwMOazAUuizn6rY36tycKpsBoD0GsJB2gKLlxCkSlZ0ISkCaESIVuZkJvTaX7l+zcJp2rrPTNQS/IZuFr2NAT2IsKCky9BTDdau9uSxA1Ibmxewxlyb3D5NwtzJ9uSVJe467Y/OsgHacm+6dBJlD9jG1kVwToiG06wjOschVPGQM+oD0x9dvTXkSTqK26/X15NM6vMr8HdSaDeLF6edqyERQmk72+7zy88/D8SjF9ES8WSJrKZ2di6PlymFCwqpL/cfugz+VTV8hDEyhkWOeJu09p9nHgItvuHQRoxHwN+Okw7VgPNLNtwQ4olAp30n4OgavcpehpdA0Af5gyCTAymQhG3Qy+ZKBgLgXiKag9zYWeedCp2YR+oKH5z+k9VbXSzTGsRUlg/A/zocNkCom5mSyipfwAXJ1jfUcaUFKF/hiYyVZAI52CbDySabsnciAAt9u+CtBzy1p2Q34loDp7WD/02foRAr+m67JIMbo4+yG3fzxmQl5ICb25WbQgRbvL6TzenGdLB1IYPS7I5bTBfZfoY9onLBiMaEWedG1b1RGvaUIjoxGBCyxCkOEpWrCTm4oTmnsHkBEOdJfHXqbj2NewkTcyVFdpQgPqrG2JmJIQB8DfKkYuA10I0d9UTaL4JDylJ9/eNV77K8U90aDlo/bNp1r6CfXYLXSjYqx5o4+hGT9QZuQxMJGu4RuRValLuSIlrB71TvPIhH6kHFeaYCS4efq0esM040fneqVw56wTqycY2uUAYVgcspmxL/QWRz4Nqp71QObb2ucbpfgJxGMEDar7zY/1QexexrRRXuVP/20eni1+52K7jZ1uqVy6LeHvJLLTT55bkvmmVfZMkqK1oKTOH+N+291fnZu+sx3fuSD9ve2zZsR2nbn1wv+Bd5kLb9bRX7jyqpZYlFRyjtgp8uOYkggJEIEfggPQZvA+kuddRWREMZ10grHpM6gmHkZLHx6ChGBAFlzFGm0J73HUhDoUbsjz5dsb/UaZtpEF4GnmaTlWZV2ioQkJf9xBbjJy7pOZnTkmxHZZChtSXnM9G5Otq0mhAeKx2AeHwnT47SQZKOlZof5hptAtkW19nV9G8CZ8cT2hnT/j1L4wBLY1/Cr5qzNKSW8xAbWfbFebYptZTStPMlESzV2SyfWRleZGcaQQppbA0Z8KOvsxcZxasheT71jjJeoRRS4Z7EALwvQbmORTLpT9dHjwMwX8BmyIUF5IZwWTE+Urt6bfqj5FlaCk68ROBEBzWFFis17Cu8QLs6Nfqkvg3qw94JP7N3d585VIv+71aHz7DHAy/hDe45j8hCWkCkEH4Zsk9VbC+bVEnAaYpFShBo3Lg2yZlniWI6rkShSoCbK6IEdah1ZDiFomC0brjORz+mTKFyEgo2AxUk0wiNfkDbRsH6UlMFkJFiTzmX0eWYQyIgWQ/b09q+G06UdXSeDTgpk7tymP6kMMxw+wxFbQwkj+Cv/bnFxtmWC+SkRc9PlLxG1NCsWAQbLlAG2cbN3o2PTL+BXIQX5ng/O8mjvXB4Kaw0YaXO22GA1cqV2w7LFDrcIzX8F/3hn6nYH8W0BbBxQSIFLnM9uJwliZEzCOwJdEaEXTwdAI2BFCDjkkadHs0+a4wH+vYtVJ5mt5viMl1j31+x764yhi3n2CeJqzEHU+KJi7VDkNlEGQoNwm/oRUcsEvGE5Pya9mDMuZ/tw7yw7TQyPyCJGJhnHlyuID6ba1kII7XhQYjJgGrcYHk14JvGgYCmpq9RIRNdt0VvZNBBCHh8a8wex1WqdsqAAz0xL6bSMNcmsW77EnsgmbKCt7OUDki5nBL5LNuQOuSvYlA5GUk3ezc+Ov3N3q0VL/jXVP6NNR49v/6JatX/B8la2DpIsK54zrdW/SVMAT+7JF6/ELHpPvDpahll2zyiWiCCUVgA9mSN0uVZE6C6kFsHucNHH+4+gpGasZpFjdQJOdiChdccKnRITg4zetBrt9b8JTA53nMsWALR050VRPsTXYOKIYi4WXlbOFaCBL1tDRTJ8uzVuQGH2Nh+FX8VsshhxI9Sj/9cb3gBHO0oJvHEL0fFJnuOPQFwORudkqTXkGevrcnYp7Iuk/7oArvPZmZkumjzxa1RkcbJ9udk2QupJpuHnPW3pv9sZdlZv4QpeT+rjp5D3Y/LsB4hFD+9PjxPcaZubR+P9sL3V+yT+fTkGnBKFtj5QzRUoYDbqFW0CE9FrGIUDaKBAE5qiOaI2yiadPBpH11IEjOLNq6jUFGFqF0h1+GYN+eTKJWF9trOXBQhShOdqmOAypALmqQl9WvaH/2ubv0XKWzxGxX7xd+dxgQdlAfZE9FKeZBRcYGdtvPjYjmXOar1/Ls7tkYlvrmqjpIln4dadF0WiJY2oM61eXWX/iEpEx1s/TdU7I157gcJcVsIeUUhPNEy8AzinkUZiPJB+pmMhkcXSJzzaHVbfMNHAA03Msx8tMl8dRy83sEEgqLLgQ/Coqy6x59wN/wJzz//FvgT7uPxSmMyVcjlj1cr/rIgIoVDZfX/1hteZI80ovV/C6o2DGfYgD3KmJB5/Y3zgPVp2EecPel9MZpwogxOlHBl6cpBCDn/9Qjr9PkXpv931cV0bS8jeJhX0nvek3vzyYPshjVo/Akq/okTsDQU3plDh/qnq+EFrBEuqQZ49A4D83p3ssEeYUPZyTCUAp37o3PsVNoIufZlC4ejoXyYDdJ7MuTAObsvlz+P7UvTXyEQgjyJBb0GTIGHdu43gQZBEoYkEojHZ0fhgF1k90uZdDkKtcwXtHuVvcilJclExV4yU0Lk6DwdUzAfzG4OmR5oeLtxmyI64HZ+oT3FzmWZcPv1KETZyrobHlk6Wi9Pf4NGi58EWbviOdBSHc5fUflSge+wdbiaDvtDR/5bVofnGEt7UIjdwubmdyeDySl2g6lcvgZ5IwVGYAXlTCAgC3Ie0iiKvF07ZDijC2n9qQ4M3BXehMHW2QzrnKdAGGDp8Vw40s7zkMmL7LQH2zBn4Q68LlevpXc6ny/kr00GZWIm0/pgjPbSLTpAhFQs+exfcZI86wElJvssFL5y+OT+x/a/5L7OSy5dNqPKk5S4yE67+f67tv8dr+PQNKIt/d4O+2erEdvwloMXQZ7ylrcBxlPAKdUb3s82O/kjkw32MfaRUmkLYsACqJ4cHU8c68RwrCel8DYLd2E+wVjPILJrIA/KNty3idl5vYwm7B2A3Z95XlSsrKxh+V4AAGo81ulM1+MWIsePe068Mv2F6q7wZDAoh26p71sAkujdyRq7DJVdqXoQ5Ido8K+QkrEGQzB6iA2b8pRcc8cJdz//nukn5xEhcp/f4FiIkP/v9PAZ9riQJcibDoUAhHxzcmE42WT/tMd+Aji5RfAS4I1RKaFRiZ7n6IIoquUx2FyQruSYcSIJQ/eQxIkuCYG8rqEaWy4vDGq6mqMGI7wHJ02Pbq1zdaexbx8kpxJ+ESk+KCTauUXlfxYqs3GYJ8ck/o8uHBSNkW4inYyUJZQUrdbHOlaZd0zlAkq4giUod1l4Fl/SYHRvmL8Uw7z1BDj1hFQx6cT7j6PiI7nIkp1GeVjzpdAmm7QtH2F9rXPKky4fudl7/r1TfwvGMYpOqpWkGlYYwfUzSm8SOaMtyUn+zVV1p7qCqCBN+ITzggYTktakqGMysXh0Pt26JFAPHkQpwmhVimAxnrtIgbZUjehpnVTZIyi1yNVGr336qNsDMVh89Ojv8848L53hzjEBTt/ciHreZbcc1b6ajNwD3PmLav2d6uqcMrcflz9PCmP53vHnYu+Yc7F3srnYO+5c7H0R52LvBHOxd7y52DvxXBzlRfnLveEoI8EJEOlbahv2GLtfZsbuEkookMLB6BkEfOaSnG35bSy038qbh4nECeDXviQzHXzEv2/6q6StXD2JttLz/k7lh5f2J8YcoWqupgE4arT+52vDyP6wgOQ4YJQmuofQpWHIJikEV2wx7wQUbUSAJtiwLj0N8JixaYW+Kc6anbDbJWSDQvifW2c/tA7+pu7Bc/v1mNTehWUIx5UUlPq9/2JsyuN1m7KtEavhDKZYA49FetYhBVc0IufkEnkZpNZ7U4vYeRQuoQUZTF70BCXOieCKslEo2AIuLmGAtA0SkA2CSqNsFFpichaNQLgPRbtyrKVRYSaQniFpy4G8vU7E4Ouahorsgljcfal5OG8K5y1n69Xlb7ubXaXZG5tm07TMG+2819frOvNLZOCKMlEHyCR2GUMX0F/UUt2QgoK0CpnpSqJXD/18DnHDqhPqRviNAt4BTjcLQkNw6WdQxzM7lusSpAJHAZcK2yEzHkhEBGFAWVBMISaqKqKI4GNlMfGditUTFSyFYyXW1sgFWsFlLef9kYqK72LctJQYRbcdpVU3ypFORLU3IWmiO3t26ezJ7uzpzuyZ/bNnaPZ2zG5dy2EpZ+C7geHp+ug2e49wwJVlOh9uFBEFGdfhI0vDYjCpLSdlhw5/75t+V24K1Rqcm1s1uLWI/E/UPithi8wEk1Pb59mmsMS+lQxHYX1O0P7lHvvhns6w02CylhYLU3lnpzUCjUlcknOrsEy+wFkwhO/K66+z9hqhDN2sXF6CeenBmhPHXXAk89olJ7prDp6y/qA1lr4Oyywbxu368idcX8GSfJJD3Q793GpYOJreP/2qW2c5qrSEYnChTusRXSyP8Hhl/7UayCbvVA8h6NYU0G0v2DvVvz9HEGEyM0LSmc4sTGfdiDAbXVz8Ff8doGjI6NGlV/NlcKpsr2ds/V+sDoaHpOkcKUEYTBsxqL3k7YgHboHAo5eO9RAABsWiAtV5T2FL3Z/Nt44b4071M4M5soy50fvGXln9B639vPIPXO8HrPZjL/O3sMhPsrTnF3bMZXyiCel8xqn8zl7J7/y9KQ/eqjiAzfU3eh5IFmhPmXKw/14dtbcsReekzw92h+/e+B17/A6Qzz+0eZAEfCvy+dvWpKWAtSSdrtXDuc5umnQpTQZH9hgg5MrUYAjKQIgihWDRn1WYnvCPwIvfTOpsO2tS4rkqhQh4UYdBeyKmMUVvpXcK4MTxGc+h0eMuIIgqMzLQ8DgXWg0tE5omnhZPrKqEucu4OagemFYaDhjwyBmP0hPInFVazxRvR6AdPhDqwlR7T/86qX6/sDb/ycLabAnLdbn3S7dYFc32F33RQgNJcGbVKHtyWwjQiRd2slRsXuACynrCihW8XdZHiYt7Avy4ZuoR2uxhNUM77CY66Zf8TvVfVIfnSqX7z6XJo+/jGsZVUx9y5ZogkPWS6y4vFTRO93k3u6cTr+5Uv1Yd3acfQM46BZUOszMkw9ksFSFrfR/zno4GS/6hZ6Pr1qDyNWOpXeu1QORbx11Bu06MMQ+t44MAfjoX5nRmzAo2BhCGjigF9/X54pzFUTjqLlFioD7am9vzfN9bw9xALp3sPpV23fesOPrZdcQkt5rFUvL0ZaUhL13Wo9W080pt2t8+g3Xvnr13z967Z+/9th939+y9L4q9d3hZ8X0qwt7vAhVh78tQRdg7XEXYuzsVYe8tqAiLLHnHUREOqK9yHBXh8CJWq8OnAB4mknCoiVVESJ2jdJMNcL732RPsQfq9kzrQ4dyd9kdfxz6mlFJtYCgKygxtdKekgtqNKnPXdRMF7G4G7yXBISgfQmFZohmVenG7cqikKJGZrREbiDa7SHSiNpvbz7BHRFtgVmeeaLgxdG5cGvj+wDTcOg8sICgl9UxHY+vYAMXB11T8jXP8SoM1PefuqK/fqcxSxp0H0j25kAzw3yKxQUuv8+RcBKDzaKU6dz29L+uY6ndiplPGgO/dZTP2jtWMvS5SeL4ZBSdxVBz/J1aGV1mdOVgp2SpiBicVPNuNinOTseaTIbPsihScCFzf1LtZKmrIPctSM8M7C+vS6CZWwuIcCKUNh0XRAtkMQEQtsjs4XHi+6bLoeVUSjM9l8PA+CvOLiB42hf28s87mEzSfBYJfCcLdzqLGlhjBS2UXTXnLk42bvedfnf6/kA/3xpNXRKklAMJWIL0/p/SfEOq6sMHud1WeguxH6lprgVw95FygVQXZAvmJQ6XKZ1aGll2hbAHkBCY+MSL9NdFnaVBSgYbADvwxJL5Ex40v1fOoni4oHHjKSTOLzpfM2kg55ZrYcVzJfgdq4MKxBazAZ9i6Ie4t03JvHTypiI6B665cL6CaBfqg5z88NbdWhAgZ5T1ezjmfJmOTmpyGp5QNu9Ryrtud7t5z5ZaLS/d1qaH8+IHb1x+d7fGNvZLtUYrndctC3mBO0mDjST4mRiKqWJ7UNwBaCNxCGWoMef3EVlmzx+j4JuyEGANrQMgcYLIfghAH1oA8GK70VdP/q7rVyb45rLJcn1j3Dx2O/3xl+CTsVFQ2LABtMptVJixDvtiQC8RQGh8kQNnbr9fRpMPQEYOfJiYnLCCGqjZYu84RMTVqQapRqFBltoM0msaNuyzxWiHXmWRPB6OIqFLvgsZkcvIE4sGySAxmCZfZeXbKcmI4gJEmfOmkvzC+HyGCs0OLZ6r02WXH1D7sX5Lyn6weYRvBmNI+zBeYbHyqqj5TrTY2HMCf9g1rbzHzcK9i31zNpx6KqDgY7h4zDIWL2kZn6iiVyYmIHJJ8SgaigEp0nipLh2gVsWjIKPFZjSV2gquj1bQUUCnBhMY6BuPnEhL1vYTEu09I/OrfjoTEv9AbjtgjmCUXsJSUn5XyhOws28SyLoWrmLg6z7FTaR+4olNnbrytMwvM8yj7zrLNNOQ5F61UipDn2KmCpadHUE++dmYZJ33Z0h+d/lJvWRKHnUviAMGzOAAjdl+aH96h83FujtMXyLhWhzUQMpfElGhJmU9y9E2/I8RuqXdr2NNtuoqkCkSNIEBeoXxZTPbS7Cko8YoMWLjBEC0s0fAv5VFtptQ4LAvsMnuYtCws4ZF5iOfTwUZs00NKWi6LWgyTwbUR23ScJDIkSrXP3RixzZAWYf5yELzl53j+1vS7iPrgGAli9yHXV2i4MMB89NwhhuL+MoShPLDvzgMeWFwDT7IzcNrAIdWpxzIIruCTP1vReCq0MC0Y4oLv1nIztIi8tFh+7OHh/1Sx76rSiiPqGaF3o6ZE+RnQJ+7CsZiLIuudsFvnO8bSIJOLaLkHHZWV8Ludu2iTJh2E/F2csrmSoAq325psIjaqrUxI9+wItTtZXazWPDk9uW9y/7Q3XWH/zwr7sRUlMZFJpFc2mAkK7dchU/uqAj/WMx4b62ehfCCWvDfqDVYIDW1bqH9IspPJbLq3uNC5B/1jXcRrmrFCGm3zKGDxsRKsxSUG5j8WyVN+hvc1LszoywtpOgpJeiE/hmO+q+jEartB1WQoYLEzBU+M7aywWo4NgTjhG6T6j6kUlAu5OGU3LI1IWSRKtiQO2vdBH7KP0FJjNclr6kx3FHTmpVI7QkIlV1rUB878dHUU2NOiHMKkfnQobMny2QFAqulQrE42t66wTbJC4HunW0PEB9791LZmW2kiFdUZCJyTixb16LplvehkCsnr7EnheSlGDsezowT5pECVUgQ5OWChj9fO58Mktd61rZts5hPlhRenopvmenX4YFcipYXdVgJQQG6paeWTAfMbFT5SUjWEbB/5WN4Rity7WApbIE+JbjfBOMwagbmhgFAP3FKxuTEVZQLuKpepLVMLcIuI0dfOf0R2uaKWv1ni6M9Ay5BY9euYX6Ne/w+r2OvMkgQksbnXrzfIXtRu8EJNQqWMcwVijelbcBH0JNptnc2THeXoRsFdDGGqv77S+YzKjJZYNVHIXPGQ8BiunM+eDD/gVyYJI3EpaggaoDNXaVtECo3SGBiMMYiAFKLg0/BQc8N3yOK0z99GMSVUbgCVL1HUAJWTEchC1dAm+AIVZ2zr5sGCgYpc6QINnFCtgBBSYxxRgEMa8s+tC1maQYMB3CIgSRvfVp9ozLGgA7zZ4bhrl/QjqjoCEwFlCLWNptEQxgWiRYU1rRVGZWgNPXGQVbYiVOaa2MJlZsoy410HH910eW4Hmrm1uCJKQrnqaijk4ID3tRrKJhRRgbht3t9PHeySXwPSw/mWlvu6YqDK3XluLsnNzjXTjFaFN3y0lv7Ll9b3WU5y4MowdP1orgt69mneSkG9A9qwkc4Bs+/G/e9LN4pkOdCN8xT7nQHqidxxt4yGDQezw0DZPXhqevLqMjq2eedtX5im05yl7pT5L/WEIe/SO9lFYZEW/02BWdzInSFgGckNsm/NcgWqqJaOjYTH4AQdV202eC03YU5FqdTVvuCz1Z+p2NdBx8GmV1EaE1UIKBgwmmRmAqgtVa2QI8AYKJUQkBkIEjiEybersfUzzqMQqr79etI6jEkHeQjpsMWkPLcrTwnnCmNMakxSaf9uNXyI3U9GTbaUJhtsiz2CFHQSJZM2rd9aKMs5V6OHWN+5Ylp5JTgXR6Xlv3Bz+ltVNiBOkLO3mhSxQ23cb+oN72PDpkvOcJU9iZadQ/+LkHZMFIjadrznKjOmnPSB5aWKHlpSfW3pWLxnOk5DAVnMJ+WOOYJuATIRc1mXQjLtrFsgmn6eKWdd5og00dp8QmJYKhfxiIZ3SV6GzjotqXDL6BUW2pfA4e7DzERHFXKheGnSXAUV1wKTofsqKc29GjBvMzPzC0tqwLgOM7OzrsvM7Kw7cCF97zpRMh7AVv65HvvJHqFWZ6HUqI8y5DXB0V2fjBc9zoyXaBpKRUUByFtYSA/w51J9OeNtqToi8GEW61hASl0B21IlGjFGMAaSkIORiuTiWa0rGmKhRvXFkhOovwriIm6QVwaMdwz1c0HqYfqcJZ1TZ0isL9iAMVLFqlYnNmQfWo6UBqF4z0vWZGEx9zy0RIlPsAfTiLWDTHshfLHZCL8sN9AH5ugGy1bp0xQcuF3+1crwEhshVkS3DMQtcdfGZMDeyWSwIrQlhrFXlLdcQle5Tm+9AGoYvQdCxobYsmVoixFnHmIzV2aPSv61mffGHINw6jF2v+cN5qRTye2B54U8Sl5Av67sXCwOTDrsRmwzAE4ru95KrHpws/fCrelPzhHTuZMdg8BEO1eo93jkU1UoeuoJSMjWmlIY+FBV5G+sDq+wi6LwBGWdoq0zUzJH++xxdj9cbMXzQkGm55kOVucAmMEAmgpYcpYk0Bjr8qMqW5ycoZ3hmj1SoChRJF0yz/ccLmW4lPf2AjsNrfMhV6iRPpTw/4vPT//RancODyXFdbM5zpR9wbfxZUX3lYjycZgFQ7Fhzu5HTO0rSyr2qqcOasO424a9E3x877gfP0pv+5b+8Dn2tCfAkybmK5eJIDrsvyaz5ysITKhGostCw20l1o2VPil4TbrrpD/6xz32t3pm7th905awnWkLE5dUlyJV5w9r8EpzzmvgTCLWJQjRcY7ZZsiBnq9gbQZBP0mV7skk7XUmS8/PA81TOn7HVGAaHsLUafL5Kggit9UdEM9nc9gV4zLwZKN0ZgAoBa3w7CrHFgKxiIOJBtugSNWIwDFEuJiDoi1Qoz/Z2HoWmNPxvcSPWCONdeamaks1b28BpbPmXaVKA/0/hbgnG7QTR+yU460Q6NZvzgfWiy9OP0/Rsr9wYLo2b5RtVwjNvpyVvkcLtl67bkbvXfKEnLUDBMPTPr5snNq33akmC/X9O217CArBkPqSZDuATzs/FvyohjyZy8tYTBedJcerSOCP2pR/Z2V4lT1ZOF130tak9YcMr8p0S5xN16d99rXsI0KVow9WsokGjWxCG0ksIKAisJdBxSIOHsGcf+YbRFKFtLcpWgTxxeJ335gMgOv/IjslcozpTb8rh6Ij1w9BEJ1jG6FcORASltfYy9MzXVn/RAsImnOVrXaq779jDmQ0hxu6iBz3WIt+saulePiRsOA1mJdjFVT+BLoJzNhn5j52np0y0jcthaqRHlWc0U32leSpWYBxuG5uH4WQoOAIwPPcrI3oGl5C5ugrwBJyNMrb6deQf80H9tzIb7GHTchlUeQsBpWRBSZQMZKbvRcn05+o9lV8/UzVp54dQFRcDR9gQyBQJ3Li6ToLbCcT5+Vabo3UMw6mRDKLkonULdrplePKyun66AkAGQjCV7mTVPbM6+vV6Xf25pmKD69iswatP1on+/QazXthbGSfqdj3VsEGbttpFPNWR2HnsxnXiGZa4UdyqntOUC25wsXnKeqFkUlZIpNIzJfzMkl2YEZmwayRCx8RQ3k1+RyMH32QvYDFiBthQZejZmJnQhQ8NS7wTN10+/VI5UUhzNAy/mVqX791jm2mpbLfENg+D2q+sq4wy3JS4uU5gHwUhuVByAbAtTOs7/J6d3m9l3n+0HQLjyo2T9+3Eri5U53BHzP9yVrjwiwcq/r9OnahONSOr9mH2bEKt9MH9u7mA4sr9AzrI1dW2swZn9ERVr+0TsxrULoVfSiZ/HnaZ9+3xr57TQhrHWE5MCoP1Ury/oVQlhwTTzEQKhqMN2MEDry8Kir4ZzQN4hJ4UagsuWbQIQigeNQwS+XydH55l0P3FnObx5oi3sT55jNZF5ZGhTJv4E+kWvVYLxUeDlHnGl45TQfSPaCO5Njn6ijQ8ExqIClGr26/HrWmcJm1OZfI0L0UP1QYACs3GoNwSAuJORCrxJ0H8TvPc5VefAvH4CVxbRpTZwZSSAPBpHHdcHwhF1SJD6pr4+Y2hjgurcUBSl+DjB8sYAWfCdgprPSjaO5SixU8Ck1GDcFaHr2n2CHFEISIUlLmtirUuvg0PgzP1jg04LqVDhpmkMHARudqrExLKr3Aaliy46RQVH5Qg58Cu8ZxGE3qVZI8BQWX/i8t8Gl/9BBbD6ijDII4JjXxi69N/8Wcp+C5ow2z9dSQ47DI/9P+8MPs+eIlRPntSjXUmK0NN+siOur5mrdmx+8uhozYO9kzVOcHY6oIEzRUs9LTfpY7SXQW/q/2LaNnYfvnhcMzVqgLiSwf3Ho016nVO/OOHLhh+zF2urGO6s7uINysE6NaNuovvTL9XO+W4jwGSnTSllgI0pYRRGNgsIyKo1JqaWHhNBGf1QjFuSFxvu5MSQJK6uHpNj4bWt3wcajST4Om9ifM0+MPzh8VPSHuVGYuhqu6GufD6ctgloLLliPTfVE15+HwHYX2NEALMKpuFmLARbOdi1Y78gq8pf7t3WVf9k7Yl73j9IX2EGCf7wOuSpd1pbKvvqliH68kz5g2m3WDtOBQXw4BLppdLGCGDoTUrSh5B9cPiElLKnY6HkRUBB1yhb4upN0YKKRkaDfBBtkQpIh0bbje8Cn2uDZz9QhB8So8fFRkSrGnLVdFj1O5gAJy+PmGGJPn/SnohMkQW20wRoCQEY7YjPyQywCmAypQYY7YgcLwpZvT/2rt1gBcJNHITBv35EFG/5B6GLU5HqPv4bWo/jhFWl3hcT/DNsHHnynaQZKNGBs0oPvCb+Y4tO0vvWf6+ZVOlfLlDfibveF5DEipnAUi2yyQGXtVZSy8m0XJfVQ8FB0YIxjKuBltOMtl9FzHwDEp2UskxrTFI4V11jHeOXq7Xu8WXo+G4pED9L7pf7t2SPExpHxSR6WO/Ndz0XKzO1lnW8jTyykhKvpG5Gohcp36/iTGkyXZLxBzCKLchGkx59h9nhMqGzu37iGetD1mTwZCAkL0DnaTTFZm6x2//bpcRyNirvuPgqcMXd3ZnZm+ackAfukD00+C4fjGQ1cEz6nccsahjvGd6uKiRSHHx8wY+260HEXHcnwKhgq51Sm+2CK/CyRy9DzUpm29pz6rD+DkMG2x5eIlbd0GxSpD26twjHZ9B3+AveZVNl6V7RqmKLooQR2phyTyy0ADyIqlEJHPrtxiZma1TD7DRlROUyB9EfAl6XJjLjFCszSn2VEPbvZeenX6RFd1e3De5OsJXmqo7y/uWIlSAfd4plblt1Y5JA5evwuO53WM79KheFcf3nvLH15cj7gGCrlwWgN2XyrDn14ZXsbcNW4x3VRQ/mEbWoJ0sHNsmKZTlOIgyMc/2Rgp0FY52osWK9lAFsq+F8kNbtEHcJTAevnd018Hxf2NJ/gVIaiGBrwb/4YP4Nb8eNW7DNN9/sDh2w+EKY7CcLICxiJ20HzPneTZI47H7zkzvMoeRw54nDJIXM/JXr7UAcO80un6dIP92U3268NstncqTWTgjmq4yvwtHM14PpY2JwfoGeDDqIpxibZICkPkELtEcgSF5gjILYTumxycx7c5wmggjgLlcnb4EzSKy1nLZZ3z6cG6MflVCvkgMvNCAcI7rAmPkAtMu6GajpmoQSBuAz1mmVNCA90DAQXSlyxC4ttUgFxqvoECSS2yQKEelt8RZlnzzGzMlH1G3udML5TTBsioL1n/+CpMNBBEs01I5GLZY9Apg/tDIYpr+eBwLChFgDhENDWrQCEIXbLY0pIm1zJs86atY2JcZ/jxtfiHIX8i1C22hTYcxyS9Lh0IhSwOM1Bp5WbyvECOJNdBjht4P722LJ5MjYdzjAG4EtxDygyiV6GZEW7W1jTBMaOIGR1nIqecpG1gs+cpM31oQ9AcZ9skrZzdkWHTlOgKMRfANhLcGj1Crix+j/V2yfMDpDEesZDaIemMBzQ04ZksoO1di3jhGus/R8nx9WqWNj6tKUj2B44SZKOnRBCodBuF5jSlRqbuibHKjhpZsnixdhYk8Dqb3ytzSqX1lsrQcMK6JimLW0UGQxzshhQ9DYOioxV1NJidy2HYIJ6hceCMIfoB7D7B2EG/6ax1RzJKy2hBR4uG04irtGXG1s9kdJbixNKhfRCVg8/CAPtGKljVGmaosObQ/9NoeVNHI1PPlfEKHWd0KRR3pRdpGBQGmRW5yIKxoi5Jo508vSKO+9ON0S9U7Meq1CptXFGYHDIzxCAEsMhQoiuVflcoqyl+3sVCIV2QacMMOGRFoHSCSdkFUMRs3gst5RAREqHhOpvDmlGIPopWBJOjj2BOTrcpsFvb7GJSLTO8IEOA0h/grevECbdvMk8BDZ0xwWlOCwEONhf8vtlG99poq2+/LoehS6Gf44pnKT7gU7PaG4Y3zrK+sXTB2PbCzd7LN6Y7XUXy+oEZBZ7z0Wpq/mgNejNax06N+tQ5OvffdXQq/orXZrSaOjNagy6N1rFnS9Ma7EJZcrznGyv0xuRqNzudKpqPwHnvo8ECKSE6G4OuR1sCT2/low21QwGgowppy/sYtK1HHJ91iq7KqDCVD7ahDS7dWjeQvhG0LSB7OxeEnyPHewRTx8c24/tAs8gPNkfzSD2E/Bfgm7B0RI0e6/54JVe7SgqJsbO6nieH0PuGsuQ8PHd0MHi9EaqNBn+MfQjOA211tEbmHZutJpnbgMnXHDNdIJeFcFXk2BSQv1NMpKGzpbjg8FNV1Uk5fYgop+BFps4L2bQLuaO0/6k1wmhrp+IB8No32B/WEGeU5IqSaZnksAyABGymubUzn+EwGrXBmCv/O4W8tZDTA1NhfDLl0naPQWWLeqidkjYQtHvK3oXf1tkwQEwsqZOoino6nShEpCiZSHTeqNFYuAdQfRsBqi+/sB/hHToIb+1UF+GtnToQFfFtaRmeJWo1N0esYncRX8I+xl4tw4unts7qpKNYni5A1hJxdKQmooKjQmFOyy4ElcGOAGL5+xX7gcp0jnsOOgRoEsYgWQjGONN3gB/D4xbwQFKBUTmoRaVUTTgkSTQPGFKDMJnJPznIMSWdjwtCXqtSxpyos7J6F60Hw0i7nBiST+/AW2Qt7fWth5fiapfYyucgIb+Nr7ts39/svfzi9Bf7S2o3Hxaj7rl88OiToGJ7StJj4iR+j9XGuNmJobRKHwO2kdbmf9kbngWhmnTFoGPIfAeTAXuJBRNQ8gHMKoqct0J2VLGQFbFActFWKjZBI55itM0etfSesQSIIIb5fLnXBsp3OdDf8TDbCM4WggGby9a//NL0x1eXYJyPDRywx4Q2/cuVoWHPps2hvYiNah2w5L5Bz0NbEIl8EOvsCXZ/eqbJOeEmacbaC0m539P10VX2OMWWnRfRq1CD61c0wuWoxtC5TDJxQuXyFeaOoVzCT1883fLlVrd8gCdhWgYjSdP1NB5fKoXibrSJ4U+vZhkuEYuodrMMN4BKnPbZD1fsr1eKe5kdyoTl5fhYNjrErI6NsG5GtpslTwEn6AUebvkn1Vp4+aecQqay+6W9RJrC2LU/efpJtj8F4i/iwEhAC2ygeDHWpv3RO9lVfFABIKMc252SyFRLrT1tpGox8Y8scNgQnPYQV+Zk+r/PYRCeOsj+OAWDiVjB2SIflVmSKDw+Gs2wBvNKjumj3rh3wjceJVt+rsJ4EXptIbvyK9hViQm8GRmBdEuZp0RmxUZ2ckdplEfb4Kk2kGWFrDvgrIgylPLX5piQwZdfmf4cwZ5HB46KPKqDv1lRSBHA5lSEkUKK60yyK3hJoVcHcJAIz831HgrUcV1JwTkfnUnjBdlrqTvrqOZsPUgoUA1RMbGcMe4MG6omA1HkuvIY43r5vdO/TD0dL4M+z9utw7YnR5FDdUKBdnfSZ3+UfW3mFpUdr4YAtyU4dG0uHmlIT1KZ0yVpx6jJgG4lAAmVNqlJkgCYCnwNCFI6mNSu7BsLsnj0NBC+NlIReB/q5/k2bk4MJ0cuifdNf7Y6Mkh6ZMnyf9AbPsrOOikLfluBy63N4pqyG1l8JtMcdHhN7m2khIK14smKBpkPGQZRdMCxG4rLHIK5yE5DRnzeaPOcUAf1+yzbyM2UA9cWX73Ze/n905+noThZjEOdWAu0R2Ro/+MctTeBRxuSwOiocv86+2oDwWEkFMr0c2BJYX0XIduCLKjdqex2Rh+yA7+lJ59XZ0sOTOAUwb7KthDLWypty0bmqGhhLxzYQJxmBw75eXYqOEvAS7Og8k2n//DtVPm6DoZFwfWDveEWe0x2FykYS5AMlt0IA3aNjZVqk3iQujEf/0pFA4PVUtfKgVKKgxtg9Bh7EM2qaEybXlZq/G5dYPclmdbhd+sYOxfYfWHhYkkyWLp3Pzj9NVqwV462dPAIu1M9epATDphhD0eGrw+fYk8Yzg3PXIBIJ451eB1R/06G7As99stYdx7TmzP9C0beFbF+RfL8m8IbA1RvPmTMtypWsgD/P/Haq1ysoaXEQrxqyGTquvMg7gjilFXjzCJDbDYQ2MjFcdP6xlszjBRxwYbiIrbcZ+FGm4l0ul0hF7/QhTcVxXvuB77czvWeghvA+kQgDwKTtk8RyZmmZreOgaFsgRTb7KKlDOkC/7IhBp31YVvQBq1WN5/osn0egeod8uOSbVGzC5TzbWdRSCD9MoS2xLdklOq1h5caFmXtvjp9DJfuM0f7SVeliO5O9aGDfJ7g7qNi/q6lUiJye0PhNiJWsjn/DQINByDlF/2kcpluMGKnunsBHE8GhWFH6nxTjygBBO4YcPiUg3GTPcrub6R1c4TsUhKX2MboHBtCgG0/0/FFtik8SVWfs1OFkFLKyeb2OdaHnOmQadLzlaWS5LXpF1bmtIBl1fEwJfVYpdUPFSPf2l9k27BOLHhyf7ViP1tZlwP8wDKvMLcOPLUmTanN0XAejS2ZcxgjzmEkvBOiZT7XtCjB3nI6JunjIZ7WRpgarl2YOcDyWIdMTbM5bt+83ny0jc6hJTwkbUOUfRKZrcgJlhSa7Ou1LjOmTvujL7P+3vNtv32+7Q8v+rbVnG/bOnFZfHNVte5t68TyjfmFavgIe6DRme1QF/wNO8s2tFaZZFgTwnMyGD3NzmbiUAvaWMsiOwiGUvoO0cfPsL4mz0JfZ6zvzd7LH5n+M9JtLhxkl/R0e3wcpvmsph4dKpH+bm+4A3artxKDSr7u6DdjYu92OZOcfR37gzmxBh2nJTqddQ2DACJLvBKSMAXoAUJaZ5XWi87pivCMAf4JR+d74VtHhfxBtk5O077FI3nrAnsgvdCH6K0s92N25PYZlvRHT4Zj+nMRKpqX0EenP7Z6a4Xzw6jnNvJ2OnQkf3IwfI295LsV/neIIFh3avyjZ1Vjof/rkVxxlDeqs6nTpohssk9ULOO0upCAzCQMAwvXkFSRDB8cWdvibNLxX1M8pKUZHWe2zWhy9R0wq2SXpl2Wo3f053rsP+yBZ5O8rlT9ocAfTE51xSCKcYoX3AmmGFFGUx0dJ+5OQ5geAHRgCleSoTLXxywYe20xlQSxFCbMuhgo4uzB3tpFKSUU0QvlDEpTMEgOkSShFF7C8CXxyyrTpmSCy6J1PrUayYHkKJY9SsSmLTeC200b4E2xo4E/uENBmqc9qyrvZ+8iZC3yY5JBgQBn2l+FMLQV14BCKbNX/O2FBGDynulPrN86ywvGiMMIAVTwek4UuDyXUyK7aISVwPloNXCuktV6gNb0WC7UgYr7HHDFdzLzD5NflV+kd95HC72f3vlP99hvAipHZNQzOQowbQ9S8ABDAby8lvIboc4X4WIEBiLnOad8HnDwzkP+Od6gXT4wMUeatlWBBiJ+XRL2GtzupHZI8qV39QyVTcpu8i9HUmhLe5pQaPjGpMq0usaGK7NdxJRklxXvjr8ZUxU0DuTxoXUxCYsupo4W/6nV4Yid8Zm3Gqm4WiX+r/TYd/Rk4XnmY0WlvZRFtnsi9kd5g9YeJRZl3iKIJqg5RB9BPTHjEyt5QYZiKbdrPZXdMAU0hUXFLAb2TMt1BJ92uhRebDhGpgUofFTNixJRnS8gWSIlJxXRNnqxEEFSJYPAeXOBAPIl5Dtf6KJTx+c822zaMZQboZUiI5Zkh6Q8n1YBPDTPZ/L+6T9fmeO/n9+LY+S/h08ubrrl3KF+7+g3HXoU/tza8HkWqAySLZFSV8paEIEzFLigygEOWdXNji0VLtiLTIhiy1P0qLgneIaRGkQ/0oHbrelFpBevMA+wToMEVBjihGSyOakkTebCyllYoMm1h99ksHWJnfaZFH95AuU2G1mqyJxh7Pl2syvXLWxP7CHN5jk2dMhghyyZep+4fnX6J1fmCanPLnER7ix4veDDFDp6dhnV6uITJdDUKXyil4plUZBWZ5cI5QUyo9UOhP7Z4zxQiJquHufuNbD5llLjHnCmLA1mPcEetDIrbY7c5BtWtrmvXfa2avgAOwXc8y2T6DZ7TBKomLLSMX6ru+W8tFGK89HDbNCUsgsbiiAGW4yte/qt0P/s3/hj9kRA8qIZgldQ9MwXINoIRL91szd5bfpbJCG2j2bD6VO3Dt3h/8nq8Al2ljhY5lOcMeoBaKBbbFLchuTmAVeV61rkhFKmkw8cVFmKStJZF0v1pU19GXP7pHEzjtHjwnrD2/DtE3iX9jOeTgPPZd0WF0Hj+hwbhrbMRSFjLdsTx/wR1peilO0oH7jZm3x4ehn2Zo/bN3rcLmMjWfQRbfWvcHsJeMt+/7II7PyC1UcP3v5R63xCHHcXhdnWGjy0OPWPsL6idTlQvnS+G2A4PbzFXqJMEpKovqSYoTZAABNQk0GVyNlyyLDckvLO0SlP16br7B+tsO9bkeCRztTGyIvoMYQMGTE6Gs7rKDtBw2gFJd0XCwr96LpTIwE80p6oWTJJvsAkleJDt+Szx1gd5Hfk+grUVVJZkM4KMEYKzyeJqpqf5XKR8C6F55bzXWp3pcmlX1zzJV9FYqlDopAPOYTY8pXmHAFScjplRHIWT8aPSzjsMo2/yjC9ObWyJZCnvmD5Wj7ONUyxNZbCsKnxptXkUPExQEIghce01XZe05yOnmYP+ty7XE5FDnwXap9WwNYr7GpaUW7mIdma8rqda9GHoEhwLvAGO89d1VlK28BvFYrDvmheQ6yNp8sVXa7M61qvvDB95611zhsfZvkw3jqCfKgnTDoo56ucd1L8T+G6hokQhc/wyYXK5G4pZPzKwl2+W8UMZEN5oZrTAGD7d9pKhIaDMiMFt979gJ2rPSvKmX5kGGHFc11Qg+fb2w615XLV2kXtRXfx8Z2ioVl74SepB4Sun/mo934H/0Jm395xJn3vpJO+d6xJ3zv+pO/d1aTvHWPS90406Xt3Mel7J5r0xQOrBoybMJxHz53owkig8mXR2Asw7r/fGL6DPVe0FZMVj53sDN4JyPZCyZl+J5SDCo6ov1Kxb69y2FIs0PehwEZ0vEbL1GWzVVOxYN8NshLfdcg/YLCiLqQUUlHN3s75Y7IstkiVh4HYkIxbUo36ZIaC/L3MHvZIT9SQDC4J26b4r7bOl/LEdr583nD7KntcGEoeh6TOsZ8BeralIh4KI9tqehfZKeEbE2bt68qXhtfO5ro8i8XGHmF9gMwCR5jtqF6vvDj9hTkeuHfO1X/rOKweK3PgovFwapGyhOs+C8mnDsKGraVp4uW+7eGZspB3lhvRrSh/13H0rzO45vCgzlH0zvdOAOdeWvZ94WbfNm/naAzgauNKAZDjEGW2h8Jhse1KlzZwlA9yn3zYL3yXb/izbMMLXwjwCvn0Z6t/jb3feylKYUFiPGjQ524wiwF92apw0mff9lyAK/t9h76lxR4C9X1/6FgtOsBplcTUdWBfLsZRayp2uZ9eYFwYldlCZWghMNnn4QH/0CVt7vjj0zum/dHXV+zfa2lqqdhbIwKZDYV7AkWOiKJxRPdAqCaF8dZZpqMBKVIcjRILCxXfVhFFcmBatO3WDhsBVsqYCE6SDrB8Se1Nx54SUshO1i7nsa12LkIBeYggQjHDhzKJJNeKpFZUZGXt97MPOkh541iHVkGJfQeZnm0ypvARU24V4nC1ixagh6BZSmIYbR1BznVFz3un/6B/6zSS61C0JEuhi3O+M9X6zlaENHeqp5fVrpFzp9qKkOIQBmy6Kxvq89t+6T4uFbDbQktPHbKP6faekIu9sd3emJB37sGaFr5qfFmP1kWDLkgxfKxzn9jZ32J87hI+1xNmP9XFQBigiMIzJpOYp+P8UTYU4OhLGwrK06VrnHOe9krarj8CHBjCSl3QcI2iup6hALmm6+wxdl+6K/PAQsKDldoBQGW6PnoXk85KbRqecXKUC2xjOuXS+2N6niJTJQMiSLIvts6zTc+9sPJ2LuSQ/kHpDl8BkTrNGx1m3EYnZQiZCigTh2oTHYeiXIBcqLFTGhAC0FYfOPkxDkAlPbw02eFm75X3takO90NN2TwKmOlgpe4kjp1x0O20rWyEhO1a6jJfH2Yvq9KR0n5Ae6T+RiLR0sZKjSZq+iP1t64BTohc3NCvNqshq24/e2NooCYP59xzHkttEXI2i1x6a86tsD7ts18fsJ8eSCdcp6BPMG24UTqRcaLOY45DnelNqCKDQ41Othzh5JOBlZTkIFaEKzQYEiuJWa+iC7m2J+ZC4KucpBx/Y2Sp/UnM0AIAEhCu8Lw0BdVMpWW0TkfnfY3nlw9YMCC2RFK52TmZQpP8l6YUmktLF6kusda3o3L0xbSPVPTJuBJM0yCyoWolVp8UIEh99A2Sm4W2sKG0BGFEMA58xefIisZidKYgGaG+OgAoMVfQoPMB3Tcyh2Qg1x1bCIBCSOECJCHVXk79A03Xzhrsq/RQzZDw1jlcB8C+9C2RPuNyob40Y44qYmMVZvTV6AYTd1KzGutmFA0SNpZC6UJSuT/QyLkD8DGFjuqWK6edFUVuHlVqI1LkEMLdxNFmM1mba1ROMpAUj8TpyTSjqsF6M5RfaYBQMNNwkOsGmV4AGuhybLPEPjvqQsZ7Egm5aF+FWHbXiFwtp1RvzJWrDcbyTG5U5kLRmCqhDCQfe8QbQhMKH0Ij7CyX2pHIN4oTgEhSTYQv2lL9SPw+soroAl6lEojJEsCVKxEYKzMOn5hLIDzpKBU1xycFlV2lbAVBiel5OlQjrSs+OSoilCv5oJ9etisUF2ihaoCWkadQ5tnnqmW0yVB2iGemxmWCHNutXAl1Derbr7cCSg6dFhxjC8g2P90Y/Vaf/Ur/yvVrVkgXoD/e56AmFA0RIJg48ZQAKCT9G5qQ7tZp5cITvmuRHr57Mvi+lT3CU7TX5AC80LOGi5xlOzYtaMiQElyup/2NXkpKOUHECICYPfID+6a7gEx5VFJXQjPH3wPyBanxi9MW9y+uLSJi4w2XmEkMExhDHggcKAgUE8O7zMVLc590lnRBEENy3Yp2UFgFCpSjhAjIEFW3Uh6jlnJcyEYKo1wm+sCdLhuuaP/xnP4PLTLAwoB2J3ct9NFRcjWtdJcf9VDx1uSsOrAVaE3S7KEcaRc5z6WwRIHeyKbsnrLaMQ4KlBDddZ4r/5s5SqPQOeSpx3e10cv2zlVBZKb4hYONqlPQ0iVfOIQLcAlKpMkuKY46c1aV5QkNgMWJDNRk1SEMwWcG55z2CEGGQBWZcHmiSJGNKZxNaQZy7hOQRztODM4+mTl5XaC9WkDyk+HWV7KrqCeRcgQUqiXtFucbOGgj1jqv667ltv04OyW8Jb7WsCtPC29F+/7TcsQGwtvMVZr+VBpUtsnmgi/9/dOm67whjneXOd6NM3eqr6/wV0+/XgADthhuXNG5G72vRzVdpNK4dHwh/XAdbdJMhQMSgNH2cW/1Na+SzfbAPidkW6SVj1bTCKUbl2ajLLhFhYZbf7462kWyJQTsvVmr95HSmDRLUJdGftk96BtEJyBsQU5wTqJKT2t69NUHPtkp1cxLtXKsMoAboaTMLn0zmJipjz+1efR4fP3KodqoLYWa70rz7Oqc47tSOpeom3Qgn1jfxFdBkt+cunmwtjmnZ1pY5ifSMAE0qaIJ0Zka1FTk10/SJETropN1OnhHP9+ZhcNMglJywhC2b07pPHRmjjIJ7m56DrQJ7naSFowCpzNfftb80U2WrgL/b7SiAVU06QRFFYAC2kCDms+dAATvs1LhKo0/T80Dk8qKZO8d0wY5qaIAKuHo76zem+KD7T4itMp0jHV0GvOV5qe/O/FKONGZ/VLBz8t6cRFkraII5CCQSKf8UkeHmx0fSU/jrj++Lig7Av+oRbZE7KBgzxpPd715Yr1piVSTcP/R9aNDAd9eiZxdgtjwbFlios5+wyyjXMzxTbMlhpmHcdpnkCFOHMlDeOqhG3137/gNREAj7AoNBc1UOfqMqe+iva06uVSZRIBqq0+6YyiUb0GdXKJMwhilBTX6mflxyoEGQvxT9bzOoEkyFdMoGZOWaHRKuJBeWBQIYqsVRfK9HTZ65hQ4ma5+nLF9+5X1xS311FwZ/rn4o83x+XTfjbkiknJ5CPIU6Pa0+amOPv6Lg1L73CE4iSWgh5Lqv2+/Lw/kC8+rvd8ZCvzecRX4veMr8HtfBgr83j0F/p4C/7tdu7unwP+en+J7CvxdKPB79xT4ewr8PQX+7VTg946pwO+9HQr83l3o44tAmnNsQ+jMgyM0D4obafkc+/S3VsMhW1eZ9o2xvnKZac3xIDgfMcoPgd/8sUpUvfKB6ReqZYR8nQziSh2ag/S53rBmj8JadJ1IEBXlgiyRTp3tm8x2cwmRDgmrajhMJ1RY8gNFjD0sofAC23SZawLgNKFl2jyy4x+c/u05qt6nj04XOmE2nD00G26x9vnRlS8/sz68yp7IyMRApS7HmYe8pmZMVil/fTjdYD9dsb9ZkUTA+l0qM7Rp44vWAuSOmAJDiGWEGgoFJVGJBN5kmAsWbXlT7bYsCrGRPnR+JAAm8ivEgKQxHDLcQf+RIiLGgENdiFwuH2WHEDFth0Lc0tJLUcdWp2vTjdE3VeyPByyCIQxUD/MFuahmUQqiL6FobK5M0akFVuj308GdSzyA6AvEFUBJs0BUrEsuYadKQ+hG9Q5adI+y0yGoOeaYoHJO4/Bm75VXp1/TXYznhoPWQIa6W8HSYvqDC7UiO8Jt8pYnOilFLZRxHvp/VJbo+GgzfA2W69KV/hqbBFtSnWQjyvHCfUwao4lYc4Ho8zkS1MLMWCLWp5mRq8FykbZBR3z+7bXheSTo9bdfj43u1pAcsO+s2J+viEZTFJqOSNy3jXKl1jeUyqHiQR7ZVXxOLKNUAhOT2p4pewxVzkH6Q0iKp9LzkqLembcFeBBy7RBXyFQoKXXSH/35iv3HFZa3MZ4w0nTc57Qwi8I0Q1YEAg4ATUzsHIAuQFUW9KXMgmAaDgR3xBZaiH5NwGIwVH1OthwHfStyEcHNQh8NReY5tXi7Ye8PvBSdQuQCVKYjDIMgNIPOdQUNlO3MhadcGR43q1sWGXz5wgZ7QIfCcpNHDpPGJ/2bvVc+NP1s79Z5ziHS3q05WEfBeSbbOISBVC/J218Ou993XD6c891wcAI26rPVc+yJpEv4gEYO8F0AobJpWjbzvvX0QFrGv3r/8MPsRUzbF3yXtKqct4cgTUqNMZk9fAfkM6WwABk4Hsrz1Y8/s8K+Y4VbGxA1ApBguxt5qwJm3hYoKJlObYHFtIg40Oak4RkkfxIjTnlOFlpDOYupf+hXs8SPh0SKuTRG+ZYi8L9Gsoo2RRPgZYJwRd1vGFR3M7vhXB7OmHacavMzCQVDrB6+MJG1b5SzzCSMpH65u9hoRegvxEn5+WfVmHY/GE+wufyMGBnDQkfpAmyFTp2pwogtkw405G0NpM70jWp2VrVVL6Nqs3jlULUH1GAy3LrMHir4ErUbFe2rDoHC+mSw/W+xj4LodR4zM4BnDSCHpfSl2o0+pDMRIFq5pKoAsHS0DWJUPR7PKN/qtmZlKN+CLshtdh6Ne5QiKukYZc8PvPZt2649yR4UwG2fjulQshNcmxFN5PFmCQP7zd4rr02fQY3XLZ6yoNvzjFccnaV/UtExLI43q+t0Ps9VKB0INMVkfae6tHA+d1gi13gj7exO9e8slInu3PIUGDSwJElmA2pLpWXhEEn5/7P3JsCWXvldWN233Xfva7Wko15vq9XdTy3pfU/9Rmc/59N4RiPJmnnTmtZcj6RZ+uqCl5kE46VSQBIy8zV2pjDgYS8nkDSE5WEbGGOHNVUxUGwFDiniAmyIIWVjJrYxJsHgBLBZ7NT5L+c73313eU8jL8Q9KeLWu99yvrP+l9//93PTavTcistgtPLYpBbXnci7Lt/5RJZ5DYBURixZnKDQXTVbe9CRbqrt/d6trkE834nqw7c3LtfBL7cqzqgJsVebOL3fc/MLKLv3PDLbKRkH1LVJXNcmoUE5kdRychOWlUPmI+Bo9Tw4+kWYB0dfxDw4Wj4Pjk43D47exjw4epvz4Ojk8+DoZPPg6KTzYDEp9A1x1gA8OvlyUHS/Ywyaek4qLpL5txtQJOPMqiKZG+LRdFUrXox1Ms5Yy3UyLwpDIdi0J8sm6qpuahM40iPlBHgQatTYqVqpkJqKrnYrcQUPD8DJOjiBY6EeFaUMxkHhzK8Rn+TimOL/SddYDYpYQRqyl60CBgHWV5a6UVr6qmrQdPQe0iwqKtkAPakMjXNVdg5rmX5KH0kW4WWxE6RywFlkscrGKbW0yub2K22VjZDNXqcbsdDGGSi0+Yqfd0mRPFF+vfjVXJ4zr8tzuc7b7d6VFT0/tnWMJtfFGZrc/9AT/1cvBu+lbwm5PZAYEkUexQ0zZ+mE6zuTl+NJkiC1ilVdNVQheNMSBxpHUmZUL8x8ZoB9ZiOSkiBIYggV17bQaqSAJ9hT6LMhXN+6lmMDKRfTS7iOGlquoVnthIvBOw3qBuP+6C/1xHf18JN17eiLCEff5YhsDKG5QU4JupOkcA1mhlF+V3cMUsoVw4ybUDloaJVpWZgFEjzM21YQhyT3GAj20rM7X6DVAyLcd5oI9/adggi311bl9dOyicEv1AX4jv7wKXGNatew8iagkLPRjTMUqNg+HIh/ui7+4XqklYz1htO2FFET/L7kgpUF9TKpHmMlEjojEG+tmQmUehFWm8RUGcfumfJPUcfDKVRVjdGsA8GEgw6ZFiW+kMVGzLQBLVt4Y9U4wzyFQApKDeGAg63pHVhkpcDnQzJohTT7eZrn9SbdVDZaEwQlzeasVA0JWoVJRZ1WftaEzmrVXEIlaSOhPUtPs4s5xS1DI+Ngq8xvgW+ykJPmdmMIo2aVC6yYMFjOA4q8kmEGdZ0ZCj3J7hxujyoxAl8UeRCp6KKlhwh1Sw9xnpRqfFcd6RxK/AO9Yf6rThc7vpj5kbefv0CFqWGG94En94fHf7UTMJcrAuYDStTGkPkFbs5QpsxqP1Ak8XqHP6JgX2nJFY5T4y2Ip18TjwQUHM7syXoQLGrYuMNBXpNPiEcgQyyZMAvYySIF30omzr/07uGh0DJTGfhbyt5rqO4vR+alZGYtHrKy7vXw7OHDh48cPip+x5r42R5TZ3HdmcaEtpmQYjmEM0D4XRPbE8YQdcEE0BReKspb1LmklfjXqaLngIW+c10Wnp7wM4EWdBsKIW21xkyY0FgXPKlEh2uY4cAxFSSRc7ZHkUaF/GaSbmnZC0BXsmWbGl0Xj8A5KVsiu45w3HD3U+LjQJmbzpbQeORqvqVwCwyB4jeQ+nc0mFUTI9VN0niQ6r7SEuXuuCmKiVL2L4rtumYilLpdbHTAXRB9Lq8Z+Jbq7qXHxRnXabuTZfTh9nj8ZemMyMvILqL4XJcyjDakDGG0mf7/YTSA/5O+LXnEiwORxBvwEIIOXEj7Cy+cf7UOK4feN4ec4K5ClImZYGDWeSwPxayBwXKquoaJE3xTh6oJ1iPDA1goNjROgfmWht3WqOWo/LQafWcPHx5gjw4TbdGXBZUl6z08lLhhbNYOgGLXyI2o4PUTDaZi24g6tSJiM5LfQSwZvmpsSA3C+emN52b5JkqNzD4k5lxVEBy2rnGxSmbW6K/9/LS3burArIoLms0YjmPNn/DfT/wVNPD7q3kp1jzPkq9atL3SlecBV0TKgVT7Pa1Gz7R/pkCa5K+ktFyyEbKE9G99bHWb/u4axtYcHP9ghTX1xDOOSlOaAFBYSNyT7AeQoCBBH0BCxIkivmigkGYjM1J4GU9zrHuEW0nj31EdNu+UGkiKHbEU+4yGiURbTc/nL9bZxE3uILCaQEkl9BjirDRkIDHZkvYnKstGy6KGlUT8hvAVZEaodldtI+QE7jJEZYjqRBiWpypfZL+pRv/3BnUqctArNF8UOBbHehiBrARWQ3dktrcpqdoaQZiIa7zOevvolqlWGF95gr/Ztv6YSLaTGxbIEWC6fbKjFGZsF42XJaTd4vHqjtSJx4m6Ggn2OaNG5h4wftAYYmc5ShUaZtJGHnwaT2ahnzbGk5kLJqdG2B0cy90hfxtjjQ3B2YTIV6nQYMdNGbFEMHlhi7NNNKGiem4Y+HRumIpruoFQaCKhTVbrJppY8SlrA06DGvOwWH0dIeAPU3X0FzdPMd/yZFsxxRDYBdwknrITXI3+Cz/pTr1H/MLMPOUzdb6mun+ug0deqWrurGzVHXA3A067nDFDpVY4A/OcXTBlOxN18QzlonuD8LFyqkZd23SM4+DPn5faNNHXdcUEJEvnamyirpFWalrhcpo/hWM7f3/w52H+hqyv8fMxkeFH18ZT/n8yo3H+1S0HMM5RJqBn+YKq+iUz6Vfuzkvm/tuY2DBaPhm5p5jfZI4ddlwKPc8auw7YbYB6oUVKIkOBWWwyIW4HVzmT22F6NSnDAh62YxfG5fy2fGFLyfvcEjRSS9wGtnQOKVycA+OcaXORArTzUz8LL3Rl1GPZhZ7+D/DOlhcevU2v7+iB1/fL0+s7Oo3Xd/QL4vUdPfD6Hnh9D7y+B17fA6/vgdf3wOt74PU98Pp+Mb2+o3fO61suRjHroR2d2kP7fO9xcUZ3Umu6SK2VHPQucN7OBdly0OcM7g+tD98jYKqoQNVTCGpChJ1uoqwkS0cAepw0KBhyLT4pvgzGnIpBGGie5keg0hJHuhMWS4zdRKaJoVuixGSrypy/RMkszQLE6T0jI27utfqZnlgbp42WVVtrB1gApTMW4IZ4JEo5yfg1lsfTdMHhcP+qOFtLmUEv7p4e1O0F88rlXn1l/LO9u+uyrJfrqqqEjvyJbavfdjs1FZ2Uv8rZqXedBAzaYgSW1cmlAf59m8OnxeOxLZPDxHoGjId7WTDkt62Jn+4ZqFt3XPrA1V9ZA5SBWFWHNZtpAKxneSp0hLHGlQ4G2Eax2tdDORFtzbpxyNbpQTSEL0WQTo1/9XwhYmo81+rglayFBSsc6FsY9aQ1C0l50BQGdFTdAG0y8NNmhMPAtDMH5Eg+JT5KnQHw+mC66CJA7KQZiEonVMTLhJ0S/ulc1cTk/teIvqqOi4nsXhRDkjOBid8SWy4s2Hz1A+Mf6eBPVCdxXpTBPWaQ+cCC8inKXtM0M6vLd3ZYB7qJrGSkh1eO3UXw6ePKt/OFMTqFnH9rY3hNPKZwdub9rFsn+9/2xDf2tCFcJxVT07yaOB8zrsTea7QhcupCsAKJZ7gQhm4/AAE7BwWduCoIwQThnsxMzgKgld7WBmn2c83tH+6J39VjhleN0x7L7pFB3bMzQRMC8CjK55IyZF4mrZyCUp3wVnn3pJ2YgTALvsNGVlks1UGfERdVBIiIIvwli1fPSOc8LS6ourywuK5ur5s7HQ/H//vW3W0pEec5XxJ0YXGwasOOJxJyWTqdfmZtqMUtQIYHxo2GshdBoAJWpsTVfLh5uC0+l2YYHUMkUUcUxAjIRc05DXNDa7AgIBAoic9CByzyUlI2S+6wUwRDQbEW4JAjWq5Y27MJ2J/RSwJAWgY3m8DgQCjTVVbyxptmmZas/Gru6e2Q8XILi28PxDXSXYbb0hOhBFmlcxSgQHrbSKlAnGL79tqrHxx/12a70yzt+7/SGz4qdibGArwKyknHG+KS2AkTm/m/+wSfH2+MzmXBUX9P96kEmFr+KKqO+raCcu7EuzP+7WtYv1TNM910Z+psYdOWfsOf2hheQX1Ohnl3Id4vCxN9TQeArhvjq8ZIDceYZ38myKpxfsKVmoAy9rU2HlHGoyj28CE2wsRwDgkkvE+HB8DSOncG73StwgN88juIT371tQKf/LBq9lrdg+rmZ3ub0deFlsh69PVCA+uH14ZPiSsEI4UNvIYyRhpEEJ/Fg+z9ggnBa9foCncaimahK4HEOQBoTO5edLP6SnDwPC7OKOQ6YZ6MVhJ6JdvDq+Px3926uymTUcJbdbVa8jVrGa7Skt1s6UdORvegVlsJv/uh4afFl7cw03hLKeJ80HBak6YT8DEY9FEyKQQWOUj+nX62txxjU0OFIjTAFdEBpo7XxxvjvvhqcYeCAODKTqwnwVYUVa5a9Cvpniy4TpGdVIiv51fiq0ZfJb4MfBw3UzACXEsaqRkqbr6ilUSiDsp3qyyyMzVUUkv8H9S9fqk4gJPeyJjlpWtQ071V36uwV4yPudOSUdoWlFLf7F8RZ3y5sQx8K7xFE++KGDjJwkcd9OfhzksX56r/HA5vr736kfFH7hopKf7hOUyQOtQBBV2ghR8aUNLywGBVgd7VRbmHASLyRUBPrapeuN/zWKnqcqUq2WdqNMz/VKOd9t8sEPpKx79DE4aQqY8vG264fb8vb7788kF6zmgRvrUHKqQLBfw466lnH+hOILDlZRNn73vf0vJEzp6Sngl+zfJXz31Aq+1V3EeaYG7JVrMupRltSGlk8jfK17i53eJm30E5ZLe0NDLte8Q36rq5+fbqYyKr78xMOFo2E47exkw4epsz4eiLnwmzrz7pTDh6O0P7+d4VMVCOXETQ8izq+fPZ8QnxqqJiuuT+t0ZBU9cczgxTCb9AIJkpMNGwQmm+ohBFOWuPlT5888bwcXFhD1T7UCmT3VAHsTHxUXGoKdILlqTlUDbu0tay3CoU0SCfAgn1m0wCwU3QmfBp9IM98b09/jyOimg3rZoYk9nKsZL05RbVXiBECZRAZiJNlnFVEDotaoTAKXmLtcYclRKhXguKIrmWk0yaglGFojdNAK2ZGpRbuJgQiI50g9XLEBSBmsOysHbb5Zqc3XOir5i6S0WmT1lsz3xs/Nf76ATETkF3Xp3Xb+rR1YnyNfPtGccsfpgn1fd7VxfJrG6krl0VY/v+TaoONdY1C1yH39ITv97YYtAM5gAsRKsNWK8Wh840JjaWfEsjITZOUVWMJ4Q8XRVkLrQDGpFI4wrltq0+b3IjjHUajY5xf/R1YootMZoCdaQthAyXPiDVHMJfPLnCwVeNtzVBLXxXrRMuqmX5OqPN26iqxFm+vy8ex96sg29a/4XDPOjE4LW/nJ2YTxx3YurCiTHWpT3zstgx1kVbt4MTLUSJvru3bqxbOK1/aHN4U4yM8VrncFrIxjRU22+CY/N/rIu/sQ66pl7rTJUo8+7HVY+uBliU6v4Am0hdNe1uCf8JfIpIOUZTkasJ06+2DNfQDudK4EMbx7G4eTne+zVwtKSr05JK1qRmxV0dOP7DUncQnyGi2rLZlngqjW0Tn9gWlF3zQC6b/l1weuJbDVPSchvbr3LdntFkwtbQTH/8syzeRhJRuL1CKbgObV1adwQoLI7PIcpLl2Zp5jjIJDk4uqMgqpCLR4H6K2DxHOKL4E/oqQagSFBSyrqu4+4Fsc2qTrC66cH7F5gECv/OActcTbYdwTEJJD+Kv750QWwHp/gtjiWebq+9enfcv7selfzKXvx0L36utxF/g5LZed8wpgnz/cq/sD6U4mnsoc+QvlScyZJYVZYsjreEhUwU+GINZiMgJ2hVE5FRrvDZlY5eH26Pt0bvFs9CnSs+m/Yg3FYC5mQbI3VOYw211IaDrqtc+g+9OP6BrTIfUK/25x/FDzCYz7QHWU373WgT69lI2t48Cs+BYfOC7vbz7l5EALqRen6u939VnM1P5sLI1gXNCvnryQSbBM6CNpP2BjDB7oj3UXgfJRmR7UBTOShprDqMFGsUiUN5+uLo7Gdp/tFd8VqoicmL93hb1JynHbCJKj8br/LZAJsQCE23dg8L/9MQXxLDmiK6HiqVWWRad8ua+c8z8+Cl8V/p5IU+cixW050EBxAt4QwFdMWcjuA+y4DmlZNrE8ZkaUjnn23MEmqEY4QaHxJfEpA+w1jYMI1kyckD1t9L231AthGImjfBVZGthOCiNcCHMu6PPiXepKdFTC2aaHPgNj3N5sNDwUhp2UTLNNDwRj6OTOoUpYvX6GjxNQ9itO+YefOhl4+bN7Ewb4KLZYw2uLg4RrsxfFJchRJmx/t7wJxwLknvHw7EV4g3OLurGpXMAVaz4IJxOC9rz4e6ncI4h7TGITEdJ9ISv4EvNhF239I0/DhRU6SBCXUzQSF97P4p8VgCSyTQThA1vclERLhYDTGjWN04z68ZeI7B7T4pLiA9kYbUZJ1rvSGjorXWh/19K56p+SIXp6h5b/BQqBqPjPB0V813zT2BPjj+o+u882zLZk9JqaocvlmWlulT6nV/6+bLL18/zp46N2i85uv2+lsnIKL0fPnxk+aRGCTsCk0M3ENB6qj0Yf8LvWfEJV+DiHrqaBDayF7IwNchQlbncDD852vDR8SwZR48HIz74r8UvwpqEAi9LjVwijOsAjKtmhnMOqLamqQzDWIX7EQ50g0BFnOPhkfFxxfW67PhNu6PniHCDDLZSDd0WoBk8NKVpsWr419RnihzMwVz+SZy3cmycelP1IFfhGS5IAaqDobQGemf0Go+QX77+nBf7GJg3sC5gJsKmQIMVSKgkngK8nqaqB7T/3JspUiYj14WJJxu0mHjHBIp4HOBQ4Ky+2kfQRACdmgGV/UPB9SpN8V5g5QwusFMJ88bYzTu7/MRRh/6yPif9MpeP55zmT1v7TGK7aXJHJ2xRiuHcwtJ1lYnaf5abziCgNhEQ7+AoygpILYthNjSRMDC2Y/ReTGgPgwQAyJb6LJ4CGBb+JNFIhe4Z8lEfX38OcoFX1vEzbcFSuPL7ZKfWh8+IUaww1q0GJ2GVS/ZDRB7gmK9FtmESNoIGdCSfcYcHiMhtjx9HDOs7V5EhmA9ZV4V8ov2r4tzFC9L/kGjJNuIDL/Q59gzwvvQM3r+otgJEuN0aFLS5S9dFDvpbJEq34FtuL32oTfGP7WGEyx+emMi4/R+T63I6/UdUkDvbu3Fg5sK9LrkvO19nim4uxGvo06Y7xDa+BKqFChE6pjztSrftbcoQrcJKb320tnhxf7xoe2fUO4jyTz4HYPh6+JF41qPw96KCP6J9xrjiDqYkIpMSmayvQS/1y3dPCURxSfhrM/PRYEtiJSlgamAWDjeK+hq8iObiUW/JBInWk3FOPwuva1pEx8ZcRPjGr6eThCajYpViLWNind9r2NGnWXf3HV5kkbiDFBp5elUsCXBPcVsYrokXIhOXNGEH4LNURNm8DPqVgD8FCE6u710e+1Db45/GCbjp+Weoog44DuBHdm4qSMhMQ90mTmQ/9Ynq/u9r+hMJ9POivfpDtedZr49R/I3NBLYuxypjsw6V0+r1r74ms4mOdeIeQffZue9rbumBppf1d72weETC912dUvPut4CecJIaQJIoNtn1auX9dkoERKOc6W9N5wmfLCm5ds04+73Xh8+tfBFne8+W7zx+k23/NP/k6Xd6GY/4DkeY16lxwY2g6hhBiyyQmf9lX/SG14Eiv6J9gXBqaeDNB8qdNKMysOVD5rH5kQM9h8TfSZyytEFna5UfCVZkTOn60fH/w+drnNNhmO4Uao0UytTJ9+4Dmaz7pjNP9wTf7uXYbZAF044joAWMIvzUHAVIYvAsUb+LoDZgQTdOFL5se09WIxibL7IYk0HSrAAhSTXPSjAK2O07iCrl+XKFJLKQCAnl6ZojvTq9E6bub10YaBfEjs1fRQgLOoTW+SfGP/DjnH45DyKuAM4GPXBCUDiw3+4OTwrhhP0E2AIxJ/vie/sQRgdKzViPQU6UQ0FJSACUjUGAlrp71Xr6uPiUu4gcGxhhntRtmzKkmXsJLnT8gCHxNFtTNEGBdSGqd3sRPpAyjgFfNtxCcTotngPudgZxY4iS6FgQZVIH6e0Jw0KE1oWNp9t0XO0ijoomP3015r/OhO/xtHaFeddrbG1B3ra1OxuDFytkd/29tqdF8ff3xnLD3bEmgpcwNAR4DBWo7Ptv9MAjB7u/jeX/YeOmOrx06uzkW1CeTHd+eXz4OSpFSNHporFukrism9irUdP59HXIIEVJyx71rmOXnFt0aTNOLMn59VK4LR2DDBbTCmd95e/uDG8Ia7EUIR+jKVSA0bnDcTT4tLeC8/HIJHjFg28bH8zQ//2yIinLQGRKFVfszMIwd/8VD3IlMq75+fsuYfbi1f4nZfHP7NWzoq5Sht6Bp53MsePIU1qyTF9bHJsTEw9vd97fng9o/0X3Td72PY8lTidvHFHb6NxR19M45Zujn9iffg4TA4SjHvrkxCwa4/jCHhzqsSvSSADEwyQYjeAzghtyJ/y8G5UiavOt5lVhE8o35aJbHuNp/HuuXnW+v4ltNXRTT6Bp4iz7IIYGtKWAGc1e4V3vnT8rzrzbncxKKJlKL21emA3tG5MN+KwzLbcAuGb+QcXfJjJH2ZmPbk/vT58WjyBjLITg5s/BdYi19hgAYf4WvEWXIdEonT+6CkSipoJSfJZst5BHEpNNGhDEXBH5z8imy5elQfaSI4rjbdGz4kbPpeBoPOoSd9Yuynf4oPXupbqcHv3SXERdZBZHakuuFiljFJKvf+0uMQFlx4dvxDzw2rrVPr/Drc7u8wV8VAAE6fOHnFUMoYIiP87r4x/tjMJTqzxtsDUGIkzIXBYFN4WdEiTLqOphv+8N7wM0TlXy8bXUGzlaYENxHuEdDUVLQAtuLJTg9o7oJwksfgKalBDe8hK9I9Hz4ldANtLLHfAA4xNkmkbOa+lXC4TeFmcqYOn7BzUCQYiO729duf94+/dOFmdRJqlf2Jt+LAYUN6ZbN43xCtaBmgblTJJytOjZitADSD0q1EUCmuiNRJiQASZ2qU5dzTujy6LMwYPZDqWTPYPFn3nOdHX5EwMOFIXbq/d+cD4m/LEeEQ2e5i9zumYDb0yATxn716UAO7swT/RG14XjxtdZ2gaxQYb6xhwJ66Jc6m/bGicjo3XLqdeLE6ECvYFzHA4W7nG6LqxDiBtOUvjlk6Bx8S2N44u9njx7bU7h+M/u1mQnS/QzmKT3C8PQH7HxvCcOJNpisFCAZDNt/TEZ3vZxjKc5iMIByUxifNbTVDyDhgz9AGWR4KsaWZ+KX7Aax1GR9MEcyi4pJH0KDKPTz7AcNoDOOQtMQ6Uy/IynWA2kMZhN0lOWfKZpASrsTIdAW8RbDotPPdcTjH6Aj2ob4pLsUhdqo4dx+b7jLX16vib1k+lfqnnqvwtCMwnJzNXXF7emymaXLgu3Aki7mkn+fPrw4fFTl6KabKIj4j3g+OMqtON0pyysG3tqm4UjGo9bZnhLaEiC1gmbyWjl0XQrVpcqKtGhVx0ifXCaop81qiNZworhpypmYgEDeZiM/hD439EeqyvIXLfEnL/UR8IP9oEC8QMo1s+ZKzpxNXTGuKEEyQiog/UU8zHV2koTmFhbmH3Hr2tu5au9d/z8PC2+BLFFfJ1W0OECIW6jDB7DhVnVvaOct14U/yHvvgnfQKqZYwXRE/ATyclY8s+nE+nYN1YD0uenGCQ/m58S30kJUr/0zzBSIv1+VZd3ucU0okz4waK39lImAmA9ElqQ5B4i68aC390NkiCHjBDRoyZnNxCug0RUo22AX5vH5huJTouiL7gBVlp3WAMAMmFqAEGhMwhdT+tWhxD23So/E1PknhSWyyOMfRy/kjVRGBZk1Xj0smJQD1oFWhUt/9FgJXYtjrNSkLk8R8UGyW58dIGiW9z/KnQSqnTfzS1z/qwaN9ILhBquxkfSOL+na9Dov+AuzXu0hnOiJcAUFg2tpbtBQREofGFzmdVjWy1YBGtwlJo2t2pCxVJIvNktMgaAKY3VE9AkbMjtj1H0Eyy1NsDDW5zyPvGET5NKweF9jGGV09T+/VEGtLBdxpFNCqmEUizhp43UY6cAARpQywwcqMj4Z4j5iZ02/VpcHKHccafpQyw/p6+y0woY4ht9vi4wJLkEak0klFHbzbA5eZanADg0si7oB1gvDn6np740yCgEDA2n8NxbcU8LSfydoCsjv6E8q2IUEv9wEgT01jZFGKHMGQINwIJgWaiDPm7MSKKxXNYzt5DYiAMLiOCyHNNW6xbT0Xn5DnsZbvPiivg45AZgVNJ8/QD8BQrqw7nnCA3xQVlDIlCTZSeWh+5lsMYg7Uct9fu3Bn/yi64QcyczDN+rPINH/5fsTpNUyOrELqKBmPYqMyEA80zDwuFcL6iqEsWHT43m/Y/RgOi9DTH+07gqD2EB0tETiW686Vj77HMzEm3XamZ3wMxr1i0Crid6qQyJPoUNaExh6kuHwc+zE8l5dBi0RA39+H1ao/jv94cPiUuIlQHqiKbiQutiQXn7QZwpnyD+Lp0meZyXNgAQqdmX8fWTkrXwpGhQ1orJsOFG/oFhfBQ6AQ9+gPrY1HMue0o6YMNILqAiMLctZZtONKxAhhWUNZlWSgKulAocqG/E0QVlM4quPYegSEpnU0pg2y1O37g7bU7r43/dv/uupSKF9fBvAVzjAxCT1U3QjwPxQFDcb+3v1hCZ9iOCT3u6zq1QZ2Ln6Su16FZ2PujvRNcBFZO5rJ4pJyBtzrRM160S+fgb14bXhACkWm4a+aY43PiScSxIg4WIWOtXFprvkcy3z8qbnsufcpa2ThDs5dEnCR6iuH6iYI0TdrgTRbNpE2UJIP8qlzVnQ+P/97W3VJO+vhwbkIIY2lPfBeUVyFQmOq6fWhqJKYirPCnxa/2iOODRQTgOefBdwVsPUyGiEabMWDSskq50VCSFtFa8BY0wXXVhDhBYsoIuafY1LFmyK8Pss3l/SK++wHc+B2DG98ZL6+m8kHeVL+r12sRxz7I+RP2768Nr4iLvs78Zq5u6lB5DmmOxcuu5iUYSGAuUKLU5eIeSJNOKOsQwbfNthlFOT2G70e1uAVRzpIPkAbGY5TZAnFfaOOdXi+Pd+6JKzVIOGYlrGQwZmaSQR1oG7i9dufLxt+/UVpSp8s9Tpw/IZnZv2wxhiy0XWIMEZmnkV/OgLPksI6WQ/J0RI2eEAIewXOQS3Z0TvvOCzwt3ug+Mv4hilWcNkhwYFfsfr/7WKWE93amUuJpccF7a/FwTs54nXVo098xgTnuj7TY9d7SsrZ1gxCEqrHEw1zeQyHkBzvMO7fDvF7sMGfTDsP8R7jBeFvWM3hvF66Dz68PnxI3jAN2jRwSD/XxJfFu8RwH7pIfzNWNsCcA0TJBZIri2m2tcMKP7ogX9154HgIYSjaTwGK1NVGL4mwEwjPykKF2PcJul62F2tewUeyOxNkoJeYxWmAvROf3PyDe7TjAiGp21mS/FPLyUqGxXDVol0sE4hD5lZPSpCd1Vud5Mch9lBa/Y9zFG+MfITjTjUV2yXYylrCM6bwYBKUCPSb9Mz2mzEm6DIt0BIssButvrQ9HkJwg+Vyml6MitV+FeUiawGRzqYIfEWpL0G3WE8xKOvi7QQcjqAoLWXAMLBR2trUn285aCH2Pvkq86ShWjyYJKXMCcokqOcso2ISOItMKgUaWP60huJzL97Xj8v3L4kxE+WeKU7Cj3t8/J/qG9tS+YXbPzoBdFY9gSI+znRaowegBt9fuvDn+sd7dczBLkNBwKtOZaabV53rrUkP4fk7uJQ3oQ0ixAGFAM13AxpIu7Lml+/FvXx8+K55KPpeULVEfAz+aiSGeAxzfoXgNFlGh8WqQ9peCUw50YK1nmmBKucCfigIiH6gTRgfiuvWIQKGljwqt2bkraNYO+7uX5hfhHg73L4ntOv9SF7/MPeE+Ov7L/bsD7HlVV5/rwfJ5ZlGm6yylK6ld6cqVbj/ACmb7/pLYsSBoSx1heTrxeHxhbfgusYchpr0Xnsdj9gUq8pqqEjCcjsv+4UDcQOIOKupTLXFHjqqNbomrSqkcjzezz9GDOhMtDlb6QR8bf3Mnqf7sioKOLTUpKi7nRkwWVHTUp7G8+hTDWe2MftvDRBOscEPCLbxJeyQpmEJMCUCQfMASR8Dhzrg/3hb/07r4w+stdaqaNgVgBrVeLaFoA0fKAGsJ2IcJ4i4MHTIoD25dlq9A7AzKuSI+09KljmTSLRRq8wsjx8AYW4+pMDAjMLUMpOb0RT5Hf5GohR4EVC0klswvQt6WJhIPim3FYxWgQ/KdhvuM5CICR5kZUIq0VyjynItMfPqHP8iSyvjB2oZM6jJBBnCEtWK0nFgEJir5nc3E84gFMGEi1lpUmUi63QjSwI2+Z0v8mS1OG3RxTg+G7Rd/2JoHY/JLbkxwKe0onfEzaSXtXhMPkyfFZYonPpEviW2TfzHFL89fStanzALL7S/58Pn42N7dhNT4V65J9elHJRMnWkiBNYrO8ke7/HhrzuQKr7nkXoz2B4gjkPtDhdf+6oMe4WLp4roN8M97Bx9UjyiGUuJ7KnzRguRA996ekr2j03/I0Wk+5OiL+JCj03zIqgDJnxoMnxFXFHEuNpT5RmqDMnmxNe6Ln10TP77GiVwI7jBU33jSnNAs54+pUYgjIV6e107MbNUFuIB8BAZg0v7Q7gvgbBMnMjo24Kq3/gjBgTCTzqVxytAGxOhriG2FTAsUHXiJrtRrZ0l2aQ74m+A7HBNIEEY9UjV/m2J2nJSD/KyjxB3uoROWf+BSLhemjTINAlchZG4RctHCpgZGm9glEP83PfGjILBmbdoTUEotTnSAVIKdFjl9QkSQG2jzpyPBODljSKykDjoxflcioUpeEo0hn6phBnSPJETQ/JCFlgjFz94lZjK18dQOSGPTdk2tiSQW0vl6VTL17V4VD6lJ2nIl4YGGyhRk5JfFQPn2J7+Af/xrxVuKHVrP7AmE/9KQ2cAiFIUVK1AC7yJR1mEIRE9JE8Bo6tOYM/p6qJwliOfh8PbanU+Mv2S5Ed+yFyKOGQj3yTB/eWl6lm65rCiz7mFxBDqUqyauyrLSA3pmYea0SLntMMPiQXIC1MSkU235zk3PX1Nubpn5/MaspvZNe9Z/tzY8J86WyCaqyjDiKZLtaVe8nkI1NpxfWFMDiS6VSzSuiDMxrduMeI269dMusjPsS6WFw8ES1+3u+LO58u0ECfaBbuj1C+o2ZmmVl7pdP74JnOo+lrII4ifXxBfWvLXaI6jSZwEOwq5LZajYCsL+2tkmzW9tAqGWQmMVs0Y6DZIJoMjiScEIcCmG+PxuufYVGQIGWkyEZEHdBNolVGh8DZuBJV40FVDkDd9RAz+6wb9rIGaKKJXkQVYHoUjAkW/5Ihh23mzSx035HUzYA1dZV5c/ePrBAZ2iLn4JfAuoLhlgwvKT/PI2z6nQgBvtQjGml1hB6KYcA+BqyQAV1WWlWDu1LolBDBwfhH/qOeIyTwgR8bROe5jPmNXIOZbXXhz/lrUuKcRK+Ola1u149iRzl+phFmwEs48Oy/gm5uI5lk71P7k2fFQ81CaxP+PuHW6JZ8UNtBdMLiNElGqYkhFt7+ktDBCO9gDh7Qim0hb+haxlsRVKgPejom88/WC8D0rN2wFee3n8P27iDnAeLWRDFvIWEvdnPd65FeLvuqnfddOMtvnLjk519dIu+xdrw0fEmYnUBTRFfLWYcsBfy4I/xqBFg+eeQtB4IKeQFFPTn8trwGQKyJwaY8tFS4X8o5tQGKWB/shOmciIK90IprL7mOhLEnPYZja//fTHmv9YdzJD3c5/Zfx/UmLtqRPMdxnv9144TQJOAA4NKdOn+B1Lu/zf9aByQxmeoeNN8S5goQErzaftT8fG1KQRmjGVektJ72U93hxdFY9ynN7lXO6Wga4Zb66KJb72/vEfgun46Yf3AEkfCZp687O9dXO8trJEzmBy8H5vNNwpIFIFLsUs//o/uzV8WTxHNc4As3yLqfd9Id9zj2iSPlPfUgb5d1pK/c3xlvhzPfGHeszMlzwDMiax2HfCBH1oW5vSgCV0U2CTWJJ3Txk8zY5O2toajDlggo3BV4hMxYIahZXkfqLyagCuG958VZRQqZu+ALBWXyemgKjNr0JrUzUKJOoKnZkgc9yh4H9QZKfXbTYS0KFt9C3KQsnhptih0P0tpe7p9B8quDr9v7ZDl8yUD4x/AoTK6vlHxlzQ4lrgI+M9K9GHF9AKmxmlKlNBzjlyUCaqfcQZRMvEMtz93EkE0IhXZp5k2qwMec4uhDj/hlk58mM3zC6KJ8VOKAZmGIqwC49LYeB+y9rwKmTwVZSymVDxCy0cO0uOxBmIT4lPEAm3Q5QIcY8RvBycWpSkxF1bkjw0O9CY3gXZbqRoha0oGxZcBnZeDBTOMotTXi4nWnrtg+OfWccd+fqiDFo/+V8HK3AN/9tg+CHx3nYzQQklyvvXnASNmRgNj5fP+Fv1vUbR+JZMMuLneuInezlFYYi4PB18gUr9Ldc1oe+PgdCceWWnmKl9wYesJ7bl8JXsgCvgDYFjY0r6cpyrpd1MYUATND8xQuowpKoN8oIQYsEhxE5xIFHn8Cec2cxRwHEO1N8s9Q5Vy4uafB8In8bpbBH64WD3KuvM2VuhTMti7+3fEGdrCbzt+ZJa+vKSmUxt8tiiI6QJGL0ut+T22msfGl8rrdVRt4RnJ+17EUWx7veuzpdVSFNpTdn7vUfwZk8391SyBkrwpy01FzdLFoTr84gG4MGerd3H29MwFllg/vnDq43hZ9REeZwQ2qFHobGaKvBUasVBZ4Oqcy3mbZ4GVBJ/ss89Wv25R8s/9+gd/9zZ0OsJPvfzvQui7z3PYZ/ncN5IfnNP/HplJeKdQIQRVzBuihTpg6oLh2usiRML2ghgeeGNJ/qGJlTpXtY0q976pN5UVmoJW0+ppPpbWTtMcga03eE3D7cFArVzLoFsDcx8oKYl5jUIatfhkaY68lElrpACs8M8BEAxWqPb4opFHMZOlG21csnxlX4ChT/+SWeKrz0xikq2KqtEucoom6iIt3AbaLpjRshFfsJLI3EmOsK+42+Of7u99tqHx891aISW8QDsTCwG62I9vd+zpzHr+1Ri0RKEfHfv4XSuUAFG6t48mSxQc5OJFjnaKjGqqYCaFTWEsBM2QUzpC73Ui4pquOlEpe9Ms+H/3Rg+hWd+MmSrGYHLe2gSQxj+G8TXEW7PoyortLOgFWF6IQyBkzuXD6k8dzjf5zgbZik2rIgpNNNEKIzESiwsHmmxW1ME1eDx6IhcGet+OR8VbWua7omLqaO8l1I1cKxk02JHwV/xdNlZYkiMx3+nw1Z9o92YCkKYLawVy0bpHPrAdNk1FDPB+H1NcXC07qE36f5RB69S8s7Us/qTrTBNqUijjk7QzqMvsp1Hy9q51Kj6G73hDXEVUgoWgMeO7L7Go7qyvXfYF4+JbWstA2pQ3Gb0FNBeOhK09t6nO1sUlnPLa+N5VD8yPtrs8HAeR2JtYUH5csx/b3gZGdAshHoib0KHG/ABgwlQRaUFuRGk0ocbo5F4KEak8cYf+jHG5MAd9le2+s3xd1CrR4tavSJU+zNbw68QrxN9A5QMOdwdCZOUHJ8XIINGCkDJpg0U1m2gPI0ydkphBokzdqWNeyiitrJGv7uheiFI4VtWJPeUsqF0VVHxY/gg3R73R8+KS+DGhlZzF6BQxFSmWk7cw+HuM+Kca8XiiEkldgKdaEV+QGj6grR5OTpJItB0ZJQscQHU7WNahdmurXmBNOICaCZn8zIP293x++4OCZPmVYU7CaWz92aMysLY2Y606mhXGHfcQT3fVX3YyYnEIDZYXKPzTrZMgjFWTWl3vndFcuiytg3qMudhAvBEbtOXnMQLxt6WTbAVlL03UWah5mXCXmx2KQBLxxU5qq4ffRlzMr4ucq9VE5roW236iY5TnyvWtG/nSF487xMWE4oIsdAAPMnOLITyyeVBaSEYkfS0CNDREAvT6wtrQyn20tOcdA0QpOAUc4iEtmnOysBOIxJCXxY7mk9FMLNMziG9B4Jc8LBaFiwSBLecKE1KDsXiyjWs27vX02qRx95boJ0vMNBjhmF1ztb04Tvj/6XPG2rXCegIyLvYYlqPs+v29NISLBWWh5pTJ/+FreHT4gkSkueUFNg0HLEnru2h+PPr4jvXOSYKzrBFvSiT01NoanPcwk+QsFYqj9Egh2gcU2cHnaTIWzEy2+pqSI0of3TSI1+liJcJkgbGtGJDLu3D6JxLk313KYmnsIaqaJh+uH+Ss575TAKWU0sgwmiJJ4itP6IbMiXaWkclPR6YuloF/SZOWDYLY4R1g0LRJqv/oLlK2erYcgVQVl+h6gAGIhi9QVJhoAIkkasYXk0penoz3la3ue4+i0f3R69BVCb5R1PVaNTC8LNxmVBAApJBg3QLReIMt+r+4fbuTXGZA0xwFrYg3eqFHIfYD2J/74XnIwnaOaRmSA5S9PTRIJzNzY2emjtvzYxfHP+79Q6+/zhAObucWfztuBrjRprmC/K4sxmuVcvn2zeGV9GgUfagpfNiOrJxX3xXT/w+iorbdPxjJqZiGVhMWhqYozEXbCrJ9b1UT+ZxZuCumqcnJDphzlaFwh8G2AAShzwKCIs7COgGeAzM2rzHtVYBOA7nxVaNAq4tfekSamn4u8t/54qBhbTS6MECmSr9xA5WHudXxubuOYVgPfDfA9aFrqawW4tyd3NP3lRV2hhnDXrYMlW+4KlF2+omKifxdSdJ+C+139NE+YNrwwvikYnlDdZSpgnq20bIZWO5CCN7aA68f0sdNEp9NwltYVsMK4u9L4htC4YlPKquJdMkjN8//lOb83Jwc6mw16w9Nb3knBK4x0SfPZQti1mYrZwqXieVfxUlp5o41EIi/w4PAW+rVpDz4yDj7ImYJAQjm+hykVl7u2G8gXbEu5auhg05dXmbIOoz/fILQoVA+q9YFz9rMciMwm3jOhkJsL17XmyryBUOy0QvnxKXNNM1wSp1Prb0ZZjdPuzfXht/YPxHNu/uSNkoK6Eqi6zipxa5N2dAxxTAHNEt2BPR0gAR/UtiRxNpDL1bYqpsvnd0f3v4qnCK01k1MRlRTN/eqzJpPJEQN8mExP2xI8oNrAd98Zt64muZdtPMsMJgYEQjxjdNBQpq0MCZe03EYwbJa3QmcCndteSsRW5OmZfJgt3QkNHXi1+Rji0Fpk33bKZjXmeA4gRzEoypY2cwZtRjRMb+epIFyBAI7NrAyw2xU/SiPqNaAHHEHlrs5Y4Pxy+UAZcn5qaBezrvZ3urqdD40n/Q6xAsdIzTlwzpCtWN9UzTBFQ8qUfq1CHJMPJV46xtIvHfIE2Pt8kA0KNfi8+QjalDY71qOaaMA0SobZyqGlN7/EGD0qSrgYCtCX6imElIa9VYC2qmGkmm0q6gdcaAagirTasqf9wb82iZ05edN9YzoqpOH1M1MZrRVWN9TX9HxVQjQaYp/Wrnde8iyhS+1K+OoRep1OJImsuqP6fsKtNWlCGwBYL993sSafLpMuDZn7m+K529HPrzo2eG394T39JTwDvp7zXIxJSeLEHpv+ZUP6v/y3vkFShMG+J/tLWTildPRecBnRFNTYRIrK3B64xeWvPmEztcL2lhkdTp/TXxc2SkpXmkK9pvIkrQ54p/DZRqFkqlcoFDDvPjkkdGeg/V2rly+FbILH0mbRS0p1kMD4Cvko4k8GMsE9N6OYEKwqDRiIuE4qPCB22IfN3YQIxZQJVf02W4f1LWkTZO47CaAhK5VW6fuwXqlB04L3bNeHvkxFMkj2iLmmpD+quOjmMsYcib1nB3Vwg2GHH3NfeqF0rT8XBnhcd8UWzz5NEDpbW06X9toGj86vitOaS2M4zakFUrIQZFPGc0jxP9Omn3/8BaxzP3ZXrud/Z+iQ3l6I/06vbcrGP1Czvd9NL2BZ5qeQN7qxPGC2UYj1i3aaEd6FOstPz43c7WXjoILuaLrs2LxsPgM9z6Ux2DdwVXlTp1o3NLnu+Ud654D1FQ0s2zW/CP9sTfyXyy0J5FE4A4W1vJ4xbAwK3mqcFMCzA9CBeBJVsWUq3GZ5UMj0R6J5gzqlk6a2DScLGu3tFKKRW1ixEMosKz+kv9YRS3/DEzr5ucK6uIi8j77+2Jb+rZkDd4hYtNk83MTLZIBoVkfQe5oM0wwCyzrwUMIFo3rYrQSjqHEL6CJ5a7RxC7ImZSlcUU/cPByEI1unUtNhpgHBCSifW0LTYbuMJLv8JwEIjRlojlw+H+NfFIAP1d4iNAjbs2AK8vss/uS12Mw0H2JfuG9TFMXcBCxh8ay7tzq81UPcubXKg5bSrVeHu/91d7qxFsr72jg6NGH35nn1ff7z1zknTDWvre11YHFJ5NVnVn7C2F5mn0EWuYNpPqfu9w3j61yDG/Qp+NZQXdD7nfe/okCYpe/HzvCTHwlkFFA29niCsLobbtKG2eU5bnY7GC/2Zv+JAYTKzLAPHHgSLNIvGIk4ymtWBgjK5Dob8lsXLXatXorZOlM8d3xv++ww59a3UEZCNLqaxSGnwcuYwwWPHWJ5uABPZE6oOKlSGGpjZ1h1omxNCyj53sslPR+OBNC2h88MdfxjQ+448UND69DiVYn4ZhYXDvp9eGT4krE21jPdWt4Gv6dOJLwOBCX7xXvAvMJdajaawGPtGoIbEBtP8aD8GqVRbh8Gx/dFkM3cTnKiiXVWwGu5cz5BPK62JReYcLIV0Q2gtCe8HM6nh9/L/2T1QS10p72HgqXgu3zDGduXb5evuDG8NLRMNDs9EwhdZAvI6c6YQQB8ZDKpxPW4vLO7svalSLinNNIgIdla/RS8KBmTKtNfDeYMbeT6Tx09ow+sOQWKqNMldLtmpglwq50E7R2v77BRO+SZD9aOzEE20vLxOH8iQQKkzGVytOMmDtCq9ZKRoreRWLTEKxEtdrPH9VPBIjktJFxo0NIiOCXmJmn1AK9d9eG78x/kdUPDdXNX4G0g1r8UTVR30oWTues7whzgERI1IVqUz8NmDRT/+F3nmxnWtLB15KpaSUElI0a8NrALDj+EOaI9Yx1xpAZ35lVgh3rgmhggtsjMjm6NI2EgNhtHXjbE4Rpi0mEPc37L7BV+2TdV8jZc8oioN0ZHncyDxyRpHgEEaTrWuVjt093V9F5MeL9WPjP7Z5d0sq7OW5GhXXs2zc0qX0s/3h+4R0NQX6wEZ1EJXJh0Cu6qbw9K1wr5RGHW+Kz66Jn+jhcpyqZFLRatPIsRCISwxXSMUc+ZmEBLdA/D9IWeFaxgooL4yNmShUedSoAqga1IHFw8dQ2ksZvNGG1quxWYaOlQANtsdaYsfAUbVE3wwc58SwERyg59Il0eEo3epsDEzYPd4aGXEzrWKnAOZdc3Fr8nDqzG+Wbq694oDL7nWxU1PAJJlU8B+F9T4XdHdVnI1BSqqSBgLHyPs6GOafGH/fRlcG45GOXec7OsoHbWTklU5yrPCgnzrRyGbX9uMz6IbiSV6j6I2hMjY7MWlLdpiWhvwM4h1hWCakL+jyo7/8JNWPVnPJm8ETomgylJI2wVVUJHS89XOr0+xsyeT9npwna5e+EXbzCSbvkQW86uqMLNsPNzHoe/IY7rEs5C7UXtbTXCAw0HLGUC/MmG9dGz4proJf0EY8S+5Biym3a1Dqpg1CITJK2WakzS6q9sRmQupqGatmyuRYLlAp2OQWbnRf9uL4e/tLsv+AANNYVRGXMxMDhPi6eKywDbzKOzZD1hZae39sfXhdPF4HX7dgXl+QOYPpcVNc1D73n5FcSJaWqPZaAZZi9J+LX5VO+7q9kNOAnoAgUy6UBgYPwrAwvAa5/iGJGaa0PaG8SABjHNvYksO2PKjz5C8fFw/HGkhzsp08iDWhA/Sz4gnGhVBpJXCd2CxiqwdRzdXi+cgr49/UqXGOp2Ez25poH+tZZcjv7p3VHiUiaojrL0hQbgyfFteRZaOr8hfuNRnlfbh1OBDvFQD7hgK/YvqnT67BeIQ/11VjmFnurU8WkMrRbfFuyJxqWRQzMpgpw4OZ9NfQSGWk2SAoLVcSGjyefLf0UMa3FRjL4+Eb/mFmOD483utGzReEZ7YwnTcaaP74vBUtKkXVqm6MWqakdIxpx2Ru+OdOZiEupLa7CS6zAStRTjgxnPrCBD0n7PD7N4b/mXjLxDZMd6tGeXhIZde4acZ7jYmFBkgoN0RDOW/aRUxblg6GU2kbHZ4Zb4w3xWeg2FQpiktiUglCOqauCk1x3VbGGpIIIkeXS2IRdJcOR3ZsLSjIK9mitMpXj7dG++IiUUl7ov6scqjQyy44+AJHEg08NbMlvwvKwwP4FWkqei7NDWUKZ2tueRnPwNfH/6GzIdgZq6Rup9S5TgfJpiwQfWb4aGmjpB4vrBk8yeeLFz4UJhKpNtNNyZGQ7Ejk6fFHHxoKcWZiiHX7MzXGb/5cT3xrL1N/IuEWxss54D9tvENmNlzjECFELKKC0kUHQXJlmHSJ5amRWJOY1ph5NxckGtyamO3IgWns23cH3rqrIh2XicpG5xDWVd/Tw+yH1lxPZKfHiMv28SdTtz/NSCJfYDlS/JVrT/Movznu312PSn5lL366Fz/X24i/QcnZ+EA31kb1GFu4ju73/mwPjwp7oqNChBb9htx51Wg/5NrRAz/VDVMxIYcJ8R4d1FNdVaP3LL0W6abqaeM1a25UTciVnXp3K17fu4kkZt+7hqpwmIBa2ujHuNG+aPVh2xI/JVCFgt+TlQoUwaSiRFQ6+J9NjliHiQV12tF39/hRAGuHuWdB+qqiRyI2Cs8pVzUG4lEW/wRzmtykmCk6HPtOGQJjkXNK06sMhi6QiC63yUDjuWWIfLSNNhXN6EAsatZVGJQue/RdJ0BrhvKG98zLqR0bBGJL2kJqr/b+o/9Ip97Rg6n3Dk+9o9NOvaMvcup1qhvNRNInQNnkfCv3B9eGNyDCJT0o6IKFApZuFrQ+HIgnIS0hPcQMXSE9oweahfFHI7EDCUYOMAedzcvFVulFRnHMGqTzzv6Pjf9tRxz/XacBlRaSQ8sd58JKOAl8Vy8NjP3OjeENcbnW2SuAozdmMmQM7b9fOJXpSVn7jis7kajQIwNhlgpjaEubwB33R+8Wt1yoc40vVylkRpTArFt8e5n/XcwLngZJOx4kHnH0GmqdR0/n6FF33F5/cfw3+t3S/0XQmfpk7FHqFExTLsuSnyA7uRbVsqFfBfFPXQiwA+hCpXKWpLANf3Jt+ATqx0NQjzDZ7Gofbh72RUAsM7IFkq+J2BAsXyf/PbDWwSbCoTdHv058tY05r4CVSZiYsAWDDtOc8/ZJ8OaCRZNZcDNIWVKiN9Nv2Hu6Hyy4RrvnxVBNDAHq9KaK0Jg54ZjzYuhIQi1d6BRceHvt9ZfG37YJjLRqer9nFoUPR4E+hBMlMTdp6Rr8bNrjrkYp25TLRNsZOLi4KM4gZoWSGX2rQdZhdEHsaBI2g79rB+O6e04M8jMhDJX+N+erd8XFOqgcrPe2qV2O1Fsic3v95fFP9+4OpZINaiUQOnsVL/wOVmXUExeWR+j/HibKVc0K42JXnE+bjoOoQndTYUW50dNYsY4TpIaT09eZ7A4CPCfJNLz+peOf6S1Omi8AoaqT8ND/g3PD18T7KGsAp9dsbX28x0KLuT6Nc1aeMd6Ft31G/MS6+IF1JqPS04kyHvdjMkIsCigZ3se5JLDi7EdmX5QHRMhDYIMDTVVX6I4RPxoRdrc1bEQpLZWhGrhYcqg6JusmiWbFVFf4qlxSRnS2cBMYTjZL9UUquiNytwOmmGAKX4rQ1bQBYBKECSAUiL8hxQZFx1EqGlIe0uAxBfHHYuPI/Ivo5mqWl5b6AIsqYENrMucAf4C2Ic+8TMNNZieGTfQB9bEhZMAUAqpUhEYFcVXOuvRjWVg8ehwcem3axHiBatq9KgY6B8C3s0FDs2U/il1v61bLVEqAtNsKNifKknSIuzm0cUWcgQqSkLFUoYVMpR8dPwJ+dMcqnV9/Zfyu7ooqMxqAHeo6x3C8aZnpHxdUwT3U+Q66+rV5y7X76KdXLBVaI7kY+Ur2MG7NtXIPWsON+cf+QK8Lk5r/jb9OQWkHuggTbYm9BRYTcPDRn2ymj3bZI/CEfKQ8P83NQCrNNf1Ky6eYd1VXcXW2gabTwLk9/CnEz88Zvrl9olTq6kxU6IkKpOh1Z+d2vF8N92rfsqFkEzJF0QJK8sXj9Q2rY/XtXXcokzd31DyTgmW2lpONh1nSAnOs3Ryjft/wyeN3He+qs8XN12+60UY6gYit45mlq/F6Xo1HS9SJOtPkqPfBJWvw+qnW4FHvYOXyK/ymeNT775evvOu/iCvviIkNFiy66/MW3VHvy0+y3q5/EevtaKbkccULYKkd9Z5dscq6w/Lp0yTD3tkFdsTO97K1VbS2Puq9+8TL6vqxZfX5XjqnbYGK1oNgc7Qgm4ZXxBkly5OTMxrxcPCF3rNgzWqUTW98QNcazUV9RjvbwqjPJFftO7aGz4ln0BJMVlasEJQpZc4DGh87BE7iUDzvfaYDRavfM6sPAFM8mGBM8MF8GYTY8y21138KdI+RYmA5QMiqoAql+giVVDMkn3NJxJQFA1mRm1RK+sX8ot1d8TCiY2LF/v+Oih0Gy/2RGKi6/bWwaHZmnJ1zDKRpKYZAaDO/7/ba6x8Yv3h3G8XP3vrk/d4Lw8FeRngQGSD5piGMbiiOYsRpSZRKtVpNaEEUx1P2FyfGt5jnA8O3ndDBn8VO2LkhoHDCMrmj07fz6GTtPDpZO2cvW9DO40mvQZB58IMsBr8IbPyZ3vASYK8nmlR+Mj/TpnhSjKjQjrhBGs8xibc+qTe1Vi6OzokBCgSlGbqJpcuL6Y+F6Guv6X6vlYZQwuH4CPKDZSh0E1o033/8xxvDZ8RViBDjko5k7nMS12e+JXEARFqkj5k1v23BzKdKQqUPind7x2BeB0izArQHkhosQmFqAKN5iNRmxEu7RK+IQeTmACVC4Yfs3xSXEHwxhaPMFU3X2zWjOsuum4oPgwZxqxGZGuQZYQCbB7qlxO2P2UHigIMfDSRWW7qQbVe3rH6vf3D82fW756RsgDpQ68Z70srrMDI9M8PIVKCf+iRvTDbZQtqGDVc3LUHxSkLw83O7PZvvCyQy5iBLAEu7oJBqFvXzTyHIXiizWmIjCDUF2TcPt8V50Q/MgB7qDIhayfDYzpJfkgyPr99GhscMiV8L9c8f0+Lwf9gePiuepDIyJg44cBzugSh6G/IZiE8D2SKkgHxJ08kiOJC0wmO2iQrEcLxUjMRsl7Nu2ksrhWvpM+Ze8VflCfeTCZ3fL0Ita45TuXv5dIslXYCFmAraa23epVZ6dXZlVzxGdi9RRc9JtIzETiR+CiLtlTPgn0qMAD+uObwCcaEcLfR1vuH22uuvjr+tUx5TtaThekHMXXPM/f1dr6mwzTsRz7ZHRw8X/5H6PKM7zs6cgrRbrNeyHm3UspYndGufa01YfRr0pW+LGsJqP+C5Qvo8fRB5kSfvu6N3qu+OTt8jR6fskaO33SOf710QgzR4FHNP/+xSjFwQ/ZppgutsS5fIrZ/oDa+KiwTrQ3vck4arv3e4Pd4S18SjWGQzzeAoLKWBBQcQ7WfAjcAYKoDyfRMmRKQlk5VaZ6peitytjJB/aPzjnQj5M4sYhrjS4QRnz/CPrg+vIgOxs3UzKQG1yV0Zb4lbYpR+S45GOmHQKclewjD9FqM3IaSrR5fE0E9UJsjz3vu0DWUOoctiJ0ASnY+xrMLU+ebLYsdNlMuXubRnKSXhuLgz/rdgwH36zN6EK0uqm5/tbaW2pPX0WJf7ewPZgPVpcsGb0BWziciSHU8evZ0nHi174tKR+sPrpAgwwVmZTpXaGMPY6YF4nzCm8AX1tImyanTUkAvIJP8Gy+KU4kIXQ8y7bnRLXAslLYZWjZugakfG4ebgdwuy7hYZAafqpE2gFEU/+oo4GxVpVy/A9R6I6/BZ+IWp5abWDaWsc7FTukKBMPvrr41/sMcE0aeIdiTXTZ8mALM5MSsoD4d/ZWP4vJCkAcqJJe1DkVhybGaAHGgntSTeEK/kHL8k+mZJ+qGWGDEmmZ7VEVoASx8j1ONz4tW2SMnR14u3dMzXe6KyqquCOUS5RlvksXJgT0TeqNCayequZO+j3Eo1u4bhK1buYx8e//vOPrZYewa/6X7vXHc9b8I33++ZlouoWEZXAT+xqAPv967OZW7rawvu14mAwmeIccDbppbJQih9FFcyeZxXjY6Mo8x0rUvn0F/I2X+IpvlYWGY4V4QRFevxZ6qU5AE0PjnUQGRVqAQhinz0DHA1B5bhAVWyUv2deOAW8x4/JvqOpa8cqjxs3V57/SPjv7exmumNsJdrzi8o2T+g6gm3qM4ML/CrJEL++rEs9kUxVJmcRw8Ux7VGNyASpTRWY4Yia82ptpVz+fXx983LWi+bPCfMWv/l3vAxiLppzcctgBCE6GvNOAOSmpPiqVpKNtHNvU4MLLa8crpf48ev/K43xj9Je+rVRdmHjYleMZN/3/pwT1yfaM+BE0SNtaye6XEvwK6xI6LY1wx5Ib8o1NNaQml3E9M/WEXPdAUOXhXv8SXX6FufJD4Mph71zB5NjPUkFd06yAWr3g3mFSqbWCqf7OzfEKKec0ndXjK3Sz86/jfrK7sUDJVl1UWwRT3TmWVzecDgUUtH59s3hk+Ja0Erl2vKJ45jxRy+BuyZEjfSZRBjYLktQ3pBnreZYbrEaiIYqEVFt6TTHhPxDvQ2AqEHOCbGt2r5NkgHyOKYTzrQiWr9ciQdeP3jBenAjmr2cKSTqbwZtMob0Hf31oNWC2NT/2BteF1cSIdNAZZx0y4R7SfEITLBs0VBBD5gLCAwA5ChUyRcVghYqyDTZ7m04RhkEShjD8QTxAKV/Dwi6cQeytEKy/IUq82PT4z/WgdqqE5S6LkJnwy7tuxl8calqM+arl66CO+vDW+KJyRvOU1RTFZ38LWPi7NQQKbZgEz+JqDPLFK3holvqVtDZk/fvSgGstXqkryRbR/D1WbIZhePh2T6uEsWIbu749+21pFsOL5bbaF6bPahjlMhrhCm+JrhUGxFyhCIs/xvvRHruh7BNatG+40Xu8H+7+714gJpsY3hNXSveBP0tp7ZCJ8T1zx4wjqtwGQ4pD0xbQcy6z97WwcMvI37o/cJSTdQyovIIVAeDCkcYNXz7KcnaKPwCQ8oWL743fBpcQEHIWChZM2qJjRYErv69tobLxf75aOq2YPvZDXItGt6W5e7preLWVt+qje8DIGVtG95jOUHXtDiWXFNa/TlD+op8K4jdUPMurPbmmDUIxQF8sBnDxR6oYzueyLPWLkUvnT8z3p3N6VslKfkijlN/GJrAo7s0hX7Y73hWTFkMQXQlrViT5c60xpI7kGxwTV+AkVqoWCjwUAEysQGTPAgJL2QTaDKs5Vf/IHxj5AVe8pIjV71od++PnxaPKEYRlpkEiDrU/CAivcKCsggOASS75pZ6VymHQ5AxVod1+/aQ4CtBS/SlwY9qkm38PwlHD2Xc3IefqoXqONfFkOfvH6WUWtxjrfX3jgcv3S3L3Edt4wPK9l+OYp+glN2CxWp3in6nrQMvy/5II/jfjaVjVJgRISDuiinH68TUeubABMGIhAsO9KIAzFUiYmeM2igN0HjXmh9E4C/5Vg5MD929KKwVk0URbwakuvH1pAG50SGHBluB7dWJ/RD37g9/uG55ZAIDk/bV5EmKDpw3Rg12jBGhWTWqHnFk8cYg+00NLWahDhN9zyJb7DtG2ZmgCmevJyLODl4mNZbZTb93t7wIpBPkKDTLQ8YAgiOXEeFaaTbm8ChVFHWW28YJdXovHgowM/5z0HXcmUfvzr+05uFKTG/Zd8GBl1L+chtbLkBiFl+H5ACGutziQQeyAFzRIaq/w/7aN7pgzZoHjiZmckWHBQY0A3750S/5r9y0p1VL86RSQdBH/4tf+SHxn+AePufXOSlDtM3AQjsWKFOaduFpf30zetwUiimQzjcFv8VitohWwV1CSHfNYLKAKYNDgT+BSPZlililY6MMS+Yuxs3YUETheg1JrTKWIyREFuUE9r2/oRL7s74H89dcuFERw3V560pvbu1F68DHwyCC6jHl95G7ki+8/pCDR+/u5GuWToWf3FWRh+GY188EZUuKmKoCKiImxjS7Bu9KT4ImQepmrRV2gLEgmifmuuTUAPGMMyfiEYssOxmK4COnd3zYhClYt8lUppi/zHRj6wOH+kA1JfEmZje3V5OxVUzw/ba+AfIJnh2NRbkZJr6v2t9WIkn0/eDVnNHqtm0AgVUuvUCanoSCC+2xR8ydUUus0BVBWafNPf0pqYSrvcKRVWqUP/gOkIU9AhICGSNCljqQTnIhO5eyB6huaf76Z9a68P+/iWx4xxV66N63xK5nDc+PP5W2ihiZ/IV4dxruAcv/LDZ3cOUubClbGMrhaJ/ZHtoRIXEoIDXUuqW0q3Sx2yYq384PDxz+JD4lp74bEtwnPxtgLvE6UQjPbF16W/pXz7AH1lCCi6OVKmcL0cvySLzIjlPCn+Gsj0X8qNB0SCzKxRs6cPRbfG888qrtmRTThRwKCezctqgLhx6asg8WE+szyqcriRO3xPnuIazVBns1nOCJsSTID0U08EV267q1Kqw9fi0uOCAJl8rNJUy49HQ1cmmh4k0fOmS2I4uF5C61gq9vfbGR8Z3y7DMU2hXFJthd2muW2uTZRFXgx/POqVhxgEuSuYozqdOVElNTsFZR+DEdJSoavRw5799NXqk+wdV5fe8/zTveWSiLUIu6AikLD7SFMwpUPUzFPRrkb/w6O13ztEvUOccvVOdc3Tazvl875LYdkrzGinWW95Szs9qnlprD/uFd/HHN0m/eUHk/OvFr4g+0n4igTUQtdE6FbscIAlE5qRbngdghsqF2RA2ihpkq3h9RR+1pmjR6GvEpH2fR1VWKh8GuBrKxNGxgISTsgkRqhSJU0rlRswQBfHL6gehqXc6UP/GG0XgSVDgKbOoQ+Qp+lhGnqI/Fsa8Inaij17V7Vh5VeNYpbn6r3vDK7Cj6ybQVM0F5VtY3azz55T2xg24i4Sb8l16i9S+tlZL5b7x0fGf7Erl6uMnvr7fu7IICbBWH7vVndjV+P2DoRV7Hbxn8s9qIB9t5Zoa3cpRArGV+Bfr4ofWs0ZGMtiCbJKJpJjPFw1hmHgZmMpzyaLgnoLSWsrA3nIc4qdEpSWlvWmjNMwx/DLSTlQs51ZepW3aNriWOF0cSVsmL3B5oFG7L/nojfdVYzzqOgDuO4NSVzyXeVMR9JFb69HKqaeS0BWhrFzmMCWsSF7FMmtAWtTTt7x0SdJKk3nIkFkG15pWmitm8StMIQauDciqTizjSWPDPoudEoKCioxxPyOrusXaQq3vu8S11DopZVHRHlu9MTOjW/iMuBAl7sxka7LgTFfmeL8Sl7QsJYnS1pIpG6XJCJpBK2NcsxxC3eJnX3pBHASpJBXzILSIMSCRexAoAiXqLA5DYf4d7txee+Nj43+9dfcRnK0au1Mx7J4VpmiZzTEY1rU8ZVBPu5iZXz/dAlYXCAw/RQQyNNskrCQoyUddVRzd0a3uZbNX4Sg2oSpLW0+EzrD58mc7n5dVytwsOc88LMcTArSf+ZxCKpUWU13YEL93fXiF4KaEurOKdlng+ryEmsM5KMuM7XGkxVMhtCdLie5iojcO5PIkZ27weJwbnGNGoUR/62tCROhLiJ/khGu0XdDeOdG3xGoyyDVyt9feuDv+uR4EjaXz0/kgmblhxlJf7ERMENqeapAZzLz04PgtW8Pr4hxBh2oA6mdUEStYiS8BqG/LI2WIPo90ebR17TCYLIY6oNCwFDf2XngePGRTEh23OtFAxF/wDTwuHo4yFzAiS3Q7iI+Lh8HAMq3w1iBjIY6RO9ckad7Ssbbh/jdfHP/7gkbxc711+RskcOat7N53e6gyTnt6t2uItUJhDbBPS63SINrnG++qNIijD3dvdnBjWHkfv4mwfr5i7hqaFX+zt3oWvQp5KbT3wJhLoxLa2tcp8mzgSIF8eU0DBdtNbFyoQFIl7U9vHH8YNhFyCKF6W0+lj3lyETvSJlCq0VVzHaLZ3bldaEuXwk+uDytxjZNLaMMkAwCkEotMCq6Jb+qJXwOnHRkmtomqko2Rad2x5yElUQ8jSUmB0eKDEgPnGsUxTa4t8ROF+vEY86BAGwCZM24EV1cUzwbtC8pcBrCnx8rGeIq9FSy5JKEwEg/hKjPT2eN8/7I4U+efcIGpORV0ZPu++YHxN67RFhimM9j94yOYTtfRhpba0ii6ReTfF1ncCrZ968jTq7rlcKyBVoLMjD3BiH//Q8NHxJBPF6In/ccb4m9vtO4sgVoL6lgE6CTn3GJdEm2GxDCgiUZGYwxQmcxTQ2SD5bOwet4g6UzA9AjamsnB8lnhHMBFlghTbX6II/l4khOERB4QBKYFGdPSxrY4wjrbTGJTtoW00sHU5W9S0AbS90BJXary4Nb4Y/3Dsq5IEQAOpFQe6+sDG8HQOxFrKOtp65HjptaSchE9rwLmGzuRBnrFBra+qZMh2hInyrGAmgSzoIwxSCLnIbp9lKFyE4ksP54q+tPDYtaRVwhvL4eKBh2EKzGirYiwVrOy1IHhfj7wdSEOCdKQUjmMNo/7owdz7MEc+3meYw9CV+9Y6OrN12eFrZbC99OzlggMD/duqgrTnHSx7B2xDtDcKPZBeXFb0b8QD/Id68Nb4qYlgx45/jhVj4R/scjSHw4FZkE0anDPIHQQSQnCWTt1qwWpt2vLP+5SRAFtkOR6cZFOY6Wj0HKLLh+SdZGDDgWufK518Ynx39+4+zD48o0iB6VbQb+QPmjbStfEJhe6zqXKnAtqXSr1ru10gUzXDDDErjZC/s5Dw6fEDVi3OcNpTDOhsAerVB4OxL9eEz++RtpWqgUEsOIiq6Vi1U5GFZhcDWBVxgsYFMV2sOSA+gY5XTBwhwGmtL2wNq1FB8dPlHHM/5sGxGG9JxwiAPAC9hzw7QjJgFlwnVkCc6sy+wuyv9M+Zxn8gPAIEj9lOVVH4l1Z55xAFI7p27MCK2AiFLZNAWkxa08Z9rHIkc3f6IhKhS1t72sJm+noDw7E7x5QsM4AgwP3KRn0MHpNHhckMlSoImwc575BJQXAgWSnp7MrkCCJa2pMc+PAgcQB4RAalllREN0zhcwssD0S9WCFf1QQAcR+Dk0NGdeGpNZreiJ1BoyNyydqOgxjY1XjdOMlxRaZ8RSwu9DpzjfRkHytDKwsjmIKeI5anFowUgEqIQKQlKKmSuAusgCVhFuYEBLnjKMirikn7WjCgYpIOm0CH6KYNCKtlvxJSvtA7EAgQRXaoKxDNCb2qyfGSVC8eeuTVVV+VRpXRdzhasr6vZCPBq1nXzVBYeqqqWMmsIRHQqc5mskTjhkfpJFwgdaZhI5TKEEHzYWusLnnfFr79Azme5w2WjVmQsaAchwCNk2NPN50pkom24SVUXPqC2LLgYEqqFkNXxMnMkQ+pOEbatgcHJFRsswCS4FhmLtBbTz0VNtvSjZiZJkNGoj0bRPrYewaTi9QuSCMHwxfAH6PtqKLXqZ0wcICamC2ntoKNgiUOXN6gmS9pBkD+wZNy7TiAk9Lqv5u4gSnJM0dn4dOkYGLCmemhljlRDGZ+sTYbNjobFrie7DgI+2WaG0FHEDcXhC5ZWjGWZyDjUsmIQg2B/wF4wA4FbgsFer2mhiguWmD4ph6K4e4Ijn15t3xj80FctWnKQ7emoAdfgK01U+vD98Ur8gsf2f4sA/wn85hiiqWktO37L3qBVb9NFC6G27FGQUY8bQ4I72vAe4CrKLwX7kQmK8cVeKiKahvDVt4oOrR5sEHh8Pdm+IxEHokFJjhHEib3Ng6HOx/PfCQyRgi0gXBqNLOlalZmlhTRMxi+oNOhGRrTgwAaeBGR1M/A2pjliHdImrUfXE5osJ45FpBlamuB9HGtm3PPykeVVlShkkXhipkdrPB4fCli6JP/a6HrmU8H95e++iL42c69SOb0KmzQ3tDnDUcpIfEot4xhgrbpUqjk4zPf3msxiSAxFUJGfhq8YkAH6e9hyXhafUS8RI7oiYiNyLq1pF2oCONScpdmarxJqaX5Eq7aDUiHMf90WvivfgqS5kyQKODYGzNtNloMGAT6ChtgpbF81A2+QEg4J30qj5aVqI8jICAUKABQrSd6r1oFzo8f3dt+Iy4MfFxSqgvIjcHTLIjTHLEuXdbcB5ZadDLCCiCgbRfadIyhwRoDaI8RgsM00w4FEBi+iKySaNYgS4BaTZfuHthvqTAfvKoZM6Al6CzeXv4R18ZP9+VfSh9ntB6oNss2gG+x/7WzZdfnrNfXxLb6HEQeo5ePWboz/AbN2kh62ibBdifLxMv6mht7Zn2ytxrNK5sXTNouUYpkRAsBE+ScTmBjBEvMB2t1oTiGP1a8VX0SJ9LvemR1mXCb5PJInyyxo2lHcOikeSBZK1x9Be+zHdfauSDVf2Or+oPHK8vc6FE+ejuutZL1vVv3EpTEOfeZ1AnzoBcomyn4Lf2xLf0jJVtNFWD5UkBNAvsmWhFm2SZkYmLNVq2CRMsvWezFgp1SSNHT5DaFjy2kAnkA4lH4M6hJ44ciiamg66OPMOMld5yleR/HM18sBDeuYXwwePHW1lmaay82dHIXzdWLogQbQ+9uEWSIVCLZ1zBveMz8U6G07EhLL5GTHC6sYjmgc+eGbkauU6P88/srVJcH0E3OkufQA6hPQ5bnPToK8XraX+neZseVyd7FzjPmrpm4a2cXjUw1sy17Fk7vtUzySViLRjJiGtU/wcaE6oFneo8o1UHlnQ43L8mdqgyENhAy9rA4xKLF8W2D5pZ/Vi+6XBwe+2jr43f6EpBL4g6biVTva4zOHp3Eaqvhere791crPO85ttnPYGXZaROWQijECx+GsLtdCbmZ8vVuIctzG3kW57rBFTngp029cTGRbwC8yl6dYZOn6Drjlb1ydHb75Oj0/fJ0an75OhUfTJryB2IJyAUmGZcE2UGPqK4Mmh9qLrWUsq0K2ROvLXhu8ReG+5NzYktPGHiQguEdVjU8x4BOziJJNvYMhejBitmsQxyqcWYS3o0Qmx3AURO4TjFhcDunu67ehUtE8MR6jZhoJDz8PbaR98cfzsBb5dV08ypdr5KLN3IVy8zXnITvD3uqR/qDR9HrirrpjIZkkGzdJm9d7glroCYt6OfGDlMde03APbm8n2pu7uXrIrdfOz94z9O37e3hHwqk3jYxXVD58QwjTNVj20hvm34PWtDJNvCIm0MytAkmrDpRurcOBe2DvuHQ7EvrnoeE5PLjqaNZ8+Zh/4Q9PxJhwsD+RFJZQPEJtOA5RIuqvrsHw53z7HfZO7pLdzTD7eWdNTr4+/cvJs25ivIpcY86meoXdDY+71rM1q5HdyIj9O3j8L+UfRDkZE3kOiwnRETdEh2cgtUtfWEdXmZmNdMJ5lUcFtTuevostixDM0DumOjieOk9S6J65i5Ty4w94lDWWH6+7ye+/ir45/ryAkv5pkuMItz1amP6++fDrSozQlwO9+3NnwSGQO4UhN5B0Np/Yy3xJeLD4fI8kL2XqNCC+0CAB4JN2njInEVxExW4IGJB7QGKbIOE5TNnf7h9nhrdEWcCRjdZtmkwlZZsbA/8cr4ezosPa7ADc9Hsp1FegRsUpx2k5pLmXrmI3i7Qdve8Iq4GNsIivaNNxWScR8OxHNiV3uELmQ4/LTxaXKHyJzueqB9jZwKoy8VwZuaPPZAIu5glGOwxUDFJG6rNaAxMjHIwBvCgy+Q2l/cq290tPKzeb2lfd14s4Dqoj/cFecwf9iGpu2tOrPegzX9lrjD/psxsrFG6qpxWsZQtSXEhYYtYDpNGrOasnwI+mhN29Z8Hoy+fU38jjUWYbV1FjOztWO4DfLsSEygJO8oeS1wqeJUZIRUMG1seDDrMG1saJytbO2KhqbvsDUWsNboXlBVguekmW9znBFP7/RPSHpEmAXKxUZHgi8qmR0q6AhwbnUEJ8OTog1q2ziCgloUzqQGaSQmV+nPNA9ChhPngdi9MG9GHA72kUUkenRpapifLdt39Hllgs6/z6yufpbq+2vFNNI3SgRiKUdTWEfM3hjVit3da2Ig9o12opN6pOEqM5vpOABpGQoY8t0Xx1e6fkVXD2CGLrTdUefWJsyyXXdvOQGr13r0ctkWf5w1rF66E82qdMzdia6IYfQqa+lHz3yrg45s6NH6cE/sphlqmiirFqqrFXApFjDdZJid0aqUwdFZzX1rhGK+AWAWkMMs/E1Ojyxi/z2++ZwX297whZ4Ci+722t0749/YOVnn8oKiiaoW44aOnZJ5718CgQ2ZafxUz5z1ueY+c+lB8q8eGn6D+Lqu0MlnfDKHfKEIBGWrDSmmQuUBkQpEqEJooxoHrUwl2I+oUegwDuKnRX0fbtPj9fGG+Jsb4n/eoE2OQg2gWjBBuIvOwG1JYA0L0tj0Hy6kFd5ECzdBXsBPM9sfIKs5oU7hFDNNHzBlmQZGp4D+S0VsnRrQC7giMNQHm56Bt9aphRqLthiJA4clfCJlwiWAF4GXV/tmYsJU85tgizaUy3LY6JA+deJJX9OzmGfaeTWQ9jqAh5ITlxqH+MZ0StVT+N1UXEtnCmsWKX7Lcj86F6lP0ymEuRBE5UwzikVTlVo6OCMjeKg3gIiA/AGM3acjkxvA7WeJJz9tSYMbE5gUGEUuyScFTxZriyOHQuldgDSlX7AAAkgAyAXsK0wSp7k0qsQokIsN3UQpomOI+sPB7p64EmWua2y05LQlPTaj4ObsIV48HX2GS2ndJBMDQrAhpEb6LLCDda+ZVuDux8a/ubPJ/BczHk5hwH8UA2YWiZ9Q6D19dsM1jLFRgeo2dPovE5rgKnRM0uWINnFAQO2Tu1RNQmxPl1kLVh+rxWhrz64vctc5tnO/93t6i/aifW0B+QzgXMZOABt2TQleT3AiPboNH02r3MKS0hLOC101ptY42XTjtOOSFVojvEDyq6gY/2Si26wa+U29k5Sp3EKUjyHUdtqDgAYf2tsi45RudF2NDk56tUmXU0OuLeTlZ/Ggz/dmjI9i7nxzTyEOh8nqoKS2hjfS2zjATD4Th/bpagLV+GL9FWYmTy3H80qC0YhgJPQVvK7QwqLW1icpznxY11nju24cF53YTtCw86FDFLZwtoqjbe1i42w0q8+9/6ZHfE8twRD8QDSeF8rfWmKmha7MupRq/Ie2SrjxPLpktYq6Z0WzSi91QROPN+uPffHN+o7e8GGxg5LbTGOO7bqJpaJ4uDdhUjpMJiszLPeuoZU/tnUi53gLG7GcxLY3PCO2gXhJyXuHZxZ2YdmUS+0d+kx29WItsXn/HDvxZmcncW3M5aQcgMOjHpBb0arsduZo5gc98CdEjUEb/+UWV0tXMxGhkkGKXrC0kb9zc+jFHoLkPuMz6svdq15odHSc2uJJWoK+8Eu+RFSQHkVmB0uRVoTUJf825Dq5cE/3DeY0D/soZ77wW+/3xDf3FKUmgYmdoHwkjJZsO0c6aVCL7SfS0sblsSyEMlY+85o2npFvXOMPH0ikYNYRWSrFPZt60srdneG4R5onOAQ/tTWrH1FsVVvKN8bqzJeulxYcq+Rh7M1LT8zE7YDDNHmiF2c90TnH3DFc/dzLtoyduDDNEgrLmN3X/j8Zm8xi1ELdmq6DNWewgjcp4kh4skI8xiYW0bDDSo24jU0s4HeIc3P1MnGJCwmaGiIdFGVcq2nvwUlk9lYREjcFX9EZCzmGoMbUEH4yAogJ6QOC4/AzJKurEdH7NjUEX7eB5cQgJPUoE1YQLVivvkSLKVO8OXQSIxeXEBv4ILFaD26iggCppPgB8aIiNi+ixBm6G0RhdhpxI0pIQ5ijFoPKNm5QVw9esgVgq8CkUBQZccKNCsA25A128WV2aKQIIZ0CgSXhQszFF3SAAAAA///0Mqy+3boCAA=="
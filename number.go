@@ -0,0 +1,574 @@
+package phonenumbers
+
+import (
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Number is a compact value type for a phone number, for parsing and formatting large volumes
+// of numbers without allocating. Unlike PhoneNumber it has no raw input, country code source
+// or carrier code, and leading zeros are only stored as Parse would set them.
+type Number struct {
+	// CountryCode is the country calling code, e.g. 44
+	CountryCode int32
+
+	// NationalNumber is the national significant number without any leading zeros
+	NationalNumber uint64
+
+	// LeadingZeros is the number of zeros before NationalNumber in the national significant
+	// number, e.g. 1 for Italian fixed line numbers
+	LeadingZeros uint8
+
+	// Extension is the extension, if any
+	Extension string
+}
+
+// NumberFromPhoneNumber returns the passed in number as a Number
+func NumberFromPhoneNumber(number *PhoneNumber) Number {
+	n := Number{
+		CountryCode:    number.GetCountryCode(),
+		NationalNumber: number.GetNationalNumber(),
+		Extension:      number.GetExtension(),
+	}
+	if number.GetItalianLeadingZero() {
+		zeros := number.GetNumberOfLeadingZeros()
+		if zeros > 255 {
+			zeros = 255
+		}
+		if zeros > 0 {
+			n.LeadingZeros = uint8(zeros)
+		}
+	}
+	return n
+}
+
+// PhoneNumber returns this number as a PhoneNumber, with its fields set as Parse sets them
+func (n Number) PhoneNumber() *PhoneNumber {
+	number := &PhoneNumber{
+		CountryCode:    proto.Int32(n.CountryCode),
+		NationalNumber: proto.Uint64(n.NationalNumber),
+	}
+	if n.LeadingZeros > 0 {
+		number.ItalianLeadingZero = proto.Bool(true)
+		if n.LeadingZeros > 1 {
+			number.NumberOfLeadingZeros = proto.Int32(int32(n.LeadingZeros))
+		}
+	}
+	if n.Extension != "" {
+		number.Extension = proto.String(n.Extension)
+	}
+	return number
+}
+
+// appends the national significant number of the passed in number to dst
+func appendNationalSignificantNumber(dst []byte, number Number) []byte {
+	for i := uint8(0); i < number.LeadingZeros; i++ {
+		dst = append(dst, '0')
+	}
+	return strconv.AppendUint(dst, number.NationalNumber, 10)
+}
+
+// the most digits we handle without allocating, which is more than any number can have
+const maxNumberDigits = 32
+
+// scratch space for the digits of numbers, which we need on the heap to match them against
+// regular expressions, so reuse
+var numberDigitsPool = sync.Pool{
+	New: func() interface{} { return new([maxNumberDigits]byte) },
+}
+
+// ParseInto parses the passed in string like Parse, storing the result in dst. Numbers made up
+// of ASCII digits and common punctuation, in international format or national format for the
+// default region, are parsed without allocating. Other inputs, such as numbers with extensions,
+// letters or international dialling prefixes, are parsed with Parse. If the number can't be
+// parsed, a ParseError is returned and dst is left untouched.
+func ParseInto(dst *Number, numberToParse, defaultRegion string) error {
+	if parseIntoFast(dst, numberToParse, defaultRegion) {
+		return nil
+	}
+
+	number, err := Parse(numberToParse, defaultRegion)
+	if err != nil {
+		return err
+	}
+	*dst = NumberFromPhoneNumber(number)
+	return nil
+}
+
+// the compiled patterns of a region's metadata we need to parse numbers without allocating
+type parsingPatterns struct {
+	internationalPrefix *regexp.Regexp // matches an IDD at the start of a number
+	nationalPrefix      *regexp.Regexp // matches a national prefix at the start of a number, if any
+	literalPrefix       string         // the national prefix if it's just digits with no transform rule
+	generalDesc         *regexp.Regexp // matches a whole national number of the region
+}
+
+var (
+	parsingPatternsCache = make(map[*PhoneMetadata]*parsingPatterns)
+	parsingPatternsMutex sync.RWMutex
+)
+
+// returns the parsing patterns for the passed in metadata, compiling them on first use
+func getParsingPatterns(metadata *PhoneMetadata) *parsingPatterns {
+	parsingPatternsMutex.RLock()
+	patterns, found := parsingPatternsCache[metadata]
+	parsingPatternsMutex.RUnlock()
+	if found {
+		return patterns
+	}
+
+	patterns = &parsingPatterns{
+		internationalPrefix: regexp.MustCompile("^(?:" + metadata.GetInternationalPrefix() + ")"),
+		generalDesc:         regexp.MustCompile("^(?:" + metadata.GetGeneralDesc().GetNationalNumberPattern() + ")$"),
+	}
+	if prefix := metadata.GetNationalPrefixForParsing(); prefix != "" {
+		if isAllDigits(prefix) && metadata.GetNationalPrefixTransformRule() == "" {
+			patterns.literalPrefix = prefix
+		} else {
+			patterns.nationalPrefix = regexp.MustCompile("^(?:" + prefix + ")")
+		}
+	}
+
+	parsingPatternsMutex.Lock()
+	parsingPatternsCache[metadata] = patterns
+	parsingPatternsMutex.Unlock()
+	return patterns
+}
+
+// parses the passed in string into dst without allocating, following the same steps as Parse,
+// and returning false if the number isn't one we can parse that way
+func parseIntoFast(dst *Number, numberToParse, defaultRegion string) bool {
+	if len(numberToParse) == 0 || len(numberToParse) > MAX_INPUT_STRING_LENGTH {
+		return false
+	}
+
+	scratch := numberDigitsPool.Get().(*[maxNumberDigits]byte)
+	defer numberDigitsPool.Put(scratch)
+
+	// we only handle ASCII digits and punctuation, with a plus before any digits
+	digits := scratch[:0]
+	hasPlus, hasParentheses := false, false
+	for i := 0; i < len(numberToParse); i++ {
+		switch c := numberToParse[i]; c {
+		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			if len(digits) == maxNumberDigits {
+				return false
+			}
+			digits = append(digits, c)
+		case '+':
+			if hasPlus || len(digits) > 0 {
+				return false
+			}
+			hasPlus = true
+		case '(', ')':
+			hasParentheses = true
+		case ' ', '-', '.', '/':
+		default:
+			return false
+		}
+	}
+	if len(digits) < 3 {
+		return false
+	}
+
+	var countryCode int
+	var metadata *PhoneMetadata
+	nationalNumber := digits
+	if hasPlus {
+		// a national prefix in parentheses after the country code is handled by Parse
+		if hasParentheses || len(digits) <= MIN_LENGTH_FOR_NSN || digits[0] == '0' {
+			return false
+		}
+		for i := 1; i <= MAX_LENGTH_COUNTRY_CODE; i++ {
+			countryCode = countryCode*10 + int(digits[i-1]-'0')
+			if hasValidCountryCallingCode(countryCode) {
+				nationalNumber = digits[i:]
+				break
+			}
+			if i == MAX_LENGTH_COUNTRY_CODE {
+				return false
+			}
+		}
+		metadata = getMetadataForRegionOrCallingCode(countryCode, GetRegionCodeForCountryCode(countryCode))
+	} else {
+		metadata = getMetadataForRegion(defaultRegion)
+		if metadata == nil {
+			return false
+		}
+
+		// numbers starting with an IDD or the region's country code are handled by Parse
+		countryCode = int(metadata.GetCountryCode())
+		if getParsingPatterns(metadata).internationalPrefix.Match(digits) || hasCountryCodePrefix(digits, countryCode) {
+			return false
+		}
+	}
+	if len(nationalNumber) < MIN_LENGTH_FOR_NSN {
+		return false
+	}
+
+	// strip any national prefix, as maybeStripNationalPrefixAndCarrierCode does
+	patterns := getParsingPatterns(metadata)
+	if patterns.nationalPrefix != nil && patterns.nationalPrefix.Match(nationalNumber) {
+		return false
+	}
+	if prefix := patterns.literalPrefix; prefix != "" && hasDigitsPrefix(nationalNumber, prefix) {
+		stripped := nationalNumber[len(prefix):]
+		if !patterns.generalDesc.Match(nationalNumber) || patterns.generalDesc.Match(stripped) {
+			switch testNumberLengthOf(int32(len(stripped)), metadata, UNKNOWN) {
+			case TOO_SHORT, IS_POSSIBLE_LOCAL_ONLY, INVALID_LENGTH:
+			default:
+				nationalNumber = stripped
+			}
+		}
+	}
+	if len(nationalNumber) < MIN_LENGTH_FOR_NSN || len(nationalNumber) > MAX_LENGTH_FOR_NSN {
+		return false
+	}
+
+	// leading zeros are counted as by setItalianLeadingZerosForPhoneNumber
+	var leadingZeros uint8
+	if len(nationalNumber) > 1 && nationalNumber[0] == '0' {
+		leadingZeros = 1
+		for int(leadingZeros) < len(nationalNumber)-1 && nationalNumber[leadingZeros] == '0' {
+			leadingZeros++
+		}
+	}
+	var value uint64
+	for _, c := range nationalNumber {
+		value = value*10 + uint64(c-'0')
+	}
+
+	*dst = Number{CountryCode: int32(countryCode), NationalNumber: value, LeadingZeros: leadingZeros}
+	return true
+}
+
+// returns whether the passed in digits start with the passed in country code
+func hasCountryCodePrefix(digits []byte, countryCode int) bool {
+	var code [MAX_LENGTH_COUNTRY_CODE]byte
+	return hasDigitsPrefix(digits, string(strconv.AppendInt(code[:0], int64(countryCode), 10)))
+}
+
+// returns whether the passed in digits start with the passed in prefix
+func hasDigitsPrefix(digits []byte, prefix string) bool {
+	return len(digits) >= len(prefix) && string(digits[:len(prefix)]) == prefix
+}
+
+// returns whether the passed in string is only ASCII digits
+func isAllDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// a group of digits in a number format's pattern, e.g. (\d{3,4})
+type formatGroup struct {
+	min, max int
+
+	// the minimum number of digits needed by the groups after this one
+	minAfter int
+}
+
+// a part of a number format's format rule, either literal text or a group, as expanded by
+// regexp.Regexp.Expand
+type formatSegment struct {
+	literal string
+	group   int // the index of the group, or 0 if this is literal text
+}
+
+// a number format compiled so it can be applied without allocating
+type compiledNumberFormat struct {
+	// whether the format's pattern is a simple run of digit groups, otherwise we fall back to
+	// Format for numbers using it
+	simple bool
+
+	leadingDigits        *regexp.Regexp // matches the format's leading digits, if it has any
+	minLength, maxLength int
+	groups               []formatGroup
+
+	international []formatSegment // the format rule
+	national      []formatSegment // the format rule with the national prefix formatting rule applied
+}
+
+var (
+	compiledFormatsCache = make(map[*NumberFormat]*compiledNumberFormat)
+	compiledFormatsMutex sync.RWMutex
+)
+
+// returns the compiled version of the passed in number format, compiling it on first use
+func getCompiledNumberFormat(numberFormat *NumberFormat) *compiledNumberFormat {
+	compiledFormatsMutex.RLock()
+	compiled, found := compiledFormatsCache[numberFormat]
+	compiledFormatsMutex.RUnlock()
+	if found {
+		return compiled
+	}
+
+	compiled = &compiledNumberFormat{}
+	compiled.groups, compiled.simple = compileFormatGroups(numberFormat.GetPattern())
+	for _, group := range compiled.groups {
+		compiled.minLength += group.min
+		compiled.maxLength += group.max
+	}
+	if leadingDigits := numberFormat.GetLeadingDigitsPattern(); len(leadingDigits) > 0 {
+		// we always use the last leading digits pattern, as it is the most detailed
+		compiled.leadingDigits = regexp.MustCompile("^(?:" + leadingDigits[len(leadingDigits)-1] + ")")
+	}
+
+	rule := numberFormat.GetFormat()
+	compiled.international = compileFormatSegments(rule)
+	compiled.national = compiled.international
+	if nationalRule := numberFormat.GetNationalPrefixFormattingRule(); nationalRule != "" {
+		if first := FIRST_GROUP_PATTERN.FindStringIndex(rule); first != nil {
+			compiled.national = compileFormatSegments(rule[:first[0]] + nationalRule + rule[first[1]:])
+		}
+	}
+	for _, segment := range append(compiled.international, compiled.national...) {
+		for i := 0; i < len(segment.literal); i++ {
+			if segment.literal[i] >= 0x80 {
+				compiled.simple = false
+			}
+		}
+	}
+
+	compiledFormatsMutex.Lock()
+	compiledFormatsCache[numberFormat] = compiled
+	compiledFormatsMutex.Unlock()
+	return compiled
+}
+
+// compiles a pattern like (\d{3})(\d{3,4}) into its groups, returning false if it isn't a
+// simple run of digit groups
+func compileFormatGroups(pattern string) ([]formatGroup, bool) {
+	groups := make([]formatGroup, 0)
+	for len(pattern) > 0 {
+		if len(pattern) < 4 || pattern[:3] != `(\d` {
+			return nil, false
+		}
+		pattern = pattern[3:]
+
+		group := formatGroup{min: 1, max: 1}
+		if pattern[0] == '{' {
+			end := 1
+			for end < len(pattern) && pattern[end] != '}' {
+				end++
+			}
+			if end == len(pattern) {
+				return nil, false
+			}
+			bounds := pattern[1:end]
+			min, max := bounds, bounds
+			for i := 0; i < len(bounds); i++ {
+				if bounds[i] == ',' {
+					min, max = bounds[:i], bounds[i+1:]
+				}
+			}
+			var err1, err2 error
+			group.min, err1 = strconv.Atoi(min)
+			group.max, err2 = strconv.Atoi(max)
+			if err1 != nil || err2 != nil || group.min < 1 || group.max < group.min {
+				return nil, false
+			}
+			pattern = pattern[end+1:]
+		}
+		if len(pattern) == 0 || pattern[0] != ')' {
+			return nil, false
+		}
+		pattern = pattern[1:]
+		groups = append(groups, group)
+	}
+
+	for i := len(groups) - 2; i >= 0; i-- {
+		groups[i].minAfter = groups[i+1].minAfter + groups[i+1].min
+	}
+	return groups, len(groups) > 0
+}
+
+// compiles a format rule like $1 $2-$3 into segments, following the syntax of
+// regexp.Regexp.Expand
+func compileFormatSegments(rule string) []formatSegment {
+	segments := make([]formatSegment, 0)
+	literal := make([]byte, 0, len(rule))
+	for i := 0; i < len(rule); i++ {
+		if rule[i] != '$' || i+1 == len(rule) {
+			literal = append(literal, rule[i])
+			continue
+		}
+		if rule[i+1] == '$' {
+			literal = append(literal, '$')
+			i++
+			continue
+		}
+
+		// a name is either in braces or a run of letters, digits and underscores
+		start, end, braced := i+1, i+1, rule[i+1] == '{'
+		if braced {
+			start++
+			end = start
+		}
+		for end < len(rule) && (isDigit(rule[end]) || isAlpha(rule[end]) || rule[end] == '_') {
+			end++
+		}
+		if start == end || (braced && (end == len(rule) || rule[end] != '}')) {
+			literal = append(literal, '$')
+			continue
+		}
+
+		segments = append(segments, formatSegment{literal: string(literal)})
+		literal = literal[:0]
+
+		// named groups and groups which don't exist expand to nothing
+		if group, err := strconv.Atoi(rule[start:end]); err == nil && group > 0 {
+			segments = append(segments, formatSegment{group: group})
+		}
+		if braced {
+			end++
+		}
+		i = end - 1
+	}
+	return append(segments, formatSegment{literal: string(literal)})
+}
+
+// AppendFormat appends the passed in number formatted like Format to dst and returns the extended
+// buffer. Formatting doesn't allocate, other than to grow dst if it's not big enough.
+func AppendFormat(dst []byte, number Number, numberFormat PhoneNumberFormat) []byte {
+	countryCode := int(number.CountryCode)
+	if numberFormat == E164 {
+		dst = append(dst, PLUS_SIGN)
+		dst = strconv.AppendInt(dst, int64(countryCode), 10)
+		return appendNationalSignificantNumber(dst, number)
+	} else if !hasValidCountryCallingCode(countryCode) {
+		return appendNationalSignificantNumber(dst, number)
+	}
+
+	metadata := getMetadataForRegionOrCallingCode(countryCode, GetRegionCodeForCountryCode(countryCode))
+	availableFormats := metadata.GetIntlNumberFormat()
+	if len(availableFormats) == 0 || numberFormat == NATIONAL {
+		availableFormats = metadata.GetNumberFormat()
+	}
+
+	scratch := numberDigitsPool.Get().(*[maxNumberDigits]byte)
+	defer numberDigitsPool.Put(scratch)
+	nationalSignificantNumber := appendNationalSignificantNumber(scratch[:0], number)
+	if len(nationalSignificantNumber) > maxNumberDigits {
+		// too many leading zeros to fit in our scratch space
+		return append(dst, Format(number.PhoneNumber(), numberFormat)...)
+	}
+
+	// choose the format as chooseFormattingPatternForNumber does
+	var format *compiledNumberFormat
+	for _, available := range availableFormats {
+		compiled := getCompiledNumberFormat(available)
+		if !compiled.simple {
+			// we can't tell whether this format's pattern matches without allocating
+			return append(dst, Format(number.PhoneNumber(), numberFormat)...)
+		}
+		if len(nationalSignificantNumber) < compiled.minLength || len(nationalSignificantNumber) > compiled.maxLength {
+			continue
+		}
+		if compiled.leadingDigits != nil && !compiled.leadingDigits.Match(nationalSignificantNumber) {
+			continue
+		}
+		format = compiled
+		break
+	}
+
+	switch numberFormat {
+	case INTERNATIONAL:
+		dst = append(dst, PLUS_SIGN)
+		dst = strconv.AppendInt(dst, int64(countryCode), 10)
+		dst = append(dst, ' ')
+	case RFC3966:
+		dst = append(dst, RFC3966_PREFIX...)
+		dst = append(dst, PLUS_SIGN)
+		dst = strconv.AppendInt(dst, int64(countryCode), 10)
+		dst = append(dst, '-')
+	}
+
+	if format == nil {
+		dst = append(dst, nationalSignificantNumber...)
+	} else {
+		segments := format.international
+		if numberFormat == NATIONAL {
+			segments = format.national
+		}
+		dst = format.appendFormatted(dst, nationalSignificantNumber, segments, numberFormat == RFC3966)
+	}
+
+	if number.Extension != "" {
+		if numberFormat == RFC3966 {
+			dst = append(dst, RFC3966_EXTN_PREFIX...)
+		} else if prefix := metadata.GetPreferredExtnPrefix(); prefix != "" {
+			dst = append(dst, prefix...)
+		} else {
+			dst = append(dst, DEFAULT_EXTN_PREFIX...)
+		}
+		dst = append(dst, number.Extension...)
+	}
+	return dst
+}
+
+// appends the passed in national significant number formatted with the passed in segments. As
+// when matching the format's pattern, each group takes as many digits as it can while leaving
+// enough for the groups after it. For RFC3966 format, runs of punctuation are replaced with a
+// single dash, and leading punctuation is dropped.
+func (f *compiledNumberFormat) appendFormatted(dst []byte, nationalSignificantNumber []byte, segments []formatSegment, rfc3966 bool) []byte {
+	var groupStarts, groupEnds [10]int
+	start := 0
+	for i, group := range f.groups {
+		length := len(nationalSignificantNumber) - start - group.minAfter
+		if length > group.max {
+			length = group.max
+		}
+		if i < len(groupStarts) {
+			groupStarts[i], groupEnds[i] = start, start+length
+		}
+		start += length
+	}
+
+	// whether we're after some digits and then some punctuation, for RFC3966 format
+	afterDigits, separated := false, false
+	writeByte := func(c byte) {
+		if !rfc3966 {
+			dst = append(dst, c)
+			return
+		}
+		if isRFC3966Separator(c) {
+			separated = afterDigits
+			return
+		}
+		if separated {
+			dst = append(dst, '-')
+			separated = false
+		}
+		dst = append(dst, c)
+		afterDigits = true
+	}
+
+	for _, segment := range segments {
+		if segment.group == 0 {
+			for i := 0; i < len(segment.literal); i++ {
+				writeByte(segment.literal[i])
+			}
+		} else if segment.group <= len(f.groups) && segment.group <= len(groupStarts) {
+			for _, c := range nationalSignificantNumber[groupStarts[segment.group-1]:groupEnds[segment.group-1]] {
+				writeByte(c)
+			}
+		}
+	}
+	return dst
+}
+
+// returns whether the passed in byte is one of the ASCII characters in VALID_PUNCTUATION
+func isRFC3966Separator(c byte) bool {
+	switch c {
+	case '-', 'x', ' ', '(', ')', '.', '[', ']', '/', '~':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// the most numbers we will expand a range like 0800 123 456-9 into
+const maxListRangeSize = 100
+
+var (
+	// separators between the numbers in a list, e.g. commas, slashes or the word "or"
+	LIST_SEPARATOR_PATTERN = regexp.MustCompile(`(?i)\s*(?:[/,;|&\n]|\s(?:or|and)\s)\s*`)
+
+	// a range of numbers written as a number followed by the last digits of the final number
+	// in the range, e.g. 0800 123 456-9
+	LIST_RANGE_PATTERN = regexp.MustCompile(`(\d+)\s*[-\x{2013}]\s*(\d+)$`)
+)
+
+// the regions which write the direct dial digits of a number after a hyphen, as in DIN 5008,
+// e.g. 030 12345-67
+var directDialRegions = map[string]bool{"AT": true, "CH": true, "DE": true, "LI": true}
+
+// ListNumber is a number found in a list by ParseList, along with where it came from
+type ListNumber struct {
+	// Number is the parsed number
+	Number *PhoneNumber
+
+	// Start is the byte offset in the input of the start of the text the number came from
+	Start int
+
+	// End is the byte offset in the input of the end of the text the number came from
+	End int
+}
+
+// ListError is the error for a fragment of a list which couldn't be resolved to a number
+type ListError struct {
+	// Text is the fragment which couldn't be parsed
+	Text string
+
+	// Start is the byte offset in the input of the start of the fragment
+	Start int
+
+	// End is the byte offset in the input of the end of the fragment
+	End int
+
+	// Err is the error parsing the fragment
+	Err error
+}
+
+func (e *ListError) Error() string {
+	return fmt.Sprintf("unable to parse %q: %s", e.Text, e.Err)
+}
+
+func (e *ListError) Unwrap() error {
+	return e.Err
+}
+
+// a fragment of the input between separators
+type listFragment struct {
+	text       string
+	start, end int
+}
+
+// ParseList parses an input containing several numbers, such as "020 7946 0000 / 0001" or
+// "555-1234 or 555-5678", as if from defaultRegion. The input is split on separators such as
+// commas, slashes and "or", and abbreviated alternatives are expanded into full numbers, so
+// that "/ 0001" replaces the last digits of the number before it, "ext 10, 11" gives a second
+// extension for the same number, and "0800 123 456-9" gives every number in the range. In
+// regions which write direct dial numbers as in DIN 5008, e.g. "030 12345-67" in Germany, an
+// unspaced hyphen in a valid number marks the direct dial digits rather than a range, so ranges
+// there need an en dash or a hyphen with spaces.
+// Each number is returned along with the span of the input it came from, and fragments which
+// couldn't be resolved to numbers are returned as errors.
+func ParseList(input, defaultRegion string) ([]*ListNumber, []*ListError) {
+	fragments := splitList(input)
+	numbers := make([]*ListNumber, 0, len(fragments))
+	errs := make([]*ListError, 0)
+
+	// the last full number we parsed, which later abbreviated fragments are relative to
+	var previous *PhoneNumber
+	previousDigits := ""
+
+	for i := 0; i < len(fragments); i++ {
+		fragment := fragments[i]
+		digits := NormalizeDigitsOnly(fragment.text)
+		isAbbreviated := previous != nil && !strings.ContainsAny(fragment.text, "+＋") &&
+			digits != "" && digits == normalize(fragment.text) && len(digits) < len(previousDigits)
+
+		if isAbbreviated {
+			if standalone, err := Parse(fragment.text, defaultRegion); err != nil || !IsValidNumber(standalone) {
+				number, err := expandListAbbreviation(previous, previousDigits, fragment.text, digits, defaultRegion)
+				if err != nil {
+					errs = append(errs, &ListError{Text: fragment.text, Start: fragment.start, End: fragment.end, Err: err})
+				} else {
+					numbers = append(numbers, &ListNumber{Number: number, Start: fragment.start, End: fragment.end})
+				}
+				continue
+			}
+		}
+
+		// fragments which aren't valid on their own may be the first part of a number which
+		// contains a separator, e.g. 030/1234567
+		number, err := Parse(fragment.text, defaultRegion)
+		if (err != nil || !IsValidNumber(number)) && digits != "" && i+1 < len(fragments) {
+			joined := &listFragment{input[fragment.start:fragments[i+1].end], fragment.start, fragments[i+1].end}
+			if joinedNumber, joinedErr := Parse(joined.text, defaultRegion); joinedErr == nil && IsValidNumber(joinedNumber) {
+				fragment, number, err = joined, joinedNumber, nil
+				i++
+			}
+		}
+		if err != nil {
+			errs = append(errs, &ListError{Text: fragment.text, Start: fragment.start, End: fragment.end, Err: err})
+			continue
+		}
+
+		if rangeNumbers := expandListRange(fragment.text, defaultRegion); rangeNumbers != nil {
+			for _, rangeNumber := range rangeNumbers {
+				numbers = append(numbers, &ListNumber{Number: rangeNumber, Start: fragment.start, End: fragment.end})
+			}
+		} else {
+			numbers = append(numbers, &ListNumber{Number: number, Start: fragment.start, End: fragment.end})
+		}
+
+		previous, previousDigits = number, listNumberDigits(fragment.text)
+	}
+
+	return numbers, errs
+}
+
+// splits the passed in input on separators, trimming any whitespace from each fragment
+func splitList(input string) []*listFragment {
+	fragments := make([]*listFragment, 0)
+	add := func(start, end int) {
+		text := input[start:end]
+		trimmed := strings.TrimLeft(text, " \t\r\n")
+		start += len(text) - len(trimmed)
+		trimmed = strings.TrimRight(trimmed, " \t\r\n")
+		if trimmed != "" {
+			fragments = append(fragments, &listFragment{trimmed, start, start + len(trimmed)})
+		}
+	}
+
+	start := 0
+	for _, separator := range LIST_SEPARATOR_PATTERN.FindAllStringIndex(input, -1) {
+		add(start, separator[0])
+		start = separator[1]
+	}
+	add(start, len(input))
+	return fragments
+}
+
+// returns the digits of the number in the passed in text, without any extension, and with a
+// leading + if it has one
+func listNumberDigits(text string) string {
+	number := NewBuilderString(extractPossibleNumber(text))
+	maybeStripExtension(number)
+	digits := NormalizeDigitsOnly(number.String())
+	if strings.ContainsAny(text, "+＋") {
+		digits = "+" + digits
+	}
+	return digits
+}
+
+// expands an abbreviated fragment, which is either another extension for the previous number
+// if that had one, or the last digits of a number which otherwise matches the previous one.
+// Errors are returned as a ParseError of the fragment text.
+func expandListAbbreviation(previous *PhoneNumber, previousDigits, text, digits, region string) (*PhoneNumber, error) {
+	if previous.GetExtension() != "" {
+		if len(digits) > 7 {
+			return nil, newParseError(PARSE_TOO_LONG, text, listDigitOffset(text, 7), region)
+		}
+		number := &PhoneNumber{}
+		proto.Merge(number, previous)
+		number.Extension = proto.String(digits)
+		return number, nil
+	}
+
+	number, err := Parse(previousDigits[:len(previousDigits)-len(digits)]+digits, region)
+	if err != nil {
+		// the error is for the number we built, so report it against the fragment instead
+		reason := PARSE_NOT_A_NUMBER
+		if parseErr, ok := err.(*ParseError); ok {
+			reason = parseErr.Reason
+		}
+		return nil, newParseError(reason, text, 0, region)
+	}
+	if !IsPossibleNumber(number) {
+		return nil, newParseError(PARSE_NOT_A_NUMBER, text, 0, region)
+	}
+	return number, nil
+}
+
+// returns the byte offset in the passed in text of the digit after the first n, or the length
+// of the text if it doesn't have that many
+func listDigitOffset(text string, n int) int {
+	for i, r := range text {
+		if unicode.IsDigit(r) {
+			if n == 0 {
+				return i
+			}
+			n--
+		}
+	}
+	return len(text)
+}
+
+// expands a range like 0800 123 456-9 into all the numbers in it, or returns nil if the passed
+// in text isn't a range. The text is only treated as a range if the digits after the dash are
+// fewer than those before it and the first number in the range is valid, and an unspaced hyphen
+// isn't a range if the text is a valid number from a region which uses direct dial notation.
+func expandListRange(text, region string) []*PhoneNumber {
+	match := LIST_RANGE_PATTERN.FindStringSubmatchIndex(text)
+	if match == nil {
+		return nil
+	}
+	firstDigits, lastDigits := text[match[2]:match[3]], text[match[4]:match[5]]
+	if len(lastDigits) >= len(firstDigits) {
+		return nil
+	}
+	if text[match[3]:match[4]] == "-" {
+		if number, err := Parse(text, region); err == nil && IsValidNumber(number) && directDialRegions[GetRegionCodeForNumber(number)] {
+			return nil
+		}
+	}
+
+	first, err := Parse(text[:match[3]], region)
+	if err != nil || !IsValidNumber(first) {
+		return nil
+	}
+
+	// the last number in the range is the first with its last digits replaced
+	from, _ := strconv.ParseUint(firstDigits[len(firstDigits)-len(lastDigits):], 10, 64)
+	to, _ := strconv.ParseUint(lastDigits, 10, 64)
+	if to <= from || to-from >= maxListRangeSize {
+		return nil
+	}
+
+	numbers := make([]*PhoneNumber, 0, to-from+1)
+	for i := uint64(0); i <= to-from; i++ {
+		rangeNumber := &PhoneNumber{}
+		proto.Merge(rangeNumber, first)
+		rangeNumber.NationalNumber = proto.Uint64(first.GetNationalNumber() + i)
+		numbers = append(numbers, rangeNumber)
+	}
+	return numbers
+}
@@ -142,10 +142,14 @@ type PhoneNumber struct {
 	//
 	// Note this is the "preferred" code, which means other codes may work as
 	// well.
-	PreferredDomesticCarrierCode *string  `protobuf:"bytes,7,opt,name=preferred_domestic_carrier_code,json=preferredDomesticCarrierCode" json:"preferred_domestic_carrier_code,omitempty"`
-	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
-	XXX_unrecognized             []byte   `json:"-"`
-	XXX_sizecache                int32    `json:"-"`
+	PreferredDomesticCarrierCode *string `protobuf:"bytes,7,opt,name=preferred_domestic_carrier_code,json=preferredDomesticCarrierCode" json:"preferred_domestic_carrier_code,omitempty"`
+	// Whether the national prefix was written in parentheses after the country
+	// code, as is common in Europe, e.g. "+44 (0)20 7946 0000". Like
+	// country_code_source, this is only set by the methods that keep raw_input.
+	NationalPrefixInParentheses *bool    `protobuf:"varint,9,opt,name=national_prefix_in_parentheses,json=nationalPrefixInParentheses" json:"national_prefix_in_parentheses,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
 }
 
 func (m *PhoneNumber) Reset()         { *m = PhoneNumber{} }
@@ -230,6 +234,13 @@ func (m *PhoneNumber) GetPreferredDomesticCarrierCode() string {
 	return ""
 }
 
+func (m *PhoneNumber) GetNationalPrefixInParentheses() bool {
+	if m != nil && m.NationalPrefixInParentheses != nil {
+		return *m.NationalPrefixInParentheses
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*PhoneNumber)(nil), "phonenumbers.PhoneNumber")
 	proto.RegisterEnum("phonenumbers.PhoneNumber_CountryCodeSource", PhoneNumber_CountryCodeSource_name, PhoneNumber_CountryCodeSource_value)
@@ -238,31 +249,33 @@ func init() {
 func init() { proto.RegisterFile("phonenumber.proto", fileDescriptor_phonenumber_2c745f30989ef8a6) }
 
 var fileDescriptor_phonenumber_2c745f30989ef8a6 = []byte{
-	// 416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x41, 0x6f, 0xd3, 0x40,
-	0x10, 0x85, 0x71, 0x9a, 0x40, 0x32, 0xa9, 0xda, 0x64, 0x89, 0x84, 0x05, 0x01, 0xdc, 0x5e, 0xb0,
-	0x84, 0x64, 0x51, 0x4e, 0x15, 0x37, 0x6a, 0x3b, 0xd4, 0x52, 0xea, 0x44, 0x9b, 0x58, 0x08, 0x38,
-	0xac, 0x16, 0x7b, 0x12, 0x56, 0x4a, 0x76, 0xa2, 0xb5, 0xa3, 0x02, 0x7f, 0x85, 0x7f, 0xc3, 0x2f,
-	0x43, 0x89, 0x4d, 0xeb, 0xb6, 0xc7, 0xfd, 0xde, 0x7b, 0x33, 0xb3, 0x0f, 0xfa, 0x9b, 0x1f, 0xa4,
-	0x51, 0x6f, 0xd7, 0xdf, 0xd1, 0x78, 0x1b, 0x43, 0x05, 0xb1, 0xc3, 0x1a, 0xca, 0x4f, 0xff, 0x36,
-	0xa1, 0x3b, 0xdd, 0x81, 0x78, 0x0f, 0xd8, 0x09, 0x1c, 0xa6, 0xb4, 0xd5, 0x85, 0xf9, 0x25, 0x52,
-	0xca, 0xd0, 0xb6, 0x9c, 0x86, 0xdb, 0xe2, 0xdd, 0x8a, 0xf9, 0x94, 0x21, 0x7b, 0x03, 0xc7, 0x5a,
-	0x16, 0x8a, 0xb4, 0x5c, 0x89, 0x72, 0x8c, 0xdd, 0x70, 0x1a, 0x6e, 0x93, 0x1f, 0xfd, 0xc7, 0xd5,
-	0xac, 0x21, 0x74, 0xf0, 0x67, 0x81, 0x3a, 0x57, 0xa4, 0xed, 0x03, 0xc7, 0x72, 0x3b, 0xfc, 0x16,
-	0xb0, 0x77, 0x30, 0x50, 0x85, 0x5c, 0x29, 0xa9, 0xc5, 0x0a, 0x65, 0xa6, 0xf4, 0x52, 0xfc, 0x46,
-	0x43, 0x76, 0xd3, 0xb1, 0xdc, 0x36, 0x67, 0x95, 0x36, 0x2e, 0xa5, 0xaf, 0x68, 0x88, 0x9d, 0xc3,
-	0xb3, 0x72, 0x9f, 0xa0, 0xc5, 0x9d, 0x4c, 0x6e, 0xb7, 0x1d, 0xcb, 0x6d, 0x7d, 0xb0, 0xce, 0xf8,
-	0xa0, 0x74, 0x4c, 0x16, 0xb5, 0x60, 0xce, 0x5e, 0x40, 0xc7, 0xc8, 0x6b, 0xa1, 0xf4, 0x66, 0x5b,
-	0xd8, 0xad, 0xfd, 0x25, 0x6d, 0x23, 0xaf, 0xa3, 0xdd, 0x9b, 0x7d, 0x83, 0xa7, 0xf5, 0x2f, 0x8b,
-	0x9c, 0xb6, 0x26, 0x45, 0xfb, 0xb1, 0x63, 0xb9, 0x47, 0xef, 0xdf, 0x7a, 0xf5, 0xba, 0xbc, 0x5a,
-	0x55, 0x9e, 0x7f, 0xdb, 0xc9, 0x6c, 0x1f, 0xe1, 0xfd, 0xf4, 0x3e, 0x62, 0x21, 0xbc, 0xde, 0x18,
-	0x5c, 0xa0, 0x31, 0x98, 0x89, 0x8c, 0xd6, 0x98, 0x17, 0x2a, 0x15, 0xa9, 0x34, 0x46, 0xa1, 0x29,
-	0x2b, 0x7e, 0xb2, 0xbf, 0x67, 0x78, 0x63, 0x0b, 0x2a, 0x97, 0x5f, 0x9a, 0x76, 0xc3, 0x4e, 0xff,
-	0x58, 0xd0, 0x7f, 0xb0, 0x8f, 0x1d, 0x43, 0x37, 0x89, 0x67, 0xd3, 0xd0, 0x8f, 0x46, 0x51, 0x18,
-	0xf4, 0x1e, 0xb1, 0x57, 0xf0, 0x7c, 0xc4, 0x27, 0x57, 0x22, 0x4e, 0xae, 0x2e, 0x42, 0x2e, 0x3e,
-	0x47, 0xf3, 0x4b, 0x31, 0x1d, 0x27, 0x33, 0x31, 0x8b, 0x3e, 0xc5, 0x3d, 0x8b, 0xd9, 0x30, 0x78,
-	0xa0, 0x47, 0x41, 0xd0, 0x6b, 0xb1, 0x13, 0x78, 0x79, 0x5f, 0x99, 0x24, 0xf3, 0x5a, 0x18, 0x6e,
-	0xc2, 0x41, 0x38, 0xfa, 0x98, 0x8c, 0xe7, 0xc2, 0x9f, 0x24, 0xf1, 0x9c, 0x7f, 0xe9, 0x0d, 0x2e,
-	0x1c, 0x18, 0xa6, 0xb4, 0xf6, 0x96, 0x44, 0xcb, 0x15, 0x7a, 0xea, 0xec, 0x5c, 0xdf, 0x69, 0xed,
-	0xf2, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xdc, 0xb0, 0x94, 0x88, 0x02, 0x00, 0x00,
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x71, 0xda, 0x40, 0x32, 0xa9, 0xda, 0x64, 0x89, 0x84, 0x45, 0x43, 0x71, 0x7b, 0xc1,
+	0x12, 0x92, 0x45, 0x39, 0x55, 0xdc, 0xa8, 0xed, 0x50, 0x4b, 0xa9, 0x63, 0x6d, 0x62, 0x21, 0xe0,
+	0xb0, 0x5a, 0xec, 0x49, 0xba, 0x52, 0xb2, 0x6b, 0xad, 0x1d, 0xb5, 0xf0, 0x02, 0x3c, 0x04, 0x2f,
+	0x5b, 0xc5, 0x4e, 0x13, 0xb7, 0x3d, 0xfa, 0x9f, 0xef, 0x1f, 0xcf, 0x7e, 0xd0, 0xcb, 0x6e, 0x94,
+	0x44, 0xb9, 0x5a, 0xfe, 0x46, 0xed, 0x64, 0x5a, 0x15, 0x8a, 0x1c, 0xd4, 0xa2, 0xfc, 0xec, 0x5f,
+	0x13, 0x3a, 0xd1, 0x3a, 0x08, 0xcb, 0x80, 0x9c, 0xc2, 0x41, 0xa2, 0x56, 0xb2, 0xd0, 0x7f, 0x58,
+	0xa2, 0x52, 0x34, 0x0d, 0xab, 0x61, 0x37, 0x69, 0x67, 0x93, 0xb9, 0x2a, 0x45, 0xf2, 0x01, 0x8e,
+	0x24, 0x2f, 0x84, 0x92, 0x7c, 0xc1, 0xaa, 0x35, 0x66, 0xc3, 0x6a, 0xd8, 0xfb, 0xf4, 0xf0, 0x21,
+	0xde, 0xec, 0x1a, 0x40, 0x1b, 0xef, 0x0a, 0x94, 0xb9, 0x50, 0xd2, 0xdc, 0xb3, 0x0c, 0xbb, 0x4d,
+	0x77, 0x01, 0xf9, 0x04, 0x7d, 0x51, 0xf0, 0x85, 0xe0, 0x92, 0x2d, 0x90, 0xa7, 0x42, 0xce, 0xd9,
+	0x5f, 0xd4, 0xca, 0xdc, 0xb7, 0x0c, 0xbb, 0x45, 0xc9, 0x66, 0x36, 0xaa, 0x46, 0x3f, 0x51, 0x2b,
+	0x72, 0x01, 0x6f, 0xaa, 0xff, 0x31, 0x35, 0x7b, 0xd4, 0xc9, 0xcd, 0x96, 0x65, 0xd8, 0xcd, 0x2f,
+	0xc6, 0x39, 0xed, 0x57, 0xc4, 0x78, 0x56, 0x2b, 0xe6, 0xe4, 0x18, 0xda, 0x9a, 0xdf, 0x32, 0x21,
+	0xb3, 0x55, 0x61, 0x36, 0xcb, 0x4b, 0x5a, 0x9a, 0xdf, 0x06, 0xeb, 0x6f, 0xf2, 0x0b, 0x5e, 0xd7,
+	0x9f, 0xcc, 0x72, 0xb5, 0xd2, 0x09, 0x9a, 0x2f, 0x2d, 0xc3, 0x3e, 0xfc, 0xfc, 0xd1, 0xa9, 0xeb,
+	0x72, 0x6a, 0xaa, 0x1c, 0x77, 0xe7, 0x64, 0x52, 0x56, 0x68, 0x2f, 0x79, 0x1a, 0x11, 0x1f, 0xde,
+	0x67, 0x1a, 0x67, 0xa8, 0x35, 0xa6, 0x2c, 0x55, 0x4b, 0xcc, 0x0b, 0x91, 0xb0, 0x84, 0x6b, 0x2d,
+	0x50, 0x57, 0x8a, 0x5f, 0x95, 0xf7, 0x0c, 0xb6, 0x98, 0xb7, 0xa1, 0xdc, 0x0a, 0x2a, 0x9d, 0xbb,
+	0x70, 0xb2, 0x75, 0xbe, 0x06, 0xc5, 0x1d, 0x13, 0x92, 0x65, 0x5c, 0xa3, 0x2c, 0x6e, 0x30, 0xc7,
+	0xdc, 0x6c, 0x97, 0xda, 0x8e, 0x1f, 0xa8, 0xa8, 0x84, 0x02, 0x19, 0xed, 0x90, 0xb3, 0xff, 0x06,
+	0xf4, 0x9e, 0x1d, 0x4d, 0x8e, 0xa0, 0x13, 0x87, 0x93, 0xc8, 0x77, 0x83, 0x61, 0xe0, 0x7b, 0xdd,
+	0x17, 0xe4, 0x04, 0xde, 0x0e, 0xe9, 0xf8, 0x9a, 0x85, 0xf1, 0xf5, 0xa5, 0x4f, 0xd9, 0xf7, 0x60,
+	0x7a, 0xc5, 0xa2, 0x51, 0x3c, 0x61, 0x93, 0xe0, 0x5b, 0xd8, 0x35, 0x88, 0x09, 0xfd, 0x67, 0xf3,
+	0xc0, 0xf3, 0xba, 0x4d, 0x72, 0x0a, 0xef, 0x9e, 0x4e, 0xc6, 0xf1, 0xb4, 0x56, 0x86, 0x6d, 0xd9,
+	0xf3, 0x87, 0x5f, 0xe3, 0xd1, 0x94, 0xb9, 0xe3, 0x38, 0x9c, 0xd2, 0x1f, 0xdd, 0xfe, 0xa5, 0x05,
+	0x83, 0x44, 0x2d, 0x9d, 0xb9, 0x52, 0xf3, 0x05, 0x3a, 0xe2, 0xfc, 0x42, 0x3e, 0x52, 0x7f, 0xb5,
+	0x77, 0x3f, 0x00, 0xb4, 0xb5, 0x07, 0x05, 0xcd, 0x02, 0x00, 0x00,
 }
@@ -1,8 +1,11 @@
 package phonenumbers
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
 	fmt "fmt"
+	"io"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -11,6 +14,7 @@ import (
 	"unicode"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/nyaruka/phonenumbers/internal/prefixmap"
 )
 
 const (
@@ -262,7 +266,7 @@ var (
 	// region, they will be represented as a regex string that always
 	// contains character(s) other than ASCII digits.
 	// Note this regex also includes tilde, which signals waiting for the tone.
-	UNIQUE_INTERNATIONAL_PREFIX = regexp.MustCompile("[\\d]+(?:[~\u2053\u223C\uFF5E][\\d]+)?")
+	UNIQUE_INTERNATIONAL_PREFIX = regexp.MustCompile("^[\\d]+(?:[~\u2053\u223C\uFF5E][\\d]+)?$")
 
 	PLUS_CHARS_PATTERN      = regexp.MustCompile("[" + PLUS_CHARS + "]+")
 	SEPARATOR_PATTERN       = regexp.MustCompile("[" + VALID_PUNCTUATION + "]+")
@@ -574,21 +578,9 @@ var (
 	// default capacity of 16 (load factor=0.75) is fine.
 	countryCodesForNonGeographicalRegion = make(map[int]bool, 16)
 
-	// These are our onces and maps for our prefix to carrier maps
-	carrierOnces     = make(map[string]*sync.Once)
-	carrierPrefixMap = make(map[string]*intStringMap)
-
-	// These are our onces and maps for our prefix to geocoding maps
-	geocodingOnces     = make(map[string]*sync.Once)
-	geocodingPrefixMap = make(map[string]*intStringMap)
-
 	// All the calling codes we support
 	supportedCallingCodes = make(map[int]bool, 320)
 
-	// Our once and map for prefix to timezone lookups
-	timezoneOnce sync.Once
-	timezoneMap  *intStringArrayMap
-
 	// Our map from country code (as integer) to two letter region codes
 	countryCodeToRegion map[int][]string
 )
@@ -609,12 +601,25 @@ func writeToRegexCache(key string, value *regexp.Regexp) {
 }
 
 func regexFor(pattern string) *regexp.Regexp {
+	regex, err := tryRegexFor(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return regex
+}
+
+// tryRegexFor is regexFor for patterns which may not compile, such as when linting metadata
+func tryRegexFor(pattern string) (*regexp.Regexp, error) {
 	regex, found := readFromRegexCache(pattern)
 	if !found {
-		regex = regexp.MustCompile(pattern)
+		var err error
+		regex, err = regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
 		writeToRegexCache(pattern, regex)
 	}
-	return regex
+	return regex, nil
 }
 
 func readFromNanpaRegions(key string) (struct{}, bool) {
@@ -626,9 +631,42 @@ func writeToNanpaRegions(key string, val struct{}) {
 	nanpaRegions[key] = val
 }
 
+// metadataEntry is where the encoded metadata for a single region or non-geographical
+// entity lives in metadataData
+type metadataEntry struct {
+	id          string
+	countryCode int
+	offset      int
+	length      int
+}
+
+var (
+	// The index of the encoded metadata in metadataData, in the order it was built. This
+	// is all we load at init, the metadata for a region is only decoded when first used.
+	metadataEntries []*metadataEntry
+
+	// The index entries for each region, and each non-geographical entity by its calling code.
+	regionToMetadataEntry            = make(map[string]*metadataEntry)
+	countryCodeToNonGeoMetadataEntry = make(map[int]*metadataEntry)
+
+	// Guards regionToMetadataMap and countryCodeToNonGeographicalMetadataMap as they are
+	// filled in lazily.
+	metadataMutex sync.RWMutex
+)
+
 func readFromRegionToMetadataMap(key string) (*PhoneMetadata, bool) {
+	metadataMutex.RLock()
 	v, ok := regionToMetadataMap[key]
-	return v, ok
+	metadataMutex.RUnlock()
+	if ok {
+		return v, ok
+	}
+
+	entry, ok := regionToMetadataEntry[key]
+	if !ok {
+		return nil, false
+	}
+	return loadMetadataEntry(entry), true
 }
 
 func writeToRegionToMetadataMap(key string, val *PhoneMetadata) {
@@ -636,61 +674,166 @@ func writeToRegionToMetadataMap(key string, val *PhoneMetadata) {
 }
 
 func readFromCountryCodeToNonGeographicalMetadataMap(key int) (*PhoneMetadata, bool) {
+	metadataMutex.RLock()
 	v, ok := countryCodeToNonGeographicalMetadataMap[key]
-	return v, ok
+	metadataMutex.RUnlock()
+	if ok {
+		return v, ok
+	}
+
+	entry, ok := countryCodeToNonGeoMetadataEntry[key]
+	if !ok {
+		return nil, false
+	}
+	return loadMetadataEntry(entry), true
 }
 
 func writeToCountryCodeToNonGeographicalMetadataMap(key int, v *PhoneMetadata) {
 	countryCodeToNonGeographicalMetadataMap[key] = v
 }
 
-func loadMetadataFromFile(
-	regionCode string,
-	countryCallingCode int) error {
+// Reads the index of the metadata for each region from our encoded metadata. The data
+// starts with the number of entries, then for each entry the length of its id, the id,
+// its country code and the length of its encoded metadata. The gzipped PhoneMetadata
+// protocol buffers for each entry then follow in the same order.
+func decodeMetadataIndex(data []byte) ([]*metadataEntry, error) {
+	reader := bytes.NewReader(data)
 
-	metadataCollection, err := MetadataCollection()
-	if err != nil {
-		return err
-	} else if currMetadataColl == nil {
-		currMetadataColl = metadataCollection
+	var count uint32
+	if err := binary.Read(reader, binary.LittleEndian, &count); err != nil {
+		return nil, err
 	}
+	if count == 0 {
+		return nil, ErrEmptyMetadata
+	}
+
+	entries := make([]*metadataEntry, count)
+	for i := range entries {
+		var idLength uint8
+		if err := binary.Read(reader, binary.LittleEndian, &idLength); err != nil {
+			return nil, err
+		}
+		id := make([]byte, idLength)
+		if _, err := io.ReadFull(reader, id); err != nil {
+			return nil, err
+		}
 
-	metadataList := metadataCollection.GetMetadata()
-	if len(metadataList) == 0 {
-		return ErrEmptyMetadata
+		var countryCode, length uint32
+		if err := binary.Read(reader, binary.LittleEndian, &countryCode); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(reader, binary.LittleEndian, &length); err != nil {
+			return nil, err
+		}
+
+		entries[i] = &metadataEntry{id: string(id), countryCode: int(countryCode), length: int(length)}
 	}
 
-	for _, meta := range metadataList {
-		region := meta.GetId()
-		if region == "001" {
-			// it's a non geographical entity
-			writeToCountryCodeToNonGeographicalMetadataMap(int(meta.GetCountryCode()), meta)
+	// our entries are laid out one after another after the index
+	offset := len(data) - reader.Len()
+	for _, entry := range entries {
+		entry.offset = offset
+		offset += entry.length
+	}
+	if offset != len(data) {
+		return nil, fmt.Errorf("metadata index covers %d bytes but have %d", offset, len(data))
+	}
+	return entries, nil
+}
+
+func loadMetadataIndex(data []byte) error {
+	entries, err := decodeMetadataIndex(data)
+	if err != nil {
+		return err
+	}
+
+	metadataEntries = entries
+	for _, entry := range entries {
+		if entry.id == REGION_CODE_FOR_NON_GEO_ENTITY {
+			countryCodeToNonGeoMetadataEntry[entry.countryCode] = entry
 		} else {
-			writeToRegionToMetadataMap(region, meta)
+			regionToMetadataEntry[entry.id] = entry
 		}
 	}
 	return nil
 }
 
-var (
-	currMetadataColl *PhoneMetadataCollection
-	reloadMetadata   = true
-)
+func decodeMetadataEntry(entry *metadataEntry) (*PhoneMetadata, error) {
+	rawBytes, err := prefixmap.DecodeUnzip(metadataData[entry.offset : entry.offset+entry.length])
+	if err != nil {
+		return nil, err
+	}
 
-func MetadataCollection() (*PhoneMetadataCollection, error) {
-	if !reloadMetadata {
-		return currMetadataColl, nil
+	metadata := &PhoneMetadata{}
+	if err := proto.Unmarshal(rawBytes, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// Returns the metadata for the passed in entry, decoding it if this is the first time it
+// has been used. Our metadata is embedded so failing to decode it is a bug and we panic
+// just as we would have at init.
+func loadMetadataEntry(entry *metadataEntry) *PhoneMetadata {
+	metadata, err := loadMetadataEntryWithError(entry)
+	if err != nil {
+		panic(fmt.Sprintf("error decoding metadata for %s: %s", entry.id, err))
+	}
+	return metadata
+}
+
+func loadMetadataEntryWithError(entry *metadataEntry) (*PhoneMetadata, error) {
+	metadataMutex.Lock()
+	defer metadataMutex.Unlock()
+
+	// another goroutine may have beaten us to it
+	isNonGeo := entry.id == REGION_CODE_FOR_NON_GEO_ENTITY
+	if isNonGeo {
+		if metadata, ok := countryCodeToNonGeographicalMetadataMap[entry.countryCode]; ok {
+			return metadata, nil
+		}
+	} else if metadata, ok := regionToMetadataMap[entry.id]; ok {
+		return metadata, nil
 	}
 
-	rawBytes, err := decodeUnzipString(metadataData)
+	metadata, err := decodeMetadataEntry(entry)
 	if err != nil {
 		return nil, err
 	}
 
-	var metadataCollection = &PhoneMetadataCollection{}
-	err = proto.Unmarshal(rawBytes, metadataCollection)
-	reloadMetadata = false
-	return metadataCollection, err
+	if isNonGeo {
+		writeToCountryCodeToNonGeographicalMetadataMap(entry.countryCode, metadata)
+	} else {
+		writeToRegionToMetadataMap(entry.id, metadata)
+	}
+	return metadata, nil
+}
+
+// PreloadMetadata decodes the metadata for every region up front. By default the metadata
+// for a region is only decoded the first time a number from that region is used, which
+// keeps package initialization cheap. Latency sensitive servers may prefer to pay that cost
+// once at startup instead.
+func PreloadMetadata() error {
+	for _, entry := range metadataEntries {
+		if _, err := loadMetadataEntryWithError(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MetadataCollection returns the metadata for every region and non-geographical entity,
+// decoding any which haven't been used yet.
+func MetadataCollection() (*PhoneMetadataCollection, error) {
+	metadataList := make([]*PhoneMetadata, 0, len(metadataEntries))
+	for _, entry := range metadataEntries {
+		metadata, err := loadMetadataEntryWithError(entry)
+		if err != nil {
+			return nil, err
+		}
+		metadataList = append(metadataList, metadata)
+	}
+	return &PhoneMetadataCollection{Metadata: metadataList}, nil
 }
 
 // Attempts to extract a possible number from the string passed in.
@@ -734,7 +877,8 @@ func isViablePhoneNumber(number string) bool {
 		return false
 	}
 
-	return VALID_PHONE_NUMBER_PATTERN.MatchString(number)
+	_, validNumberPattern := getExtensionPatterns()
+	return validNumberPattern.MatchString(number)
 }
 
 // Normalizes a string of characters representing a phone number. This
@@ -938,10 +1082,9 @@ func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
 	numberGroups := DIGITS_PATTERN.FindAllString(nationalSignificantNumber, -1)
 
 	// The pattern will start with "+COUNTRY_CODE " so the first group
-	// will always be the empty string (before the + symbol) and the
-	// second group will be the country calling code. The third group
-	// will be area code if it is not the last group.
-	if len(numberGroups) <= 3 {
+	// will always be the country calling code. The second group will be
+	// area code if it is not the last group.
+	if len(numberGroups) <= 2 {
 		return 0
 	}
 	if GetNumberType(number) == MOBILE {
@@ -1518,6 +1661,9 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 		var numberFormats = []*NumberFormat{numFormatCopy}
 		formattedNumber = FormatByPattern(number, NATIONAL, numberFormats)
 	}
+	if number.GetNationalPrefixInParentheses() && number.GetCountryCodeSource() != PhoneNumber_FROM_DEFAULT_COUNTRY {
+		formattedNumber = addNationalPrefixInParentheses(formattedNumber, number)
+	}
 	rawInput = number.GetRawInput()
 	// If no digit is inserted/removed/modified as a result of our
 	// formatting, we return the formatted phone number; otherwise we
@@ -1532,6 +1678,33 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 	return formattedNumber
 }
 
+// Adds the national prefix in parentheses after the country code of the passed
+// in internationally formatted number, e.g. "+44 (0)20 7946 0000".
+func addNationalPrefixInParentheses(formattedNumber string, number *PhoneNumber) string {
+	countryCode := int(number.GetCountryCode())
+	nationalPrefix := GetNddPrefixForRegion(GetRegionCodeForCountryCode(countryCode), true)
+	if nationalPrefix == "" {
+		return formattedNumber
+	}
+
+	// the country code is either at the start, after a plus or after an IDD
+	countryCodeStr := strconv.Itoa(countryCode) + " "
+	index := -1
+	if strings.HasPrefix(formattedNumber, countryCodeStr) {
+		index = 0
+	} else if i := strings.Index(formattedNumber, "+"+countryCodeStr); i >= 0 {
+		index = i + 1
+	} else if i := strings.Index(formattedNumber, " "+countryCodeStr); i >= 0 {
+		index = i + 1
+	}
+	if index < 0 {
+		return formattedNumber
+	}
+
+	index += len(countryCodeStr)
+	return formattedNumber[:index] + "(" + nationalPrefix + ")" + formattedNumber[index:]
+}
+
 // Check if rawInput, which is assumed to be in the national format, has
 // a national prefix. The national prefix is assumed to be in digits-only
 // form.
@@ -1845,7 +2018,7 @@ func formatNsnUsingPatternWithCarrier(
 				}
 				return s
 			})
-		formattedNationalNumber = m.ReplaceAllString(numberFormatRule, nationalNumber)
+		formattedNationalNumber = m.ReplaceAllString(nationalNumber, numberFormatRule)
 	} else {
 		// Use the national prefix formatting rule instead.
 		nationalPrefixFormattingRule :=
@@ -1999,42 +2172,47 @@ func GetNumberType(number *PhoneNumber) PhoneNumberType {
 }
 
 func getNumberTypeHelper(nationalNumber string, metadata *PhoneMetadata) PhoneNumberType {
-	if !isNumberMatchingDesc(nationalNumber, metadata.GetGeneralDesc()) {
+	return getNumberTypeWithMatcher(newDescMatcher(nationalNumber, metadata), metadata)
+}
+
+// Gets the type of a national number using the passed in matcher, which
+// uses the region's classifier if it has one, otherwise its regexes.
+func getNumberTypeWithMatcher(m descMatcher, metadata *PhoneMetadata) PhoneNumberType {
+	if !m.matches(metadata.GetGeneralDesc(), classifyGeneral) {
 		return UNKNOWN
 	}
 
-	if isNumberMatchingDesc(nationalNumber, metadata.GetPremiumRate()) {
+	if m.matches(metadata.GetPremiumRate(), classifyPremiumRate) {
 		return PREMIUM_RATE
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetTollFree()) {
+	if m.matches(metadata.GetTollFree(), classifyTollFree) {
 		return TOLL_FREE
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetSharedCost()) {
+	if m.matches(metadata.GetSharedCost(), classifySharedCost) {
 		return SHARED_COST
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetVoip()) {
+	if m.matches(metadata.GetVoip(), classifyVoip) {
 		return VOIP
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetPersonalNumber()) {
+	if m.matches(metadata.GetPersonalNumber(), classifyPersonalNumber) {
 		return PERSONAL_NUMBER
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetPager()) {
+	if m.matches(metadata.GetPager(), classifyPager) {
 		return PAGER
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetUan()) {
+	if m.matches(metadata.GetUan(), classifyUan) {
 		return UAN
 	}
-	if isNumberMatchingDesc(nationalNumber, metadata.GetVoicemail()) {
+	if m.matches(metadata.GetVoicemail(), classifyVoicemail) {
 		return VOICEMAIL
 	}
 
-	var isFixedLine = isNumberMatchingDesc(
-		nationalNumber, metadata.GetFixedLine())
+	var isFixedLine = m.matches(metadata.GetFixedLine(), classifyFixedLine)
 
 	if isFixedLine {
 		if metadata.GetSameMobileAndFixedLinePattern() {
 			return FIXED_LINE_OR_MOBILE
-		} else if isNumberMatchingDesc(nationalNumber, metadata.GetMobile()) {
+		} else if m.matches(metadata.GetMobile(), classifyMobile) {
 			return FIXED_LINE_OR_MOBILE
 		}
 		return FIXED_LINE
@@ -2042,7 +2220,7 @@ func getNumberTypeHelper(nationalNumber string, metadata *PhoneMetadata) PhoneNu
 	// Otherwise, test to see if the number is mobile. Only do this if
 	// certain that the patterns for mobile and fixed line aren't the same.
 	if !metadata.GetSameMobileAndFixedLinePattern() &&
-		isNumberMatchingDesc(nationalNumber, metadata.GetMobile()) {
+		m.matches(metadata.GetMobile(), classifyMobile) {
 		return MOBILE
 	}
 	return UNKNOWN
@@ -2068,27 +2246,30 @@ func getMetadataForNonGeographicalRegion(countryCallingCode int) *PhoneMetadata
 }
 
 func isNumberPossibleForDesc(nationalNumber string, numberDesc *PhoneNumberDesc) bool {
-	// Check if any possible number lengths are present; if so, we use them to avoid checking the
-	// validation pattern if they don't match. If they are absent, this means they match the general
-	// description, which we have already checked before checking a specific number type.
-	actualLength := int32(len(nationalNumber))
-	if len(numberDesc.PossibleLength) > 0 {
-		found := false
-		for _, l := range numberDesc.PossibleLength {
-			if actualLength == l {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
+	if !isPossibleLengthForDesc(nationalNumber, numberDesc) {
+		return false
 	}
 	possiblePattern := "^(?:" + numberDesc.GetNationalNumberPattern() + ")$" // Strictly match
 	pat := regexFor(possiblePattern)
 	return pat.MatchString(nationalNumber)
 }
 
+// Check if any possible number lengths are present; if so, we use them to avoid checking the
+// validation pattern if they don't match. If they are absent, this means they match the general
+// description, which we have already checked before checking a specific number type.
+func isPossibleLengthForDesc(nationalNumber string, numberDesc *PhoneNumberDesc) bool {
+	if len(numberDesc.PossibleLength) == 0 {
+		return true
+	}
+	actualLength := int32(len(nationalNumber))
+	for _, l := range numberDesc.PossibleLength {
+		if actualLength == l {
+			return true
+		}
+	}
+	return false
+}
+
 func isNumberMatchingDesc(nationalNumber string, numberDesc *PhoneNumberDesc) bool {
 	patP := "^(?:" + numberDesc.GetNationalNumberPattern() + ")$" // Strictly match
 	pat := regexFor(patP)
@@ -2153,13 +2334,12 @@ func getRegionCodeForNumberFromRegionList(
 		// full validation. Metadata cannot be null because the
 		// region codes come from the country calling code map.
 		var metadata *PhoneMetadata = getMetadataForRegion(regionCode)
+		m := newDescMatcher(nationalNumber, metadata)
 		if len(metadata.GetLeadingDigits()) > 0 {
-			patP := "^(?:" + metadata.GetLeadingDigits() + ")" // Non capturing grouping to support OR'ed alternatives (e.g. 555|1[78]|2)
-			pat := regexFor(patP)
-			if pat.MatchString(nationalNumber) {
+			if m.matchesLeadingDigits(metadata) {
 				return regionCode
 			}
-		} else if getNumberTypeHelper(nationalNumber, metadata) != UNKNOWN {
+		} else if getNumberTypeWithMatcher(m, metadata) != UNKNOWN {
 			return regionCode
 		}
 	}
@@ -2301,6 +2481,12 @@ func mergeLengths(l1 []int32, l2 []int32) []int32 {
 // Helper method to check a number against possible lengths for this number type, and determine
 // whether it matches, or is too short or too long.
 func testNumberLength(number string, metadata *PhoneMetadata, numberType PhoneNumberType) ValidationResult {
+	return testNumberLengthOf(int32(len(number)), metadata, numberType)
+}
+
+// Like testNumberLength, but for a number of the passed in length, for when
+// we don't have the number as a string.
+func testNumberLengthOf(actualLength int32, metadata *PhoneMetadata, numberType PhoneNumberType) ValidationResult {
 	desc := getNumberDescByType(metadata, numberType)
 
 	// There should always be "possibleLengths" set for every element. This is declared in the XML
@@ -2319,7 +2505,7 @@ func testNumberLength(number string, metadata *PhoneMetadata, numberType PhoneNu
 		if !descHasPossibleNumberData(getNumberDescByType(metadata, FIXED_LINE)) {
 			// The rare case has been encountered where no fixedLine data is available (true for some
 			// non-geographical entities), so we just check mobile.
-			return testNumberLength(number, metadata, MOBILE)
+			return testNumberLengthOf(actualLength, metadata, MOBILE)
 		} else {
 			mobileDesc := getNumberDescByType(metadata, MOBILE)
 			if descHasPossibleNumberData(mobileDesc) {
@@ -2347,8 +2533,6 @@ func testNumberLength(number string, metadata *PhoneMetadata, numberType PhoneNu
 		return INVALID_LENGTH
 	}
 
-	actualLength := int32(len(number))
-
 	// This is safe because there is never an overlap beween the possible lengths and the local-only
 	// lengths; this is checked at build time.
 	for _, l := range localLengths {
@@ -2543,8 +2727,11 @@ func maybeExtractCountryCode(
 		phoneNumber.CountryCodeSource = &countryCodeSource
 	}
 	if countryCodeSource != PhoneNumber_FROM_DEFAULT_COUNTRY {
+		// errors point to whatever follows the plus sign or IDD
+		afterIdd := offsetAfterNormalized(number, len(normalize(number))-len(fullNumber.String()))
+
 		if len(fullNumber.String()) <= MIN_LENGTH_FOR_NSN {
-			return 0, ErrTooShortAfterIDD
+			return 0, newParseError(PARSE_TOO_SHORT_AFTER_IDD, number, afterIdd, defaultRegionMetadata.GetId())
 		}
 		potentialCountryCode := extractCountryCode(fullNumber, nationalNumber)
 		if potentialCountryCode != 0 {
@@ -2554,7 +2741,7 @@ func maybeExtractCountryCode(
 
 		// If this fails, they must be using a strange country calling code
 		// that we don't recognize, or that doesn't exist.
-		return 0, ErrInvalidCountryCode
+		return 0, newParseError(PARSE_INVALID_COUNTRY_CODE, number, afterIdd, defaultRegionMetadata.GetId())
 	} else if defaultRegionMetadata != nil {
 		// Check to see if the number starts with the country calling code
 		// for the default region. If so, we remove the country calling
@@ -2727,10 +2914,11 @@ func maybeStripExtension(number *Builder) string {
 	// If we find a potential extension, and the number preceding this is
 	// a viable number, we assume it is an extension.
 	numStr := number.String()
-	ind := EXTN_PATTERN.FindStringIndex(numStr)
+	extnPattern, _ := getExtensionPatterns()
+	ind := extnPattern.FindStringIndex(numStr)
 	if len(ind) > 0 && isViablePhoneNumber(numStr[0:ind[0]]) {
 		// The numbers are captured into groups in the regular expression.
-		for _, extension := range EXTN_PATTERN.FindStringSubmatch(numStr)[1:] {
+		for _, extension := range extnPattern.FindStringSubmatch(numStr)[1:] {
 			if len(extension) == 0 {
 				continue
 			}
@@ -2797,6 +2985,57 @@ func ParseAndKeepRawInputToNumber(
 	return parseHelper(numberToParse, defaultRegion, true, true, phoneNumber)
 }
 
+// ParseOptions controls how ParseWithOptions parses a number
+type ParseOptions struct {
+	// DefaultRegion is the region we expect the number to be from, used if
+	// the number isn't written in international format
+	DefaultRegion string
+
+	// KeepRawInput populates the raw_input and country_code_source fields
+	// of the parsed number
+	KeepRawInput bool
+
+	// KeepCarrierCode populates the preferred_domestic_carrier_code field of
+	// the parsed number with any carrier code found in it
+	KeepCarrierCode bool
+
+	// SkipRegionCheck allows numbers which aren't in international format
+	// to be parsed when DefaultRegion is empty or unknown, in which case the
+	// parsed number has no country code
+	SkipRegionCheck bool
+
+	// AllowedRegions rejects numbers which aren't from one of these regions,
+	// numbers which aren't valid are considered to be from the main region
+	// for their calling code
+	AllowedRegions []string
+
+	// AllowedCallingCodes rejects numbers which don't have one of these
+	// country calling codes
+	AllowedCallingCodes []int
+
+	// RequireValid rejects numbers which aren't valid
+	RequireValid bool
+
+	// DisallowAlpha rejects numbers containing letters, such as vanity
+	// numbers like 1-800-FLOWERS
+	DisallowAlpha bool
+
+	// DisallowExtension rejects numbers with an extension
+	DisallowExtension bool
+
+	// MaxInputLength overrides MAX_INPUT_STRING_LENGTH if greater than zero
+	MaxInputLength int
+}
+
+// ParseWithOptions parses a string and returns it in proto buffer format,
+// according to the passed in options. Numbers rejected by the options
+// return a ParseError, just like numbers which can't be parsed.
+func ParseWithOptions(numberToParse string, opts ParseOptions) (*PhoneNumber, error) {
+	phoneNumber := &PhoneNumber{}
+	err := parseWithOptions(numberToParse, &opts, phoneNumber)
+	return phoneNumber, err
+}
+
 // Returns an iterable over all PhoneNumberMatch PhoneNumberMatches in text.
 // This is a shortcut for findNumbers(CharSequence, String, Leniency, long)
 // getMatcher(text, defaultRegion, Leniency.VALID, Long.MAX_VALUE)}.
@@ -2844,6 +3083,116 @@ var (
 	ErrTooShortNSN        = errors.New("the string supplied is too short to be a phone number")
 )
 
+// errors for numbers rejected by the options passed to ParseWithOptions
+var (
+	ErrRegionNotAllowed    = errors.New("the phone number supplied is not from an allowed region")
+	ErrInvalidNumber       = errors.New("the phone number supplied is not a valid number")
+	ErrAlphaNotAllowed     = errors.New("the phone number supplied contains letters")
+	ErrExtensionNotAllowed = errors.New("the phone number supplied has an extension")
+)
+
+// ParseErrorReason is the reason parsing a phone number failed
+type ParseErrorReason int
+
+const (
+	PARSE_INVALID_COUNTRY_CODE ParseErrorReason = iota
+	PARSE_NOT_A_NUMBER
+	PARSE_TOO_SHORT_AFTER_IDD
+	PARSE_TOO_SHORT_NSN
+	PARSE_TOO_LONG
+	PARSE_REGION_NOT_ALLOWED
+	PARSE_INVALID_NUMBER
+	PARSE_ALPHA_NOT_ALLOWED
+	PARSE_EXTENSION_NOT_ALLOWED
+	PARSE_INVALID_TEL_URI
+	PARSE_INVALID_SIP_URI
+	PARSE_INVALID_CANONICAL
+)
+
+// the sentinel error for each parse error reason
+var parseErrorSentinels = map[ParseErrorReason]error{
+	PARSE_INVALID_COUNTRY_CODE:  ErrInvalidCountryCode,
+	PARSE_NOT_A_NUMBER:          ErrNotANumber,
+	PARSE_TOO_SHORT_AFTER_IDD:   ErrTooShortAfterIDD,
+	PARSE_TOO_SHORT_NSN:         ErrTooShortNSN,
+	PARSE_TOO_LONG:              ErrNumTooLong,
+	PARSE_REGION_NOT_ALLOWED:    ErrRegionNotAllowed,
+	PARSE_INVALID_NUMBER:        ErrInvalidNumber,
+	PARSE_ALPHA_NOT_ALLOWED:     ErrAlphaNotAllowed,
+	PARSE_EXTENSION_NOT_ALLOWED: ErrExtensionNotAllowed,
+	PARSE_INVALID_TEL_URI:       ErrInvalidTelURI,
+	PARSE_INVALID_SIP_URI:       ErrInvalidSIPURI,
+	PARSE_INVALID_CANONICAL:     ErrInvalidCanonical,
+}
+
+// ParseError is the error returned when a phone number can't be parsed. It unwraps to one of
+// the sentinel errors (ErrNotANumber, ErrInvalidCountryCode etc) so can be checked with
+// errors.Is, and carries enough context to tell the user which part of their input was wrong.
+type ParseError struct {
+	// Reason is why parsing failed
+	Reason ParseErrorReason
+
+	// Input is the string we were asked to parse
+	Input string
+
+	// Offset is the byte offset in Input where parsing gave up, e.g. the start of an invalid
+	// country code, or the first digit past the maximum length of a number
+	Offset int
+
+	// Region is the region in effect when parsing gave up, which is the default region unless a
+	// country calling code had already been extracted from the number
+	Region string
+}
+
+func newParseError(reason ParseErrorReason, input string, offset int, region string) *ParseError {
+	return &ParseError{Reason: reason, Input: input, Offset: offset, Region: region}
+}
+
+// Error returns the message of the sentinel error for our reason
+func (e *ParseError) Error() string {
+	return e.Unwrap().Error()
+}
+
+// Unwrap returns the sentinel error for our reason
+func (e *ParseError) Unwrap() error {
+	return parseErrorSentinels[e.Reason]
+}
+
+// Returns whether the passed in number has the national prefix in parentheses
+// at offset, as in "+44 (0)20 7946 0000".
+func hasNationalPrefixInParentheses(number string, offset int, nationalPrefix string) bool {
+	if nationalPrefix == "" {
+		return false
+	}
+	before := strings.TrimRightFunc(number[:offset], unicode.IsSpace)
+	after := number[offset:]
+	if !strings.HasSuffix(before, "(") || !strings.HasPrefix(after, nationalPrefix) {
+		return false
+	}
+	after = strings.TrimLeftFunc(after[len(nationalPrefix):], unicode.IsSpace)
+	return strings.HasPrefix(after, ")")
+}
+
+// Returns the byte offset in number of the first character which is kept by normalization
+// after skipping n such characters. This lets us turn a position in a normalized number back
+// into a position in the input.
+func offsetAfterNormalized(number string, n int) int {
+	alpha := VALID_ALPHA_PHONE_PATTERN.MatchString(number)
+	for offset, r := range number {
+		kept := unicode.IsDigit(r)
+		if alpha {
+			_, kept = ALPHA_PHONE_MAPPINGS[unicode.ToUpper(r)]
+		}
+		if kept {
+			if n == 0 {
+				return offset
+			}
+			n--
+		}
+	}
+	return len(number)
+}
+
 // Parses a string and fills up the phoneNumber. This method is the same
 // as the public Parse() method, with the exception that it allows the
 // default region to be null, for use by IsNumberMatch(). checkRegion should
@@ -2853,24 +3202,66 @@ func parseHelper(
 	numberToParse, defaultRegion string,
 	keepRawInput, checkRegion bool,
 	phoneNumber *PhoneNumber) error {
+	opts := ParseOptions{
+		DefaultRegion:   defaultRegion,
+		KeepRawInput:    keepRawInput,
+		KeepCarrierCode: keepRawInput,
+		SkipRegionCheck: !checkRegion,
+	}
+	return parseWithOptions(numberToParse, &opts, phoneNumber)
+}
+
+// Parses a string and fills up the phoneNumber according to the passed in
+// options, this is what all the parse functions use under the hood.
+func parseWithOptions(numberToParse string, opts *ParseOptions, phoneNumber *PhoneNumber) error {
+	defaultRegion, keepRawInput := opts.DefaultRegion, opts.KeepRawInput
+
+	maxInputLength := opts.MaxInputLength
+	if maxInputLength <= 0 {
+		maxInputLength = MAX_INPUT_STRING_LENGTH
+	}
+
 	if len(numberToParse) == 0 {
-		return ErrNotANumber
-	} else if len(numberToParse) > MAX_INPUT_STRING_LENGTH {
-		return ErrNumTooLong
+		return newParseError(PARSE_NOT_A_NUMBER, numberToParse, 0, defaultRegion)
+	} else if len(numberToParse) > maxInputLength {
+		return newParseError(PARSE_TOO_LONG, numberToParse, maxInputLength, defaultRegion)
 	}
 
 	nationalNumber := NewBuilder(nil)
-	buildNationalNumberForParsing(numberToParse, nationalNumber)
+	start, contiguous, buildErr := buildNationalNumberForParsing(numberToParse, nationalNumber)
+	if buildErr != nil {
+		buildErr.Region = defaultRegion
+		return buildErr
+	}
+
+	// Returns the offset in numberToParse of an offset in nationalNumber. If
+	// nationalNumber isn't a contiguous part of the input, we can only point
+	// at the start of the number.
+	inputOffset := func(offset int) int {
+		if !contiguous {
+			return start
+		}
+		return start + offset
+	}
+
+	// Rebases a parse error for the part of nationalNumber starting at offset
+	// so that it refers to the whole input.
+	rebaseError := func(err error, offset int) error {
+		if parseErr, ok := err.(*ParseError); ok {
+			return newParseError(parseErr.Reason, numberToParse, inputOffset(offset+parseErr.Offset), defaultRegion)
+		}
+		return err
+	}
 
 	if !isViablePhoneNumber(nationalNumber.String()) {
-		return ErrNotANumber
+		return newParseError(PARSE_NOT_A_NUMBER, numberToParse, inputOffset(0), defaultRegion)
 	}
 
 	// Check the region supplied is valid, or that the extracted number
 	// starts with some sort of + sign so the number's region can be determined.
-	if checkRegion &&
+	if !opts.SkipRegionCheck &&
 		!checkRegionForParsing(nationalNumber.String(), defaultRegion) {
-		return ErrInvalidCountryCode
+		return newParseError(PARSE_INVALID_COUNTRY_CODE, numberToParse, inputOffset(0), defaultRegion)
 	}
 
 	if keepRawInput {
@@ -2881,8 +3272,16 @@ func parseHelper(
 	// number here.
 	extension := maybeStripExtension(nationalNumber)
 	if len(extension) > 0 {
+		if opts.DisallowExtension {
+			return newParseError(PARSE_EXTENSION_NOT_ALLOWED, numberToParse, inputOffset(nationalNumber.Len()), defaultRegion)
+		}
 		phoneNumber.Extension = proto.String(extension)
 	}
+	if opts.DisallowAlpha {
+		if letter := strings.IndexFunc(nationalNumber.String(), unicode.IsLetter); letter >= 0 {
+			return newParseError(PARSE_ALPHA_NOT_ALLOWED, numberToParse, inputOffset(letter), defaultRegion)
+		}
+	}
 	var regionMetadata *PhoneMetadata = getMetadataForRegion(defaultRegion)
 	// Check to see if the number is given in international format so we
 	// know whether this number is from the default region or not.
@@ -2896,22 +3295,25 @@ func parseHelper(
 	if err != nil {
 		// There might be a plus at the beginning
 		inds := PLUS_CHARS_PATTERN.FindStringIndex(nationalNumber.String())
-		if err == ErrInvalidCountryCode && len(inds) > 0 {
+		if errors.Is(err, ErrInvalidCountryCode) && len(inds) > 0 {
 			// Strip the plus-char, and try again.
 			countryCode, err = maybeExtractCountryCode(
 				nationalNumber.String()[inds[1]:], regionMetadata,
 				normalizedNationalNumber, keepRawInput, phoneNumber)
 			if err != nil {
-				return err
+				return rebaseError(err, inds[1])
 			} else if countryCode == 0 {
-				return ErrInvalidCountryCode
+				return newParseError(PARSE_INVALID_COUNTRY_CODE, numberToParse, inputOffset(inds[1]), defaultRegion)
 			}
 		} else {
-			return err
+			return rebaseError(err, 0)
 		}
 	}
+	region := defaultRegion
+	countryCodeInNumber := countryCode != 0
 	if countryCode != 0 {
 		phoneNumberRegion := GetRegionCodeForCountryCode(countryCode)
+		region = phoneNumberRegion
 		if phoneNumberRegion != defaultRegion {
 			// Metadata cannot be null because the country calling
 			// code is valid.
@@ -2931,7 +3333,25 @@ func parseHelper(
 		}
 	}
 	if len(normalizedNationalNumber.String()) < MIN_LENGTH_FOR_NSN {
-		return ErrTooShortNSN
+		return newParseError(PARSE_TOO_SHORT_NSN, numberToParse, inputOffset(len(nationalNumber.String())), region)
+	}
+
+	// Numbers in international format are often written with the national
+	// prefix in parentheses after the country code, e.g. "+44 (0)20 7946 0000",
+	// in which case we know it's the national prefix and can drop it. The
+	// rest of the number is then stripped as usual, so that any carrier code
+	// or transform rule which follows the national prefix is still applied.
+	if regionMetadata != nil && countryCodeInNumber {
+		nationalPrefix := regionMetadata.GetNationalPrefix()
+		afterCountryCode := offsetAfterNormalized(nationalNumber.String(),
+			len(normalize(nationalNumber.String()))-normalizedNationalNumber.Len())
+		if hasNationalPrefixInParentheses(nationalNumber.String(), afterCountryCode, nationalPrefix) &&
+			strings.HasPrefix(normalizedNationalNumber.String(), nationalPrefix) {
+			normalizedNationalNumber = NewBuilderString(normalizedNationalNumber.String()[len(nationalPrefix):])
+			if keepRawInput {
+				phoneNumber.NationalPrefixInParentheses = proto.Bool(true)
+			}
+		}
 	}
 
 	if regionMetadata != nil {
@@ -2948,7 +3368,7 @@ func parseHelper(
 		validationResult := testNumberLength(potentialNationalNumber.String(), regionMetadata, UNKNOWN)
 		if validationResult != TOO_SHORT && validationResult != IS_POSSIBLE_LOCAL_ONLY && validationResult != INVALID_LENGTH {
 			normalizedNationalNumber = potentialNationalNumber
-			if keepRawInput {
+			if opts.KeepCarrierCode {
 				phoneNumber.PreferredDomesticCarrierCode =
 					proto.String(carrierCode.String())
 			}
@@ -2956,30 +3376,85 @@ func parseHelper(
 	}
 	lengthOfNationalNumber := len(normalizedNationalNumber.String())
 	if lengthOfNationalNumber < MIN_LENGTH_FOR_NSN {
-		return ErrTooShortNSN
+		return newParseError(PARSE_TOO_SHORT_NSN, numberToParse, inputOffset(len(nationalNumber.String())), region)
 	}
 	if lengthOfNationalNumber > MAX_LENGTH_FOR_NSN {
-		return ErrNumTooLong
+		// point at the first character past the maximum length, which is after any country
+		// code and national prefix we stripped
+		stripped := len(normalize(nationalNumber.String())) - lengthOfNationalNumber
+		offset := offsetAfterNormalized(nationalNumber.String(), stripped+MAX_LENGTH_FOR_NSN)
+		return newParseError(PARSE_TOO_LONG, numberToParse, inputOffset(offset), region)
 	}
 	setItalianLeadingZerosForPhoneNumber(
 		normalizedNationalNumber.String(), phoneNumber)
 	val, _ := strconv.ParseUint(normalizedNationalNumber.String(), 10, 64)
 	phoneNumber.NationalNumber = proto.Uint64(val)
+
+	if len(opts.AllowedRegions) > 0 || len(opts.AllowedCallingCodes) > 0 {
+		// numbers which aren't valid don't have a region, so fall back to
+		// the main region for their calling code
+		numberRegion := GetRegionCodeForNumber(phoneNumber)
+		if numberRegion == "" {
+			numberRegion = GetRegionCodeForCountryCode(countryCode)
+		}
+		if !isAllowedForParsing(numberRegion, countryCode, opts) {
+			return newParseError(PARSE_REGION_NOT_ALLOWED, numberToParse, inputOffset(0), numberRegion)
+		}
+	}
+	if opts.RequireValid && !IsValidNumber(phoneNumber) {
+		return newParseError(PARSE_INVALID_NUMBER, numberToParse, inputOffset(0), region)
+	}
 	return nil
 }
 
+// Returns whether the passed in region and calling code are allowed by the
+// passed in options.
+func isAllowedForParsing(region string, countryCode int, opts *ParseOptions) bool {
+	if len(opts.AllowedRegions) > 0 {
+		allowed := false
+		for _, r := range opts.AllowedRegions {
+			if strings.EqualFold(r, region) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	if len(opts.AllowedCallingCodes) > 0 {
+		for _, cc := range opts.AllowedCallingCodes {
+			if cc == countryCode {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
 var ErrNumTooLong = errors.New("the string supplied is too long to be a phone number")
 
 // Converts numberToParse to a form that we can parse and write it to
 // nationalNumber if it is written in RFC3966; otherwise extract a possible
-// number out of it and write to nationalNumber.
+// number out of it and write to nationalNumber. Returns the offset in
+// numberToParse that nationalNumber starts at, and whether nationalNumber is
+// a contiguous part of numberToParse, which isn't the case if it has been
+// prefixed with a phone-context.
 func buildNationalNumberForParsing(
 	numberToParse string,
-	nationalNumber *Builder) {
+	nationalNumber *Builder) (int, bool, *ParseError) {
+
+	start, contiguous := 0, true
 
 	indexOfPhoneContext := strings.Index(numberToParse, RFC3966_PHONE_CONTEXT)
 	if indexOfPhoneContext > 0 {
 		phoneContextStart := indexOfPhoneContext + len(RFC3966_PHONE_CONTEXT)
+		if phoneContextStart == len(numberToParse) {
+			// The phone-context is present but has no value.
+			return 0, false, newParseError(PARSE_NOT_A_NUMBER, numberToParse, phoneContextStart, "")
+		}
+
 		// If the phone context contains a phone number prefix, we need
 		// to capture it, whereas domains will be ignored.
 		if numberToParse[phoneContextStart] == PLUS_SIGN {
@@ -2989,10 +3464,11 @@ func buildNationalNumberForParsing(
 			phoneContextEnd := strings.Index(numberToParse[phoneContextStart:], ";")
 			if phoneContextEnd > 0 {
 				nationalNumber.WriteString(
-					numberToParse[phoneContextStart:phoneContextEnd])
+					numberToParse[phoneContextStart : phoneContextStart+phoneContextEnd])
 			} else {
 				nationalNumber.WriteString(numberToParse[phoneContextStart:])
 			}
+			contiguous = false
 		}
 		// Now append everything between the "tel:" prefix and the
 		// phone-context. This should include the national number, an
@@ -3007,11 +3483,18 @@ func buildNationalNumberForParsing(
 		}
 		nationalNumber.WriteString(
 			numberToParse[indexOfNationalNumber:indexOfPhoneContext])
+		start = indexOfNationalNumber
 	} else {
 		// Extract a possible number from the string passed in (this
 		// strips leading characters that could not be the start of a
 		// phone number.)
-		nationalNumber.WriteString(extractPossibleNumber(numberToParse))
+		possibleNumber := extractPossibleNumber(numberToParse)
+		nationalNumber.WriteString(possibleNumber)
+		if possibleNumber == "" {
+			start = len(numberToParse)
+		} else {
+			start = strings.Index(numberToParse, possibleNumber)
+		}
 	}
 
 	// Delete the isdn-subaddress and everything after it if it is present.
@@ -3027,6 +3510,7 @@ func buildNationalNumberForParsing(
 	// This is because we are concerned about deleting content from a
 	// potential number string when there is no strong evidence that the
 	// number is actually written in RFC3966.
+	return start, contiguous, nil
 }
 
 // Takes two phone numbers and compares them for equality.
@@ -3055,9 +3539,11 @@ func isNumberMatchWithNumbers(firstNumberIn, secondNumberIn *PhoneNumber) MatchT
 	firstNumber.RawInput = nil
 	firstNumber.CountryCodeSource = nil
 	firstNumber.PreferredDomesticCarrierCode = nil
+	firstNumber.NationalPrefixInParentheses = nil
 	secondNumber.RawInput = nil
 	secondNumber.CountryCodeSource = nil
 	secondNumber.PreferredDomesticCarrierCode = nil
+	secondNumber.NationalPrefixInParentheses = nil
 
 	firstNumExt := firstNumber.GetExtension()
 	secondNumExt := secondNumber.GetExtension()
@@ -3130,14 +3616,14 @@ func IsNumberMatch(firstNumber, secondNumber string) MatchType {
 	firstNumberAsProto, err := Parse(firstNumber, UNKNOWN_REGION)
 	if err == nil {
 		return isNumberMatchWithOneNumber(firstNumberAsProto, secondNumber)
-	} else if err != ErrInvalidCountryCode {
+	} else if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 
 	secondNumberAsProto, err := Parse(secondNumber, UNKNOWN_REGION)
 	if err == nil {
 		return isNumberMatchWithOneNumber(secondNumberAsProto, firstNumber)
-	} else if err != ErrInvalidCountryCode {
+	} else if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 
@@ -3164,7 +3650,7 @@ func isNumberMatchWithOneNumber(
 	if err == nil {
 		return isNumberMatchWithNumbers(firstNumber, secondNumberAsProto)
 	}
-	if err != ErrInvalidCountryCode {
+	if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 	// The second number has no country calling code. EXACT_MATCH is no
@@ -3228,14 +3714,18 @@ func IsMobileNumberPortableRegion(regionCode string) bool {
 
 func init() {
 	// load our regions
-	regionMap, err := loadIntStringArrayMap(regionMapData)
+	regionMap, err := prefixmap.LoadIntStringArrayMap(regionMapData)
 	if err != nil {
 		panic(err)
 	}
 	countryCodeToRegion = regionMap.Map
 
-	// then our metadata
-	err = loadMetadataFromFile("US", 1)
+	// then the index of our metadata, the metadata for each region is decoded on first use
+	err = loadMetadataIndex(metadataData)
+	if err != nil {
+		panic(err)
+	}
+	err = loadClassifierIndex(classifierData)
 	if err != nil {
 		panic(err)
 	}
@@ -3268,118 +3758,54 @@ func init() {
 	for _, val := range countryCodeToRegion[NANPA_COUNTRY_CODE] {
 		writeToNanpaRegions(val, struct{}{})
 	}
-
-	// Create our sync.Onces for each of our languages for carriers
-	for lang := range carrierMapData {
-		carrierOnces[lang] = &sync.Once{}
-	}
-	for lang := range geocodingMapData {
-		geocodingOnces[lang] = &sync.Once{}
-	}
 }
 
+// ErrPrefixDataNotLoaded is returned by the deprecated carrier, geocoding and timezone lookups
+// when the package containing the data they need hasn't been imported.
+var ErrPrefixDataNotLoaded = errors.New("prefix data not loaded")
+
 // GetTimezonesForPrefix returns a slice of Timezones corresponding to the number passed
 // or error when it is impossible to convert the string to int
-// The algorythm tries to match the timezones starting from the maximum
-// number of phone number digits and decreasing until it finds one or reaches 0
+//
+// Deprecated: use timezone.GetTimezonesForPrefix from github.com/nyaruka/phonenumbers/timezone,
+// this only works when that package has been imported.
 func GetTimezonesForPrefix(number string) ([]string, error) {
-	var err error
-	timezoneOnce.Do(func() {
-		timezoneMap, err = loadIntStringArrayMap(timezoneMapData)
-	})
-
-	if timezoneMap == nil {
-		return nil, fmt.Errorf("error loading timezone map: %v", err)
-	}
-
-	// strip any leading +
-	number = strings.TrimLeft(number, "+")
-
-	for i := timezoneMap.MaxLength; i > 0; i-- {
-		index, err := strconv.Atoi(number[0:i])
-		if err != nil {
-			return nil, err
-		}
-		tzs, found := timezoneMap.Map[index]
-		if found {
-			return tzs, nil
-		}
+	if prefixmap.TimezonesForPrefix == nil {
+		return nil, fmt.Errorf("%w: import github.com/nyaruka/phonenumbers/timezone", ErrPrefixDataNotLoaded)
 	}
-	return []string{UNKNOWN_TIMEZONE}, nil
+	return prefixmap.TimezonesForPrefix(number)
 }
 
 // GetTimezonesForNumber returns the names of timezones which we believe maps to the
 // passed in number.
+//
+// Deprecated: use timezone.GetTimezonesForNumber from github.com/nyaruka/phonenumbers/timezone,
+// this only works when that package has been imported.
 func GetTimezonesForNumber(number *PhoneNumber) ([]string, error) {
 	e164 := Format(number, E164)
 	return GetTimezonesForPrefix(e164)
 }
 
-func getValueForNumber(onceMap map[string]*sync.Once, langMap map[string]*intStringMap, binMap map[string]string, language string, maxLength int, number *PhoneNumber) (string, error) {
-	// do we have data for this language
-	_, existing := binMap[language]
-	if !existing {
-		return "", nil
-	}
-
-	// load it into our map
-	onceMap[language].Do(func() {
-		prefixMap, err := loadPrefixMap(binMap[language])
-		if err == nil {
-			langMap[language] = prefixMap
-		}
-	})
-
-	// do we have a map for this language?
-	prefixMap, ok := langMap[language]
-	if !ok {
-		return "", fmt.Errorf("error loading language map for %s", language)
-	}
-
-	e164 := Format(number, E164)
-
-	l := len(e164)
-	if maxLength > l {
-		maxLength = l
-	}
-	for i := maxLength; i > 1; i-- {
-		index, err := strconv.Atoi(e164[0:i])
-		if err != nil {
-			return "", err
-		}
-		if value, has := prefixMap.Map[index]; has {
-			return value, nil
-		}
-	}
-	return "", nil
-}
-
 // GetCarrierForNumber returns the carrier we believe the number belongs to. Note due
 // to number porting this is only a guess, there is no guarantee to its accuracy.
+//
+// Deprecated: use carrier.GetCarrierForNumber from github.com/nyaruka/phonenumbers/carrier,
+// this only works when that package has been imported.
 func GetCarrierForNumber(number *PhoneNumber, lang string) (string, error) {
-	carrier, err := getValueForNumber(carrierOnces, carrierPrefixMap, carrierMapData, lang, 10, number)
-	if err != nil {
-		return "", err
-	}
-	if carrier != "" {
-		return carrier, nil
+	if prefixmap.CarrierForE164 == nil {
+		return "", fmt.Errorf("%w: import github.com/nyaruka/phonenumbers/carrier", ErrPrefixDataNotLoaded)
 	}
-
-	// fallback to english
-	return getValueForNumber(carrierOnces, carrierPrefixMap, carrierMapData, "en", 10, number)
+	return prefixmap.CarrierForE164(Format(number, E164), lang)
 }
 
 // GetGeocodingForNumber returns the location we think the number was first acquired in. This is
 // just our best guess, there is no guarantee to its accuracy.
+//
+// Deprecated: use geocoder.GetGeocodingForNumber from github.com/nyaruka/phonenumbers/geocoder,
+// this only works when that package has been imported.
 func GetGeocodingForNumber(number *PhoneNumber, lang string) (string, error) {
-	geocoding, err := getValueForNumber(geocodingOnces, geocodingPrefixMap, geocodingMapData, lang, 10, number)
-	if err != nil {
-		return "", err
-	}
-	if geocoding != "" {
-		return geocoding, nil
+	if prefixmap.GeocodingForE164 == nil {
+		return "", fmt.Errorf("%w: import github.com/nyaruka/phonenumbers/geocoder", ErrPrefixDataNotLoaded)
 	}
-
-	// fallback to english
-	return getValueForNumber(geocodingOnces, geocodingPrefixMap, geocodingMapData, "en", 10, number)
+	return prefixmap.GeocodingForE164(Format(number, E164), lang)
 }
@@ -1,12 +1,16 @@
 package phonenumbers
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/nyaruka/phonenumbers/internal/prefixmap"
 )
 
 func TestParse(t *testing.T) {
@@ -76,7 +80,7 @@ func TestParse(t *testing.T) {
 
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
-		if err != test.err {
+		if !errors.Is(err, test.err) {
 			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
 		}
 		if num.GetNationalNumber() != test.expectedNum {
@@ -85,6 +89,445 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParsePhoneContext(t *testing.T) {
+	// phone-contexts followed by other parameters used to be sliced out of bounds and panic
+	var tests = []struct {
+		input    string
+		expected string
+	}{
+		{"tel:253-0000;phone-context=+1-650", "+16502530000"},
+		{"tel:253-0000;phone-context=+1-650;ext=1", "+16502530000"},
+		{"tel:2530000;isub=12345;phone-context=+1-650", "+16502530000"},
+		{"tel:253-0000;phone-context=+1-650;isub=12345;ext=1", "+16502530000"},
+	}
+
+	for i, test := range tests {
+		num, err := Parse(test.input, "US")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.input, err)
+			continue
+		}
+		if formatted := Format(num, E164); formatted != test.expected {
+			t.Errorf("[test %d:e164] %s != %s", i, formatted, test.expected)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	var tests = []struct {
+		input  string
+		region string
+		reason ParseErrorReason
+		offset int
+		errRgn string
+	}{
+		{"", "US", PARSE_NOT_A_NUMBER, 0, "US"},
+		{"hello", "US", PARSE_NOT_A_NUMBER, 5, "US"},
+		{"call me on 1", "US", PARSE_NOT_A_NUMBER, 11, "US"},
+		{"tel:253-0000;phone-context=", "US", PARSE_NOT_A_NUMBER, 27, "US"},
+		{"253-0000", "ZZ", PARSE_INVALID_COUNTRY_CODE, 0, "ZZ"},
+		{"number: +999 123 4567", "US", PARSE_INVALID_COUNTRY_CODE, 9, "US"},
+		{"011 999 123 4567", "US", PARSE_INVALID_COUNTRY_CODE, 4, "US"},
+		{"011 4", "US", PARSE_TOO_SHORT_AFTER_IDD, 4, "US"},
+		{"011 49", "US", PARSE_TOO_SHORT_AFTER_IDD, 4, "US"},
+		{"+800 1", "US", PARSE_TOO_SHORT_NSN, 6, "001"},
+		{"+44 1234 5678 9012 3456 7890", "US", PARSE_TOO_LONG, 25, "GB"},
+		{strings.Repeat("1", 251), "US", PARSE_TOO_LONG, 250, "US"},
+	}
+
+	for i, test := range tests {
+		_, err := Parse(test.input, test.region)
+
+		parseErr, isParseErr := err.(*ParseError)
+		if !isParseErr {
+			t.Errorf("[test %d] expected parse error for '%s', got %v", i, test.input, err)
+			continue
+		}
+		if parseErr.Reason != test.reason {
+			t.Errorf("[test %d:reason] %d != %d", i, parseErr.Reason, test.reason)
+		}
+		if parseErr.Input != test.input {
+			t.Errorf("[test %d:input] %s != %s", i, parseErr.Input, test.input)
+		}
+		if parseErr.Offset != test.offset {
+			t.Errorf("[test %d:offset] %d != %d", i, parseErr.Offset, test.offset)
+		}
+		if parseErr.Region != test.errRgn {
+			t.Errorf("[test %d:region] %s != %s", i, parseErr.Region, test.errRgn)
+		}
+		if !errors.Is(err, parseErrorSentinels[test.reason]) {
+			t.Errorf("[test %d:is] %v is not %v", i, err, parseErrorSentinels[test.reason])
+		}
+		if err.Error() != parseErrorSentinels[test.reason].Error() {
+			t.Errorf("[test %d:message] %s != %s", i, err.Error(), parseErrorSentinels[test.reason].Error())
+		}
+	}
+}
+
+func TestParseWithOptions(t *testing.T) {
+	var tests = []struct {
+		input   string
+		opts    ParseOptions
+		err     error
+		number  string
+		carrier bool
+		raw     string
+	}{
+		{"(206) 779-9191", ParseOptions{DefaultRegion: "US"}, nil, "+12067799191", false, ""},
+		{"(206) 779-9191", ParseOptions{DefaultRegion: "US", KeepRawInput: true}, nil, "+12067799191", false, "(206) 779-9191"},
+		{"(206) 779-9191", ParseOptions{}, ErrInvalidCountryCode, "", false, ""},
+		{"(206) 779-9191", ParseOptions{SkipRegionCheck: true}, nil, "+02067799191", false, ""},
+
+		// carrier codes
+		{"0 15 11 4444 4444", ParseOptions{DefaultRegion: "BR"}, nil, "+551144444444", false, ""},
+		{"0 15 11 4444 4444", ParseOptions{DefaultRegion: "BR", KeepCarrierCode: true}, nil, "+551144444444", true, ""},
+
+		// allowed regions and calling codes
+		{"+12067799191", ParseOptions{AllowedRegions: []string{"US", "CA"}}, nil, "+12067799191", false, ""},
+		{"+12067799191", ParseOptions{AllowedRegions: []string{"ca"}}, ErrRegionNotAllowed, "", false, ""},
+		{"+442083661177", ParseOptions{AllowedRegions: []string{"US"}}, ErrRegionNotAllowed, "", false, ""},
+		{"+12001230101", ParseOptions{AllowedRegions: []string{"US"}}, nil, "+12001230101", false, ""},
+		{"+442083661177", ParseOptions{AllowedCallingCodes: []int{44}}, nil, "+442083661177", false, ""},
+		{"+12067799191", ParseOptions{AllowedCallingCodes: []int{44}}, ErrRegionNotAllowed, "", false, ""},
+		{"+12067799191", ParseOptions{AllowedRegions: []string{"US"}, AllowedCallingCodes: []int{44}}, ErrRegionNotAllowed, "", false, ""},
+
+		// validity
+		{"+12001230101", ParseOptions{}, nil, "+12001230101", false, ""},
+		{"+12001230101", ParseOptions{RequireValid: true}, ErrInvalidNumber, "", false, ""},
+		{"+12067799191", ParseOptions{RequireValid: true}, nil, "+12067799191", false, ""},
+
+		// alpha characters and extensions
+		{"1-800-FLOWERS", ParseOptions{DefaultRegion: "US"}, nil, "+18003569377", false, ""},
+		{"1-800-FLOWERS", ParseOptions{DefaultRegion: "US", DisallowAlpha: true}, ErrAlphaNotAllowed, "", false, ""},
+		{"+12067799191 ext. 123", ParseOptions{DisallowAlpha: true}, nil, "+12067799191", false, ""},
+		{"+12067799191 ext. 123", ParseOptions{DisallowExtension: true}, ErrExtensionNotAllowed, "", false, ""},
+
+		// max input length
+		{"+12067799191", ParseOptions{MaxInputLength: 10}, ErrNumTooLong, "", false, ""},
+		{"+12067799191", ParseOptions{MaxInputLength: 12}, nil, "+12067799191", false, ""},
+	}
+
+	for i, test := range tests {
+		num, err := ParseWithOptions(test.input, test.opts)
+		if !errors.Is(err, test.err) {
+			t.Errorf("[test %d:err] failed: %v != %v", i, err, test.err)
+			continue
+		}
+		if test.err != nil {
+			if _, isParseErr := err.(*ParseError); !isParseErr {
+				t.Errorf("[test %d:err] expected parse error, got %T", i, err)
+			}
+			continue
+		}
+		if formatted := Format(num, E164); formatted != test.number {
+			t.Errorf("[test %d:number] %s != %s", i, formatted, test.number)
+		}
+		if (num.PreferredDomesticCarrierCode != nil) != test.carrier {
+			t.Errorf("[test %d:carrier] %v != %v", i, num.PreferredDomesticCarrierCode != nil, test.carrier)
+		}
+		if num.GetRawInput() != test.raw {
+			t.Errorf("[test %d:raw] %s != %s", i, num.GetRawInput(), test.raw)
+		}
+	}
+}
+
+func TestParseNationalPrefixInParentheses(t *testing.T) {
+	var tests = []struct {
+		input     string
+		region    string
+		number    string
+		bracketed bool
+	}{
+		{"+44 (0)20 7946 0000", "US", "+442079460000", true},
+		{"+44(0)2079460000", "US", "+442079460000", true},
+		{"+49 (0) 30 1234567", "US", "+49301234567", true},
+		{"0049 (0)30 1234567", "GB", "+49301234567", true},
+		{"+7 (8) 495 123 4567", "US", "+74951234567", true},
+		{"+44 20 7946 0000", "US", "+442079460000", false},
+		{"020 7946 0000", "GB", "+442079460000", false},
+
+		// the rest of the number is stripped as usual, applying the transform rule after the prefix
+		{"+54 (0)11 15 8765 4321", "US", "+5491187654321", true},
+		{"+61 (0)1831 2 9876 5432", "US", "+61298765432", true},
+
+		// only the national prefix of the region is dropped, Italian numbers keep their leading zero
+		{"+39 (0)6 1234 5678", "US", "+390612345678", false},
+		{"+49 (1)30 1234567", "US", "+491301234567", false},
+	}
+
+	for i, test := range tests {
+		num, err := ParseAndKeepRawInput(test.input, test.region)
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.input, err)
+			continue
+		}
+		if Format(num, E164) != test.number {
+			t.Errorf("[test %d:number] %s != %s", i, Format(num, E164), test.number)
+		}
+		if num.GetNationalPrefixInParentheses() != test.bracketed {
+			t.Errorf("[test %d:bracketed] %v != %v", i, num.GetNationalPrefixInParentheses(), test.bracketed)
+		}
+
+		// which is only recorded when keeping the raw input
+		num, _ = Parse(test.input, test.region)
+		if num.NationalPrefixInParentheses != nil {
+			t.Errorf("[test %d:parse] expected no national prefix in parentheses for Parse", i)
+		}
+	}
+}
+
+func TestParseWithRegionHints(t *testing.T) {
+	var tests = []struct {
+		input   string
+		regions []string
+		err     error
+		number  string
+		ranking []string
+		reasons []string
+	}{
+		{
+			"020 8366 1177", []string{"US", "GB"}, nil, "+442083661177",
+			[]string{"GB", "US"},
+			[]string{"won: is a valid number", "lost: GB is a valid number"},
+		},
+		{
+			"+1 206 779 9191", []string{"CA", "US"}, nil, "+12067799191",
+			[]string{"US", "CA"},
+			[]string{"won: is from the hinted region", "lost: US is from the hinted region"},
+		},
+		{
+			"(206) 779-9191", []string{"GB", "ZZ", "US"}, nil, "+12067799191",
+			[]string{"US", "GB", "ZZ"},
+			[]string{"won: is a valid number", "lost: US is a valid number", "lost: US could be parsed"},
+		},
+		{
+			"2067799191", []string{"US", "CA"}, nil, "+12067799191",
+			[]string{"US", "CA"},
+			[]string{"won: is from the hinted region", "lost: US is from the hinted region"},
+		},
+		{
+			"6502530000", []string{"DE"}, nil, "+496502530000",
+			[]string{"DE"},
+			[]string{"won: only region hinted"},
+		},
+		{
+			"+442083661177", nil, nil, "+442083661177",
+			[]string{"ZZ"},
+			[]string{"won: only region hinted"},
+		},
+		{
+			"hello", []string{"US", "GB"}, ErrNotANumber, "",
+			[]string{"US", "GB"},
+			[]string{"won: was hinted first", "lost: US was hinted first"},
+		},
+
+		// regions hinted more than once are only interpreted once
+		{
+			"020 8366 1177", []string{"GB", "GB"}, nil, "+442083661177",
+			[]string{"GB"},
+			[]string{"won: only region hinted"},
+		},
+		{
+			"020 8366 1177", []string{"GB", "US", "gb"}, nil, "+442083661177",
+			[]string{"GB", "US"},
+			[]string{"won: is a valid number", "lost: GB is a valid number"},
+		},
+		{
+			"+442083661177", []string{"", "ZZ"}, nil, "+442083661177",
+			[]string{""},
+			[]string{"won: only region hinted"},
+		},
+	}
+
+	for i, test := range tests {
+		num, interpretations, err := ParseWithRegionHints(test.input, test.regions)
+		if !errors.Is(err, test.err) {
+			t.Errorf("[test %d:err] failed: %v != %v", i, err, test.err)
+		}
+		if test.err == nil && Format(num, E164) != test.number {
+			t.Errorf("[test %d:number] %s != %s", i, Format(num, E164), test.number)
+		}
+
+		ranking := make([]string, len(interpretations))
+		reasons := make([]string, len(interpretations))
+		for j, interpretation := range interpretations {
+			ranking[j] = interpretation.Region
+			reasons[j] = interpretation.Reason
+		}
+		if !reflect.DeepEqual(ranking, test.ranking) {
+			t.Errorf("[test %d:ranking] %v != %v", i, ranking, test.ranking)
+		}
+		if !reflect.DeepEqual(reasons, test.reasons) {
+			t.Errorf("[test %d:reasons] %v != %v", i, reasons, test.reasons)
+		}
+	}
+}
+
+func TestSuggestCorrections(t *testing.T) {
+	var tests = []struct {
+		input    string
+		region   string
+		best     string
+		kind     CorrectionKind
+		distance int
+		includes string
+	}{
+		{"+44 44 20 8366 1177", "US", "+442083661177", CORRECTION_REPEATED_COUNTRY_CODE, 2, ""},
+		{"0044 20 8366 1177", "US", "+442083661177", CORRECTION_IDD_PREFIX, 2, ""},
+		{"+00 44 20 8366 1177", "US", "+442083661177", CORRECTION_IDD_PREFIX, 2, ""},
+		{"206 779 91911", "US", "+12677991911", CORRECTION_EXTRA_DIGIT, 1, "+12067799191"},
+		{"020 8366 117", "GB", "+441208366117", CORRECTION_MISSING_DIGIT, 1, "+442083661177"},
+		{"+49 49 30 1234567", "DE", "+49349301234567", CORRECTION_MISSING_DIGIT, 1, "+49301234567"},
+		{"01624 756789", "GB", "+441624756789", CORRECTION_OTHER_REGION, 0, ""},
+		{"07797 712345", "GB", "+447797712345", CORRECTION_OTHER_REGION, 0, ""},
+
+		// valid numbers and things which aren't numbers have no corrections
+		{"+44 20 8366 1177", "US", "", "", 0, ""},
+		{"+44 1624 756789", "GB", "", "", 0, ""},
+		{"01624 756789", "IM", "", "", 0, ""},
+		{"hello", "US", "", "", 0, ""},
+	}
+
+	for i, test := range tests {
+		corrections := SuggestCorrections(test.input, test.region)
+		if test.best == "" {
+			if len(corrections) != 0 {
+				t.Errorf("[test %d] expected no corrections for %s, got %d", i, test.input, len(corrections))
+			}
+			continue
+		}
+		if len(corrections) == 0 {
+			t.Errorf("[test %d] expected corrections for %s, got none", i, test.input)
+			continue
+		}
+
+		best := corrections[0]
+		if Format(best.Number, E164) != test.best {
+			t.Errorf("[test %d:best] %s != %s", i, Format(best.Number, E164), test.best)
+		}
+		if best.Kind != test.kind {
+			t.Errorf("[test %d:kind] %s != %s", i, best.Kind, test.kind)
+		}
+		if best.Distance != test.distance {
+			t.Errorf("[test %d:distance] %d != %d", i, best.Distance, test.distance)
+		}
+
+		found := test.includes == ""
+		for j, correction := range corrections {
+			if !IsValidNumber(correction.Number) {
+				t.Errorf("[test %d:valid] correction %s is not valid", i, Format(correction.Number, E164))
+			}
+			if j > 0 && correction.Distance < corrections[j-1].Distance {
+				t.Errorf("[test %d:order] corrections not ordered by distance", i)
+			}
+			found = found || Format(correction.Number, E164) == test.includes
+		}
+		if !found {
+			t.Errorf("[test %d:includes] expected %s in corrections", i, test.includes)
+		}
+	}
+
+	// numbers from other regions sharing the calling code are only valid for those regions
+	corrections := SuggestCorrections("01624 756789", "GB")
+	if len(corrections) == 0 || corrections[0].Region != "IM" || IsValidNumberForRegion(corrections[0].Number, "GB") {
+		t.Errorf("expected correction to be valid only for IM, got %v", corrections)
+	}
+}
+
+func TestParseList(t *testing.T) {
+	var tests = []struct {
+		input   string
+		region  string
+		numbers []string
+		spans   [][2]int
+		errors  []string
+	}{
+		{"020 7946 0000 / 0001", "GB", []string{"+442079460000", "+442079460001"}, [][2]int{{0, 13}, {16, 20}}, nil},
+		{"020 7946 0000 / 01 / 0002", "GB", []string{"+442079460000", "+442079460001", "+442079460002"}, [][2]int{{0, 13}, {16, 18}, {21, 25}}, nil},
+		{"555-1234 or 555-5678", "US", []string{"+15551234", "+15555678"}, [][2]int{{0, 8}, {12, 20}}, nil},
+		{"(650) 253-0000, 253-0001", "US", []string{"+16502530000", "+16502530001"}, [][2]int{{0, 14}, {16, 24}}, nil},
+		{"+1 212 555 0100 ext 10, 11", "US", []string{"+12125550100 ext. 10", "+12125550100 ext. 11"}, [][2]int{{0, 22}, {24, 26}}, nil},
+		{"0800 123 456-9", "GB", []string{"+44800123456", "+44800123457", "+44800123458", "+44800123459"}, [][2]int{{0, 14}, {0, 14}, {0, 14}, {0, 14}}, nil},
+		{"0800 123 456 - 9", "GB", []string{"+44800123456", "+44800123457", "+44800123458", "+44800123459"}, [][2]int{{0, 16}, {0, 16}, {0, 16}, {0, 16}}, nil},
+		{"0800 123 456–9", "GB", []string{"+44800123456", "+44800123457", "+44800123458", "+44800123459"}, [][2]int{{0, 16}, {0, 16}, {0, 16}, {0, 16}}, nil},
+		{"(650) 253-0000-2", "US", []string{"+16502530000", "+16502530001", "+16502530002"}, [][2]int{{0, 16}, {0, 16}, {0, 16}}, nil},
+
+		// German direct dial numbers and extension suffixes aren't ranges, unless marked as one
+		{"030 1234567-89", "DE", []string{"+4930123456789"}, [][2]int{{0, 14}}, nil},
+		{"(0)30 12345-67", "DE", []string{"+49301234567"}, [][2]int{{0, 14}}, nil},
+		{"+49 30 12345-67", "US", []string{"+49301234567"}, [][2]int{{0, 15}}, nil},
+		{"030 12345 - 7", "DE", []string{"+493012345", "+493012346", "+493012347"}, [][2]int{{0, 13}, {0, 13}, {0, 13}}, nil},
+		{"030/1234567; 030 1234568", "DE", []string{"+49301234567", "+49301234568"}, [][2]int{{0, 11}, {13, 24}}, nil},
+		{"+44 20 7946 0000 and +1 650 253 0000", "US", []string{"+442079460000", "+16502530000"}, [][2]int{{0, 16}, {21, 36}}, nil},
+		{"foo, 020 7946 0000", "GB", []string{"+442079460000"}, [][2]int{{5, 18}}, []string{"foo"}},
+		{"", "GB", []string{}, [][2]int{}, nil},
+	}
+
+	for i, test := range tests {
+		numbers, errs := ParseList(test.input, test.region)
+
+		formatted := make([]string, len(numbers))
+		spans := make([][2]int, len(numbers))
+		for j, number := range numbers {
+			formatted[j] = Format(number.Number, E164)
+			if number.Number.GetExtension() != "" {
+				formatted[j] += " ext. " + number.Number.GetExtension()
+			}
+			spans[j] = [2]int{number.Start, number.End}
+		}
+		if !reflect.DeepEqual(formatted, test.numbers) {
+			t.Errorf("[test %d:numbers] %v != %v", i, formatted, test.numbers)
+		}
+		if !reflect.DeepEqual(spans, test.spans) {
+			t.Errorf("[test %d:spans] %v != %v", i, spans, test.spans)
+		}
+
+		var errTexts []string
+		for _, err := range errs {
+			errTexts = append(errTexts, test.input[err.Start:err.End])
+			if err.Text != test.input[err.Start:err.End] {
+				t.Errorf("[test %d:error] text %s doesn't match span", i, err.Text)
+			}
+		}
+		if !reflect.DeepEqual(errTexts, test.errors) {
+			t.Errorf("[test %d:errors] %v != %v", i, errTexts, test.errors)
+		}
+	}
+}
+
+func TestParseListErrors(t *testing.T) {
+	var tests = []struct {
+		input  string
+		region string
+		text   string
+		reason ParseErrorReason
+		offset int
+	}{
+		{"+1 212 555 0100 ext 10, 123456789", "US", "123456789", PARSE_TOO_LONG, 7},
+		{"+44 20 7946 0000 / 000000000000", "GB", "000000000000", PARSE_INVALID_COUNTRY_CODE, 0},
+	}
+
+	for i, test := range tests {
+		_, errs := ParseList(test.input, test.region)
+		if len(errs) != 1 {
+			t.Errorf("[test %d:errors] %d != 1", i, len(errs))
+			continue
+		}
+		if errs[0].Text != test.text {
+			t.Errorf("[test %d:text] %s != %s", i, errs[0].Text, test.text)
+		}
+
+		var parseErr *ParseError
+		if !errors.As(errs[0], &parseErr) {
+			t.Errorf("[test %d:type] %T is not a ParseError", i, errs[0].Err)
+			continue
+		}
+		if parseErr.Reason != test.reason || parseErr.Input != test.text || parseErr.Offset != test.offset {
+			t.Errorf("[test %d:error] %+v != {%d %s %d}", i, parseErr, test.reason, test.text, test.offset)
+		}
+	}
+}
+
 func TestConvertAlphaCharactersInNumber(t *testing.T) {
 	var tests = []struct {
 		input, output string
@@ -102,6 +545,62 @@ func TestConvertAlphaCharactersInNumber(t *testing.T) {
 	}
 }
 
+func TestNormalizeSpokenNumber(t *testing.T) {
+	var tests = []struct {
+		text     string
+		lang     string
+		expected string
+	}{
+		{"oh two oh seven nine four six", "en", "0207946"},
+		{"Double five, triple two, eight", "en", "552228"},
+		{"plus four four two oh seven nine four six oh oh oh oh", "en-GB", "+442079460000"},
+		{"my number is 020 seven nine four six, um, zero zero zero zero", "en", "02079460000"},
+		{"six five oh two five three oh oh oh oh extension one two", "en", "6502530000 ext. 12"},
+		{"zwei drei vier", "de", "234"},
+		{"plus vier neun, dreißig, eins zwo drei vier fünf sechs sieben Durchwahl doppel null", "de", "+49301234567 ext. 00"},
+		{"cinq cinq", "fr", "55"},
+		{"plus trois trois un deux trois quatre cinq six sept huit neuf poste douze", "fr", "+33123456789 ext. 12"},
+		{"zéro un, double deux", "fr", "0122"},
+		{"más tres cuatro nueve uno dos tres cuatro cinco seis siete ocho", "es", "+34912345678"},
+		{"cero ocho, doble cinco", "es-MX", "0855"},
+		{"um um, meia meia, dois três", "pt-BR", "116623"},
+		{"mais cinco cinco onze ramal três", "pt", "+5511 ext. 3"},
+		{"one two three", "xx", ""},
+
+		// teens and tens
+		{"oh two oh, seven nine four six, double twenty", "en", "02079462020"},
+		{"eight hundred twenty one", "en", ""},
+		{"fifteen twenty one", "en", "1521"},
+		{"null dreißig, einundzwanzig zwölf", "de", "0302112"},
+		{"zweihundert", "de", ""},
+		{"zéro un, soixante-douze, quatre-vingt-dix-sept, dix-sept, vingt et un", "fr", "0172971721"},
+		{"quatre-vingts cinq", "fr", "85"},
+		{"deux cents", "fr", ""},
+		{"cero, treinta y dos, veintiuno, quince", "es", "0322115"},
+		{"doscientos", "es", ""},
+		{"zero, vinte e um, dezoito, meia", "pt-BR", "021186"},
+		{"quinhentos", "pt", ""},
+		{"1-800-FLOWERS", "en", "1800"},
+		{"five *  two #", "en", "5*2#"},
+	}
+
+	for i, test := range tests {
+		normalized := NormalizeSpokenNumber(test.text, test.lang)
+		if normalized != test.expected {
+			t.Errorf("[test %d:normalized] %s != %s", i, normalized, test.expected)
+		}
+	}
+
+	// and the result should be something we can parse
+	num, err := Parse(NormalizeSpokenNumber("plus four four two oh seven nine four six oh oh oh oh extension double three", "en"), "")
+	if err != nil {
+		t.Fatalf("failed to parse normalized number: %s", err)
+	}
+	if Format(num, E164) != "+442079460000" || num.GetExtension() != "33" {
+		t.Errorf("unexpected number %s ext %s", Format(num, E164), num.GetExtension())
+	}
+}
+
 func TestNormalizeDigits(t *testing.T) {
 	var tests = []struct {
 		input         string
@@ -309,7 +808,7 @@ func TestIsValidNumber(t *testing.T) {
 
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
-		if err != test.err {
+		if !errors.Is(err, test.err) {
 			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
 		}
 		if test.err != nil {
@@ -395,7 +894,7 @@ func TestIsValidNumberForRegion(t *testing.T) {
 
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
-		if err != test.err {
+		if !errors.Is(err, test.err) {
 			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
 		}
 		if test.err != nil {
@@ -466,7 +965,7 @@ func TestIsPossibleNumberWithReason(t *testing.T) {
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
 		if err != nil {
-			if test.err == err {
+			if errors.Is(err, test.err) {
 				continue
 			}
 			t.Errorf("[test %d:err] failed: %v\n", i, err)
@@ -605,86 +1104,829 @@ func TestFormatForMobileDialing(t *testing.T) {
 	}
 }
 
-func TestFormatByPattern(t *testing.T) {
-	var tcs = []struct {
-		in          string
-		region      string
-		format      PhoneNumberFormat
-		userFormats []*NumberFormat
-		exp         string
+func TestFormatMasked(t *testing.T) {
+	var tests = []struct {
+		number   string
+		format   PhoneNumberFormat
+		opts     MaskOptions
+		expected string
 	}{
-		{
-			in:     "+33122334455",
-			region: "FR",
-			format: E164,
-			userFormats: []*NumberFormat{
-				&NumberFormat{
-					Pattern: s(`(\d+)`),
-					Format:  s(`$1`),
-				},
-			},
-			exp: "+33122334455",
-		}, {
-			in:     "+442070313000",
-			region: "UK",
-			format: NATIONAL,
-			userFormats: []*NumberFormat{
-				&NumberFormat{
-					Pattern: s(`(20)(\d{4})(\d{4})`),
-					Format:  s(`$1 $2 $3`),
-				},
-			},
-			exp: "20 7031 3000",
-		},
+		{"+442079460000", INTERNATIONAL, MaskOptions{RevealDigits: 4}, "+44 20 •••• 0000"},
+		{"+442079460000", NATIONAL, MaskOptions{RevealDigits: 4}, "020 •••• 0000"},
+		{"+442079460000", E164, MaskOptions{RevealDigits: 4}, "+4420••••0000"},
+		{"+442079460000", RFC3966, MaskOptions{RevealDigits: 4}, "tel:+44-20-••••-0000"},
+		{"+442079460000", INTERNATIONAL, MaskOptions{}, "+44 20 •••• ••••"},
+		{"+442079460000", INTERNATIONAL, MaskOptions{MaskChar: 'X', RevealDigits: 2}, "+44 20 XXXX XX00"},
+		{"+442079460000", INTERNATIONAL, MaskOptions{RevealDigits: 20}, "+44 20 •946 0000"},
+		{"+447912345678", INTERNATIONAL, MaskOptions{RevealDigits: 4}, "+44 7912 ••5678"},
+		{"+16502530000", NATIONAL, MaskOptions{RevealDigits: 4}, "(650) •••-0000"},
+		{"+5491187654321", INTERNATIONAL, MaskOptions{RevealDigits: 4}, "+54 9 11 ••••-4321"},
+		{"+390236618300", INTERNATIONAL, MaskOptions{RevealDigits: 4}, "+39 02 •••• 8300"},
+		{"+16502530000 ext. 1234", INTERNATIONAL, MaskOptions{RevealDigits: 4}, "+1 650-•••-0000 ext. ••••"},
+		{"+16502530000 ext. 1234", NATIONAL, MaskOptions{RevealDigits: 4, RevealExtensionDigits: 1}, "(650) •••-0000 ext. •••4"},
+		{"+16502530000 ext. 1234", RFC3966, MaskOptions{RevealExtensionDigits: 4}, "tel:+1-650-•••-••••;ext=1234"},
+		{"+16502530000 ext. 1234", E164, MaskOptions{RevealDigits: 4}, "+1650•••0000"},
+
+		// negative reveals never mask the country code or national destination code
+		{"+442079460000", INTERNATIONAL, MaskOptions{RevealDigits: -3}, "+44 20 •••• ••••"},
+		{"+16502530000 ext. 1234", NATIONAL, MaskOptions{RevealDigits: -1, RevealExtensionDigits: -2}, "(650) •••-•••• ext. ••••"},
 	}
 
-	for i, tc := range tcs {
-		num, err := Parse(tc.in, tc.region)
+	for i, test := range tests {
+		num, err := Parse(test.number, "")
 		if err != nil {
-			t.Errorf("[test %d] failed: should be able to parse, err:%v\n", i, err)
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.number, err)
+			continue
 		}
-		got := FormatByPattern(num, tc.format, tc.userFormats)
-		if got != tc.exp {
-			t.Errorf("[test %d:fmt] failed %s != %s\n", i, got, tc.exp)
+		if masked := FormatMasked(num, test.format, test.opts); masked != test.expected {
+			t.Errorf("[test %d:masked] %s != %s", i, masked, test.expected)
 		}
 	}
 }
 
-func TestFormatInOriginalFormat(t *testing.T) {
+func TestFormatForSpeech(t *testing.T) {
 	var tests = []struct {
-		in     string
-		exp    string
-		region string
-		frmt   PhoneNumberFormat
+		number   string
+		lang     string
+		opts     SpeechOptions
+		expected string
 	}{
-		{
-			in:     "0987654321",
-			region: "DE",
-			exp:    "09876 54321",
-		}, {
-			in:     "0049987654321",
-			region: "CH",
-			exp:    "00 49 9876 54321",
-		}, {
-			in:     "+49987654321",
-			region: "DE",
-			exp:    "+49 9876 54321",
-		}, {
-			in:     "49987654321",
-			region: "DE",
-			exp:    "4998 7654321",
-		}, {
-			in:     "6463752545",
-			region: "US",
-			exp:    "(646) 375-2545",
-		}, {
-			in:     "3752545",
-			region: "US",
-			exp:    "375-2545",
+		{"+442079460000", "en-GB", SpeechOptions{Style: SPEECH_TEXT}, "oh two oh, seven nine four six, double oh double oh"},
+		{"+442079460000", "en-GB", SpeechOptions{}, `oh two oh<break time="300ms"/>seven nine four six<break time="300ms"/>double oh double oh`},
+		{"+442079460000", "en", SpeechOptions{Style: SPEECH_TEXT}, "plus four four, two zero, seven nine four six, zero zero zero zero"},
+		{"+442079411100", "en_gb", SpeechOptions{Style: SPEECH_TEXT}, "oh two oh, seven nine four one, double one double oh"},
+		{"+16502530000 ext. 1234", "en-US", SpeechOptions{Style: SPEECH_TEXT}, "six five zero, two five three, zero zero zero zero, extension, one two three four"},
+		{"+16502530000", "en-GB", SpeechOptions{Style: SPEECH_TEXT}, "plus one, six five oh, two five three, double oh double oh"},
+		{"+5511987654321", "pt-BR", SpeechOptions{Style: SPEECH_TEXT}, "um um, nove oito sete meia cinco, quatro três dois um"},
+		{"+5511987654321", "pt", SpeechOptions{Style: SPEECH_TEXT}, "mais cinco cinco, um um, nove oito sete seis cinco, quatro três dois um"},
+		{"+33123456789", "fr", SpeechOptions{Style: SPEECH_TEXT}, "plus trois trois, un, deux trois, quatre cinq, six sept, huit neuf"},
+		{"+4930123456 ext. 7", "de-DE", SpeechOptions{Style: SPEECH_TEXT}, "null drei null, eins zwo drei vier fünf sechs, Durchwahl, sieben"},
+		{"+34912345678", "es-ES", SpeechOptions{Style: SPEECH_TEXT}, "nueve uno dos, tres cuatro, cinco seis, siete ocho"},
+		{"+34912345678", "xx", SpeechOptions{Style: SPEECH_TEXT}, "plus three four, nine one two, three four, five six, seven eight"},
+		{"+442079460000", "en-GB", SpeechOptions{Style: SPEECH_SSML_SAY_AS}, `<say-as interpret-as="telephone" format="44">020 7946 0000</say-as>`},
+		{"+442079460000 ext. 12", "en", SpeechOptions{Style: SPEECH_SSML_SAY_AS, Pause: "1s"},
+			`<say-as interpret-as="telephone" format="44">+44 20 7946 0000</say-as><break time="1s"/>extension<break time="1s"/><say-as interpret-as="digits">12</say-as>`},
+	}
+
+	for i, test := range tests {
+		num, err := Parse(test.number, "")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.number, err)
+			continue
+		}
+		spoken := FormatForSpeechWithOptions(num, test.lang, test.opts)
+		if spoken != test.expected {
+			t.Errorf("[test %d:spoken] %s != %s", i, spoken, test.expected)
+		}
+		if test.opts == (SpeechOptions{}) && FormatForSpeech(num, test.lang) != spoken {
+			t.Errorf("[test %d:default] %s != %s", i, FormatForSpeech(num, test.lang), spoken)
+		}
+
+		// what we say should be understood when spoken back to us
+		if test.opts.Style == SPEECH_TEXT && speechLanguages[baseLanguage(test.lang)] != nil {
+			reparsed, err := Parse(NormalizeSpokenNumber(spoken, test.lang), GetRegionCodeForNumber(num))
+			if err != nil || !proto.Equal(reparsed, num) {
+				t.Errorf("[test %d:reparsed] %v != %v (%v)", i, reparsed, num, err)
+			}
+		}
+	}
+}
+
+func TestFormatForSpeechExtension(t *testing.T) {
+	var tests = []struct {
+		extension string
+		opts      SpeechOptions
+		expected  string
+	}{
+		{"12a", SpeechOptions{Style: SPEECH_TEXT}, "plus four four, two zero, seven nine four six, zero zero zero zero, extension, one two a"},
+		{"12a", SpeechOptions{}, `plus four four<break time="300ms"/>two zero<break time="300ms"/>seven nine four six<break time="300ms"/>zero zero zero zero<break time="300ms"/>extension<break time="300ms"/>one two a`},
+		{"1<2", SpeechOptions{Pause: `1s"/><audio src="x`}, `plus four four<break time="1s&#34;/&gt;&lt;audio src=&#34;x"/>two zero<break time="1s&#34;/&gt;&lt;audio src=&#34;x"/>seven nine four six<break time="1s&#34;/&gt;&lt;audio src=&#34;x"/>zero zero zero zero<break time="1s&#34;/&gt;&lt;audio src=&#34;x"/>extension<break time="1s&#34;/&gt;&lt;audio src=&#34;x"/>one &lt; two`},
+		{"1&2", SpeechOptions{Style: SPEECH_SSML_SAY_AS}, `<say-as interpret-as="telephone" format="44">+44 20 7946 0000</say-as><break time="300ms"/>extension<break time="300ms"/><say-as interpret-as="digits">1&amp;2</say-as>`},
+	}
+
+	for i, test := range tests {
+		num, err := Parse("+442079460000", "")
+		if err != nil {
+			t.Fatalf("failed to parse: %s", err)
+		}
+		num.Extension = proto.String(test.extension)
+
+		spoken := FormatForSpeechWithOptions(num, "en", test.opts)
+		if spoken != test.expected {
+			t.Errorf("[test %d:spoken] %s != %s", i, spoken, test.expected)
+		}
+	}
+
+	// extensions with letters can be parsed from canonical strings
+	num, err := ParseCanonical("+44-2079460000;ext=12a")
+	if err != nil {
+		t.Fatalf("failed to parse canonical: %s", err)
+	}
+	if spoken := FormatForSpeechWithOptions(num, "en", SpeechOptions{Style: SPEECH_TEXT}); spoken != tests[0].expected {
+		t.Errorf("[canonical] %s != %s", spoken, tests[0].expected)
+	}
+}
+
+func TestFormatCanonical(t *testing.T) {
+	mustParse := func(number, region string) *PhoneNumber {
+		num, err := ParseAndKeepRawInput(number, region)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", number, err)
+		}
+		return num
+	}
+
+	var tests = []struct {
+		number   *PhoneNumber
+		expected string
+	}{
+		{getTestNumber("GB_NUMBER"), "+44-2070313000"},
+		{getTestNumber("IT_NUMBER"), "+39-0236618300"},
+		{getTestNumber("UNKNOWN_COUNTRY_CODE_NO_RAW_INPUT"), "+2-12345"},
+		{&PhoneNumber{CountryCode: proto.Int32(39), NationalNumber: proto.Uint64(1234), ItalianLeadingZero: proto.Bool(true), NumberOfLeadingZeros: proto.Int32(3)}, "+39-0001234"},
+		{&PhoneNumber{CountryCode: proto.Int32(39), NationalNumber: proto.Uint64(1234), ItalianLeadingZero: proto.Bool(true), NumberOfLeadingZeros: proto.Int32(1)}, "+39-01234;nlz=1"},
+		{&PhoneNumber{CountryCode: proto.Int32(39), NationalNumber: proto.Uint64(1234), ItalianLeadingZero: proto.Bool(false), NumberOfLeadingZeros: proto.Int32(2)}, "+39-1234;ilz=0;nlz=2"},
+		{&PhoneNumber{CountryCode: proto.Int32(39), NationalNumber: proto.Uint64(0), ItalianLeadingZero: proto.Bool(true)}, "+39-00"},
+		{&PhoneNumber{CountryCode: proto.Int32(1), NationalNumber: proto.Uint64(6502530000), Extension: proto.String("")}, "+1-6502530000;ext="},
+		{mustParse("+1 650-253-0000 ext. 1234", ""), "+1-6502530000;ext=1234;pdcc=;src=1;raw=+1%20650-253-0000%20ext.%201234"},
+		{mustParse("0xx15 11 98765-4321", "BR"), "+55-11987654321;pdcc=;src=20;raw=0xx15%2011%2098765-4321"},
+		{mustParse("011 44 20 7946 0000", "US"), "+44-2079460000;pdcc=;src=5;raw=011%2044%2020%207946%200000"},
+		{&PhoneNumber{CountryCode: proto.Int32(57), NationalNumber: proto.Uint64(16012345), PreferredDomesticCarrierCode: proto.String("3")}, "+57-16012345;pdcc=3"},
+		{&PhoneNumber{CountryCode: proto.Int32(44), NationalNumber: proto.Uint64(2079460000), RawInput: proto.String("a;b=c%")}, "+44-2079460000;raw=a%3Bb=c%25"},
+	}
+
+	for i, test := range tests {
+		canonical := FormatCanonical(test.number)
+		if canonical != test.expected {
+			t.Errorf("[test %d:canonical] %s != %s", i, canonical, test.expected)
+		}
+
+		parsed, err := ParseCanonical(canonical)
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, canonical, err)
+		} else if !proto.Equal(parsed, test.number) {
+			t.Errorf("[test %d:parsed] %v != %v", i, parsed, test.number)
+		}
+	}
+}
+
+func TestParseCanonicalErrors(t *testing.T) {
+	var tests = []struct {
+		canonical string
+		offset    int
+	}{
+		{"", 0},
+		{"44-2079460000", 0},
+		{"+44 2079460000", 0},
+		{"+044-2079460000", 1},
+		{"+44-", 4},
+		{"+44-207946000x", 4},
+		{"+44-2079460000;ext", 15},
+		{"+44-2079460000;foo=1", 15},
+		{"+44-2079460000;src=1;ext=1", 15},
+		{"+44-2079460000;ext=1;ext=2", 19},
+		{"+44-2079460000;src=2", 19},
+		{"+44-2079460000;ilz=yes", 19},
+		{"+44-2079460000;raw=%zz", 19},
+		{"+44-2079460000;raw=%2b", 19},
+		{"+39-00;ilz=0", 5},
+	}
+
+	for i, test := range tests {
+		_, err := ParseCanonical(test.canonical)
+		parseErr, isParseErr := err.(*ParseError)
+		if !isParseErr {
+			t.Errorf("[test %d] expected ParseError for %s, got %v", i, test.canonical, err)
+			continue
+		}
+		if !errors.Is(err, ErrInvalidCanonical) {
+			t.Errorf("[test %d:err] %v != %v", i, err, ErrInvalidCanonical)
+		}
+		if parseErr.Offset != test.offset {
+			t.Errorf("[test %d:offset] %d != %d", i, parseErr.Offset, test.offset)
+		}
+	}
+}
+
+func TestMarshalling(t *testing.T) {
+	num, _ := Parse("+1 650 253 0000 ext. 1234", "")
+	gb, _ := Parse("+44 20 7946 0000", "")
+
+	// the proto text format is unaffected
+	unmarshalled := &PhoneNumber{}
+	if err := proto.UnmarshalText(proto.MarshalTextString(num), unmarshalled); err != nil || !proto.Equal(unmarshalled, num) {
+		t.Errorf("[prototext] %v != %v (%v)", unmarshalled, num, err)
+	}
+
+	// text
+	text, err := TextPhoneNumber{num}.MarshalText()
+	if err != nil || string(text) != "+16502530000;ext=1234" {
+		t.Errorf("[text] %s != +16502530000;ext=1234 (%v)", text, err)
+	}
+	if text, err := (TextPhoneNumber{}).MarshalText(); err != nil || string(text) != "" {
+		t.Errorf("[text:nil] %s != (%v)", text, err)
+	}
+	textNumber := &TextPhoneNumber{}
+	if err := textNumber.UnmarshalText(text); err != nil || !proto.Equal(textNumber.Number, num) {
+		t.Errorf("[unmarshalText] %v != %v (%v)", textNumber.Number, num, err)
+	}
+	if err := textNumber.UnmarshalText([]byte("not a number")); !errors.Is(err, ErrNotANumber) || !proto.Equal(textNumber.Number, num) {
+		t.Errorf("[unmarshalText:invalid] %v != %v (%v)", textNumber.Number, num, err)
+	}
+	if err := textNumber.UnmarshalText([]byte{}); err != nil || textNumber.Number != nil {
+		t.Errorf("[unmarshalText:empty] %v != nil (%v)", textNumber.Number, err)
+	}
+	keyed := map[TextPhoneNumber]string{}
+	if err := json.Unmarshal([]byte(`{"+442079460000":"office"}`), &keyed); err != nil || len(keyed) != 1 {
+		t.Errorf("[text:key] %v (%v)", keyed, err)
+	}
+	for key := range keyed {
+		if !proto.Equal(key.Number, gb) {
+			t.Errorf("[text:key] %v != %v", key.Number, gb)
+		}
+	}
+
+	// JSON
+	type contact struct {
+		Phone *PhoneNumber    `json:"phone"`
+		Fax   *PhoneNumber    `json:"fax"`
+		Rich  RichPhoneNumber `json:"rich"`
+	}
+	encoded, err := json.Marshal(&contact{Phone: num, Rich: RichPhoneNumber{gb}})
+	expected := `{"phone":"+16502530000;ext=1234","fax":null,"rich":{"e164":"+442079460000","region":"GB","type":"FIXED_LINE","national":"020 7946 0000","international":"+44 20 7946 0000","valid":true}}`
+	if err != nil || string(encoded) != expected {
+		t.Errorf("[json] %s != %s (%v)", encoded, expected, err)
+	}
+	encoded, _ = json.Marshal(RichPhoneNumber{num})
+	expected = `{"e164":"+16502530000","extension":"1234","region":"US","type":"FIXED_LINE_OR_MOBILE","national":"(650) 253-0000 ext. 1234","international":"+1 650-253-0000 ext. 1234","valid":true}`
+	if string(encoded) != expected {
+		t.Errorf("[json:rich] %s != %s", encoded, expected)
+	}
+
+	decoded := &contact{}
+	err = json.Unmarshal([]byte(`{"phone":{"e164":"+16502530000","extension":"1234"},"fax":null,"rich":"+442079460000"}`), decoded)
+	if err != nil || !proto.Equal(decoded.Phone, num) || decoded.Fax != nil || !proto.Equal(decoded.Rich.Number, gb) {
+		t.Errorf("[json:decoded] %v %v %v (%v)", decoded.Phone, decoded.Fax, decoded.Rich.Number, err)
+	}
+	if err := json.Unmarshal([]byte(`{"phone":"020 7946 0000"}`), decoded); !errors.Is(err, ErrInvalidCountryCode) {
+		t.Errorf("[json:national] %v != %v", err, ErrInvalidCountryCode)
+	}
+	if err := json.Unmarshal([]byte(`{"phone":12}`), decoded); err == nil {
+		t.Errorf("[json:number] expected error")
+	}
+	if err := json.Unmarshal([]byte(`{"phone":"not a number"}`), decoded); !errors.Is(err, ErrNotANumber) || !proto.Equal(decoded.Phone, num) {
+		t.Errorf("[json:invalid] %v != %v (%v)", decoded.Phone, num, err)
+	}
+
+	// unmarshal options
+	SetUnmarshalOptions(ParseOptions{DefaultRegion: "GB", RequireValid: true})
+	defer SetUnmarshalOptions(ParseOptions{})
+
+	if err := json.Unmarshal([]byte(`{"phone":"020 7946 0000","rich":"020 7946 0000"}`), decoded); err != nil || !proto.Equal(decoded.Phone, gb) || !proto.Equal(decoded.Rich.Number, gb) {
+		t.Errorf("[json:region] %v %v != %v (%v)", decoded.Phone, decoded.Rich.Number, gb, err)
+	}
+	if err := json.Unmarshal([]byte(`{"phone":"020 1234"}`), decoded); !errors.Is(err, ErrInvalidNumber) {
+		t.Errorf("[json:valid] %v != %v", err, ErrInvalidNumber)
+	}
+	if err := textNumber.UnmarshalText([]byte("020 7946 0000")); err != nil || !proto.Equal(textNumber.Number, gb) {
+		t.Errorf("[unmarshalText:region] %v != %v (%v)", textNumber.Number, gb, err)
+	}
+	regionScanned := &PhoneNumber{}
+	if err := regionScanned.Scan("020 7946 0000"); err != nil || !proto.Equal(regionScanned, gb) {
+		t.Errorf("[scan:region] %v != %v (%v)", regionScanned, gb, err)
+	}
+
+	// decoders with their own options
+	decoder := NumberDecoder{Options: ParseOptions{DefaultRegion: "GB", RequireValid: true}}
+	var decodedNumber *PhoneNumber
+	if err := decoder.DecodeJSON([]byte(`"020 7946 0000"`), &decodedNumber); err != nil || !proto.Equal(decodedNumber, gb) {
+		t.Errorf("[decoder:region] %v != %v (%v)", decodedNumber, gb, err)
+	}
+	if err := decoder.DecodeJSON([]byte(`"020 1234"`), &decodedNumber); !errors.Is(err, ErrInvalidNumber) || !proto.Equal(decodedNumber, gb) {
+		t.Errorf("[decoder:valid] %v != %v (%v)", decodedNumber, ErrInvalidNumber, err)
+	}
+	if err := decoder.DecodeJSON([]byte(`null`), &decodedNumber); err != nil || decodedNumber != nil {
+		t.Errorf("[decoder:null] %v != nil (%v)", decodedNumber, err)
+	}
+	if err := decoder.DecodeValue([]byte("020 7946 0000"), &decodedNumber); err != nil || !proto.Equal(decodedNumber, gb) {
+		t.Errorf("[decoder:scan] %v != %v (%v)", decodedNumber, gb, err)
+	}
+	if err := decoder.DecodeValue(nil, &decodedNumber); err != nil || decodedNumber != nil {
+		t.Errorf("[decoder:scanNull] %v != nil (%v)", decodedNumber, err)
+	}
+	if err := (NumberDecoder{}).DecodeValue("020 7946 0000", &decodedNumber); !errors.Is(err, ErrInvalidCountryCode) {
+		t.Errorf("[decoder:default] %v != %v", err, ErrInvalidCountryCode)
+	}
+
+	// SQL
+	value, err := num.Value()
+	if err != nil || value != "+16502530000;ext=1234" {
+		t.Errorf("[value] %v != +16502530000;ext=1234 (%v)", value, err)
+	}
+	var nilNumber *PhoneNumber
+	if value, err := nilNumber.Value(); value != nil || err != nil {
+		t.Errorf("[value:nil] %v != nil (%v)", value, err)
+	}
+
+	scanned := &PhoneNumber{}
+	if err := scanned.Scan([]byte("+442079460000")); err != nil || !proto.Equal(scanned, gb) {
+		t.Errorf("[scan:bytes] %v != %v (%v)", scanned, gb, err)
+	}
+	if err := scanned.Scan("+16502530000;ext=1234"); err != nil || !proto.Equal(scanned, num) {
+		t.Errorf("[scan:string] %v != %v (%v)", scanned, num, err)
+	}
+	if err := scanned.Scan(nil); err != ErrNullNumber {
+		t.Errorf("[scan:nil] %v != %v", err, ErrNullNumber)
+	}
+	if err := scanned.Scan(12); err == nil {
+		t.Errorf("[scan:int] expected error")
+	}
+}
+
+func TestNumber(t *testing.T) {
+	var tests = []struct {
+		input         string
+		region        string
+		number        Number
+		international string
+		national      string
+		rfc3966       string
+	}{
+		{"+16502530000", "", Number{1, 6502530000, 0, ""}, "+1 650-253-0000", "(650) 253-0000", "tel:+1-650-253-0000"},
+		{"(650) 253-0000", "US", Number{1, 6502530000, 0, ""}, "+1 650-253-0000", "(650) 253-0000", "tel:+1-650-253-0000"},
+		{"020 7946 0000", "GB", Number{44, 2079460000, 0, ""}, "+44 20 7946 0000", "020 7946 0000", "tel:+44-20-7946-0000"},
+		{"+39 02 3661 8300", "", Number{39, 236618300, 1, ""}, "+39 02 3661 8300", "02 3661 8300", "tel:+39-02-3661-8300"},
+		{"+80012345678", "", Number{800, 12345678, 0, ""}, "+800 1234 5678", "1234 5678", "tel:+800-1234-5678"},
+		{"011 44 20 7946 0000", "US", Number{44, 2079460000, 0, ""}, "+44 20 7946 0000", "020 7946 0000", "tel:+44-20-7946-0000"},
+		{"+44 20 7946 0000 ext. 123", "", Number{44, 2079460000, 0, "123"}, "+44 20 7946 0000 ext. 123", "020 7946 0000 ext. 123", "tel:+44-20-7946-0000;ext=123"},
+		{"1-800-FLOWERS", "US", Number{1, 8003569377, 0, ""}, "+1 800-356-9377", "(800) 356-9377", "tel:+1-800-356-9377"},
+	}
+
+	buf := make([]byte, 0, 64)
+	for i, test := range tests {
+		var number Number
+		if err := ParseInto(&number, test.input, test.region); err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.input, err)
+			continue
+		}
+		if number != test.number {
+			t.Errorf("[test %d:number] %v != %v", i, number, test.number)
+		}
+
+		// should be the same as parsing and converting
+		parsed, _ := Parse(test.input, test.region)
+		if converted := NumberFromPhoneNumber(parsed); converted != number {
+			t.Errorf("[test %d:converted] %v != %v", i, converted, number)
+		}
+		if !proto.Equal(number.PhoneNumber(), parsed) {
+			t.Errorf("[test %d:phoneNumber] %v != %v", i, number.PhoneNumber(), parsed)
+		}
+
+		if formatted := string(AppendFormat(buf[:0], number, E164)); formatted != Format(parsed, E164) {
+			t.Errorf("[test %d:e164] %s != %s", i, formatted, Format(parsed, E164))
+		}
+		if formatted := string(AppendFormat(buf[:0], number, INTERNATIONAL)); formatted != test.international {
+			t.Errorf("[test %d:international] %s != %s", i, formatted, test.international)
+		}
+		if formatted := string(AppendFormat(buf[:0], number, NATIONAL)); formatted != test.national {
+			t.Errorf("[test %d:national] %s != %s", i, formatted, test.national)
+		}
+		if formatted := string(AppendFormat(buf[:0], number, RFC3966)); formatted != test.rfc3966 {
+			t.Errorf("[test %d:rfc3966] %s != %s", i, formatted, test.rfc3966)
+		}
+	}
+
+	// errors are the same as Parse, leaving the number untouched
+	number := Number{CountryCode: 1, NationalNumber: 6502530000}
+	if err := ParseInto(&number, "hello", "US"); !errors.Is(err, ErrNotANumber) {
+		t.Errorf("[error] expected ErrNotANumber, got %v", err)
+	}
+	if number.NationalNumber != 6502530000 {
+		t.Errorf("[error] number was changed to %v", number)
+	}
+}
+
+func TestNumberMatchesPhoneNumber(t *testing.T) {
+	types := []PhoneNumberType{FIXED_LINE, MOBILE, TOLL_FREE, PREMIUM_RATE, SHARED_COST, VOIP, PERSONAL_NUMBER, PAGER, UAN, VOICEMAIL}
+	formats := []PhoneNumberFormat{E164, INTERNATIONAL, NATIONAL, RFC3966}
+
+	for region := range GetSupportedRegions() {
+		for _, numberType := range types {
+			example := GetExampleNumberForType(region, numberType)
+			if example == nil {
+				continue
+			}
+			for _, numberFormat := range formats {
+				input := Format(example, numberFormat)
+				for _, defaultRegion := range []string{region, ""} {
+					parsed, err := Parse(input, defaultRegion)
+
+					var number Number
+					if err2 := ParseInto(&number, input, defaultRegion); (err == nil) != (err2 == nil) {
+						t.Errorf("[%s:%s] errors differ: %v != %v", defaultRegion, input, err2, err)
+					} else if err == nil && !proto.Equal(number.PhoneNumber(), parsed) {
+						t.Errorf("[%s:%s] %v != %v", defaultRegion, input, number.PhoneNumber(), parsed)
+					}
+				}
+
+				withExtension := &PhoneNumber{}
+				proto.Merge(withExtension, example)
+				withExtension.Extension = proto.String("1234")
+				for _, number := range []*PhoneNumber{example, withExtension} {
+					if formatted := string(AppendFormat(nil, NumberFromPhoneNumber(number), numberFormat)); formatted != Format(number, numberFormat) {
+						t.Errorf("[%s:%d] %s != %s", region, numberFormat, formatted, Format(number, numberFormat))
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestNumberAllocations(t *testing.T) {
+	var tests = []struct {
+		input  string
+		region string
+	}{
+		{"+16502530000", ""},
+		{"(650) 253-0000", "US"},
+		{"020 7946 0000", "GB"},
+		{"+39 02 3661 8300", ""},
+		{"07912 345678", "GB"},
+	}
+
+	buf := make([]byte, 0, 64)
+	for i, test := range tests {
+		var number Number
+		if allocs := testing.AllocsPerRun(100, func() { ParseInto(&number, test.input, test.region) }); allocs != 0 {
+			t.Errorf("[test %d:parse] %v allocations", i, allocs)
+		}
+		for _, numberFormat := range []PhoneNumberFormat{E164, INTERNATIONAL, NATIONAL, RFC3966} {
+			if allocs := testing.AllocsPerRun(100, func() { buf = AppendFormat(buf[:0], number, numberFormat) }); allocs != 0 {
+				t.Errorf("[test %d:format %d] %v allocations", i, numberFormat, allocs)
+			}
+		}
+	}
+}
+
+func TestClassifier(t *testing.T) {
+	collection, err := MetadataCollection()
+	if err != nil {
+		t.Fatalf("error reading metadata: %s", err)
+	}
+
+	for _, metadata := range collection.GetMetadata() {
+		// if this fails, the classifiers need rebuilding with `buildmetadata classifier`
+		if getClassifier(metadata) == nil {
+			t.Errorf("[%s] no classifier which matches metadata", metadata.GetId())
+			continue
+		}
+
+		// check example numbers along with their prefixes and longer versions of them
+		numbers := []string{""}
+		for _, desc := range classifierDescs(metadata) {
+			example := desc.GetExampleNumber()
+			for i := 1; i <= len(example); i++ {
+				numbers = append(numbers, example[:i])
+			}
+			if example != "" {
+				numbers = append(numbers, example+"0", example+"99")
+			}
+		}
+
+		for _, number := range numbers {
+			classified := newDescMatcher(number, metadata)
+			withRegexes := descMatcher{nationalNumber: number}
+
+			if numberType, expected := getNumberTypeWithMatcher(classified, metadata), getNumberTypeWithMatcher(withRegexes, metadata); numberType != expected {
+				t.Errorf("[%s:%s] %d != %d", metadata.GetId(), number, numberType, expected)
+			}
+			if matches, expected := classified.matchesLeadingDigits(metadata), withRegexes.matchesLeadingDigits(metadata); matches != expected {
+				t.Errorf("[%s:%s:leadingDigits] %t != %t", metadata.GetId(), number, matches, expected)
+			}
+		}
+	}
+
+	// numbers we can't classify fall back to regexes
+	metadata := getMetadataForRegion("US")
+	if matcher := newDescMatcher("650a2530000", metadata); matcher.classified {
+		t.Errorf("expected number with letters not to be classified")
+	}
+}
+
+func TestFormatNationalNumberWithCarrierCode(t *testing.T) {
+	var tests = []struct {
+		number      string
+		carrierCode string
+		expected    string
+	}{
+		{"+5511987654321", "15", "0 15 (11) 98765-4321"},
+		{"+5511987654321", "", "(11) 98765-4321"},
+		{"+5716012345", "3", "03 1 6012345"},
+		{"+16502530000", "15", "(650) 253-0000"},
+	}
+
+	for i, test := range tests {
+		num, err := Parse(test.number, "")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.number, err)
+			continue
+		}
+		if formatted := FormatNationalNumberWithCarrierCode(num, test.carrierCode); formatted != test.expected {
+			t.Errorf("[test %d:formatted] %s != %s", i, formatted, test.expected)
+		}
+
+		// the format rule used to be returned unexpanded, e.g. 0 15 ($1) $2-$3
+		if preferred := FormatNationalNumberWithPreferredCarrierCode(num, test.carrierCode); strings.Contains(preferred, "$") {
+			t.Errorf("[test %d:preferred] unexpanded format rule %s", i, preferred)
+		}
+	}
+}
+
+func TestDialer(t *testing.T) {
+	london, _ := Parse("+442079460000", "")
+
+	var tests = []struct {
+		dialer   *Dialer
+		number   string
+		expected string
+		postDial string
+		mode     DialMode
+		err      error
+	}{
+		{NewDialer("US"), "+16502530000 ext. 12", "16502530000", ",12", DIAL_DOMESTIC, nil},
+		{NewDialer("CA"), "+16502530000", "16502530000", "", DIAL_DOMESTIC, nil},
+		{NewDialer("US"), "+442079460000", "+442079460000", "", DIAL_INTERNATIONAL, nil},
+		{&Dialer{Region: "US", UseIDD: true}, "+442079460000", "011442079460000", "", DIAL_IDD, nil},
+		{&Dialer{Region: "GB", UseIDD: true}, "+16502530000", "0016502530000", "", DIAL_IDD, nil},
+		{&Dialer{Region: "RU", UseIDD: true}, "+442079460000", "810442079460000", "", DIAL_IDD, nil},
+		{&Dialer{Region: "BR", UseIDD: true, CarrierCode: "21"}, "+16502530000", "002116502530000", "", DIAL_IDD, nil},
+		{&Dialer{Region: "BR", UseIDD: true}, "+16502530000", "+16502530000", "", DIAL_INTERNATIONAL, nil},
+		{&Dialer{Region: "BR", CarrierCode: "15"}, "+5511987654321", "01511987654321", "", DIAL_DOMESTIC, nil},
+		{&Dialer{Region: "BR"}, "+5511987654321", "", "", DIAL_DOMESTIC, ErrCarrierCodeRequired},
+		{&Dialer{Region: "CO"}, "+5716012345", "0316012345", "", DIAL_DOMESTIC, nil},
+		{&Dialer{Region: "HU"}, "+3612345678", "0612345678", "", DIAL_DOMESTIC, nil},
+		{&Dialer{Region: "MX"}, "+525512345678", "+525512345678", "", DIAL_INTERNATIONAL, nil},
+		{&Dialer{Region: "KZ"}, "+74951234567", "84951234567", "", DIAL_DOMESTIC, nil},
+		{&Dialer{Region: "GB", Pause: "ww"}, "+442079460001 ext. 5", "02079460001", "ww5", DIAL_DOMESTIC, nil},
+		{&Dialer{Number: london, OmitAreaCode: true}, "+442079460001", "79460001", "", DIAL_LOCAL, nil},
+		{&Dialer{Number: london, OmitAreaCode: true}, "+441612345678", "01612345678", "", DIAL_DOMESTIC, nil},
+		{&Dialer{Number: london}, "+442079460001", "02079460001", "", DIAL_DOMESTIC, nil},
+		{&Dialer{Region: "DE"}, "+18002530000", "+18002530000", "", DIAL_INTERNATIONAL, nil},
+		{&Dialer{}, "+74951234567", "", "", DIAL_LOCAL, ErrUnknownCallerRegion},
+	}
+
+	for i, test := range tests {
+		num, err := Parse(test.number, "")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.number, err)
+			continue
+		}
+		dial, err := test.dialer.Dial(num)
+		if err != test.err {
+			t.Errorf("[test %d:err] %v != %v", i, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if dial.Number != test.expected {
+			t.Errorf("[test %d:number] %s != %s", i, dial.Number, test.expected)
+		}
+		if dial.PostDial != test.postDial {
+			t.Errorf("[test %d:postDial] %s != %s", i, dial.PostDial, test.postDial)
+		}
+		if dial.Mode != test.mode {
+			t.Errorf("[test %d:mode] %d != %d", i, dial.Mode, test.mode)
+		}
+		if dial.String() != test.expected+test.postDial {
+			t.Errorf("[test %d:string] %s != %s", i, dial.String(), test.expected+test.postDial)
+		}
+	}
+}
+
+func TestFormatByPattern(t *testing.T) {
+	var tcs = []struct {
+		in          string
+		region      string
+		format      PhoneNumberFormat
+		userFormats []*NumberFormat
+		exp         string
+	}{
+		{
+			in:     "+33122334455",
+			region: "FR",
+			format: E164,
+			userFormats: []*NumberFormat{
+				&NumberFormat{
+					Pattern: s(`(\d+)`),
+					Format:  s(`$1`),
+				},
+			},
+			exp: "+33122334455",
+		}, {
+			in:     "+442070313000",
+			region: "UK",
+			format: NATIONAL,
+			userFormats: []*NumberFormat{
+				&NumberFormat{
+					Pattern: s(`(20)(\d{4})(\d{4})`),
+					Format:  s(`$1 $2 $3`),
+				},
+			},
+			exp: "20 7031 3000",
+		},
+	}
+
+	for i, tc := range tcs {
+		num, err := Parse(tc.in, tc.region)
+		if err != nil {
+			t.Errorf("[test %d] failed: should be able to parse, err:%v\n", i, err)
+		}
+		got := FormatByPattern(num, tc.format, tc.userFormats)
+		if got != tc.exp {
+			t.Errorf("[test %d:fmt] failed %s != %s\n", i, got, tc.exp)
+		}
+	}
+}
+
+func TestFormatTemplate(t *testing.T) {
+	var tests = []struct {
+		template string
+		number   string
+		expected string
+	}{
+		{"+{cc} {ndc}-{sn}", "+16502530000", "+1 650-2530000"},
+		{"({ndc}) {sn:3-4}", "+16502530000", "(650) 253-0000"},
+		{"{np}{ndc} {sn:4 4}[ ext. {ext}]", "+442079460000", "020 7946 0000"},
+		{"{np}{ndc} {sn:4 4}[ ext. {ext}]", "+442079460000 ext. 5", "020 7946 0000 ext. 5"},
+		{"+{cc} [{mt} ]{ndc} {sn:4-4}", "+5491187654321", "+54 9 11 8765-4321"},
+		{"+{cc} [{mt} ]{ndc} {sn:4-4}", "+541187654321", "+54 11 8765-4321"},
+		{"+{cc}.{groups:.}", "+33123456789", "+33.1.23.45.67.89"},
+		{"+{cc} {groups}", "+33123456789", "+33 1 23 45 67 89"},
+		{"+{cc} {nsn:1 *}", "+33123456789", "+33 1 23456789"},
+		{"\\{{cc}\\} \\[{nsn}\\]", "+33123456789", "{33} [123456789]"},
+		{"{np}{ndc} {sn}", "+447912345678", "07912 345678"},
+
+		// templates which can't be applied fall back to international format
+		{"({ndc}) {sn:3-4}", "+442079460000", "+44 20 7946 0000"},
+		{"{np}{ndc} {sn}", "+80012345678", "+800 1234 5678"},
+		{"{np}{nsn}", "+16502530000", "+1 650-253-0000"},
+	}
+
+	for i, test := range tests {
+		template, err := CompileFormatTemplate(test.template)
+		if err != nil {
+			t.Errorf("[test %d] failed to compile %s: %s", i, test.template, err)
+			continue
+		}
+		num, err := Parse(test.number, "")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.number, err)
+			continue
+		}
+		if formatted := template.Format(num); formatted != test.expected {
+			t.Errorf("[test %d:formatted] %s != %s", i, formatted, test.expected)
+		}
+		if template.String() != test.template {
+			t.Errorf("[test %d:string] %s != %s", i, template.String(), test.template)
+		}
+	}
+}
+
+func TestCompileFormatTemplateErrors(t *testing.T) {
+	var tests = []string{
+		"{x}",
+		"{cc",
+		"{cc}}",
+		"{cc:3}",
+		"{sn:}",
+		"{sn:3-}",
+		"{sn:*-3}",
+		"{sn:0}",
+		"[{ext}",
+		"{ext}]",
+		"[a[{ext}]]",
+		"{cc}\\",
+	}
+
+	for i, test := range tests {
+		_, err := CompileFormatTemplate(test)
+		if !errors.Is(err, ErrInvalidFormatTemplate) {
+			t.Errorf("[test %d] expected error compiling %s, got %v", i, test, err)
+		}
+	}
+}
+
+func TestFormatWithOptions(t *testing.T) {
+	var tests = []struct {
+		number   string
+		format   PhoneNumberFormat
+		opts     FormatOptions
+		expected string
+	}{
+		{"+49 30 1234567 ext. 12", NATIONAL, FormatOptions{Language: "de"}, "030 1234567 Durchwahl 12"},
+		{"+49 30 1234567 ext. 12", INTERNATIONAL, FormatOptions{Language: "de-AT"}, "+49 30 1234567 Durchwahl 12"},
+		{"+33 1 23 45 67 89 ext. 12", NATIONAL, FormatOptions{Language: "fr"}, "01 23 45 67 89 poste 12"},
+		{"+55 11 2345 6789 ext. 3", NATIONAL, FormatOptions{Language: "pt_BR"}, "(11) 2345-6789 ramal 3"},
+		{"+7 495 123 4567 ext. 34", INTERNATIONAL, FormatOptions{Language: "ru"}, "+7 495 123-45-67 доб. 34"},
+		{"+81 3 1234 5678 ext. 5", NATIONAL, FormatOptions{Language: "ja"}, "03-1234-5678 内線 5"},
+		{"+1 650 253 0000 ext. 12", NATIONAL, FormatOptions{Language: "en"}, "(650) 253-0000 ext. 12"},
+		{"+1 650 253 0000 ext. 12", NATIONAL, FormatOptions{ExtensionLabel: " x", Language: "de"}, "(650) 253-0000 x12"},
+		{"+1 650 253 0000 ext. 12", NATIONAL, FormatOptions{Language: "xx"}, "(650) 253-0000 ext. 12"},
+		{"+1 650 253 0000 ext. 12", RFC3966, FormatOptions{Language: "de"}, "tel:+1-650-253-0000;ext=12"},
+		{"+1 650 253 0000 ext. 12", E164, FormatOptions{Language: "de"}, "+16502530000"},
+		{"+1 650 253 0000", NATIONAL, FormatOptions{Language: "de"}, "(650) 253-0000"},
+		{"+971 50 123 4567", INTERNATIONAL, FormatOptions{NumberingSystem: NUMBERING_ARAB}, "+٩٧١ ٥٠ ١٢٣ ٤٥٦٧"},
+		{"+98 21 1234 5678", INTERNATIONAL, FormatOptions{NumberingSystem: NUMBERING_ARABEXT, Bidi: BIDI_ISOLATE}, "\u2066+۹۸ ۲۱ ۱۲۳۴ ۵۶۷۸\u2069"},
+		{"+98 21 1234 5678", E164, FormatOptions{NumberingSystem: NUMBERING_ARABEXT, Bidi: BIDI_MARKS}, "\u200E+۹۸۲۱۱۲۳۴۵۶۷۸\u200E"},
+		{"+91 11 2345 6789 ext. 12", NATIONAL, FormatOptions{NumberingSystem: NUMBERING_DEVA}, "०११ २३४५ ६७८९ ext. १२"},
+		{"+91 11 2345 6789", RFC3966, FormatOptions{NumberingSystem: NUMBERING_DEVA, Bidi: BIDI_ISOLATE}, "tel:+91-11-2345-6789"},
+		{"+91 11 2345 6789", NATIONAL, FormatOptions{NumberingSystem: "xxx"}, "011 2345 6789"},
+	}
+
+	for i, test := range tests {
+		num, err := Parse(test.number, "")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.number, err)
+			continue
+		}
+		// invalid numbers aren't grouped, so wouldn't show that grouping is kept
+		if !IsValidNumber(num) {
+			t.Errorf("[test %d:valid] %s isn't a valid number", i, test.number)
+		}
+		formatted := FormatWithOptions(num, test.format, test.opts)
+		if formatted != test.expected {
+			t.Errorf("[test %d:formatted] %s != %s", i, formatted, test.expected)
+		}
+	}
+}
+
+func TestTransliterateDigits(t *testing.T) {
+	var tests = []struct {
+		text     string
+		system   NumberingSystem
+		expected string
+	}{
+		{"+1 650-253-0000", NUMBERING_ARAB, "+١ ٦٥٠-٢٥٣-٠٠٠٠"},
+		{"+١ ٦٥٠-٢٥٣-٠٠٠٠", NUMBERING_LATN, "+1 650-253-0000"},
+		{"۰۲۱ ۱۲۳۴", NUMBERING_DEVA, "०२१ १२३४"},
+		{"0123456789", NUMBERING_BENG, "০১২৩৪৫৬৭৮৯"},
+		{"0123456789", NUMBERING_THAI, "๐๑๒๓๔๕๖๗๘๙"},
+		{"0123456789", NUMBERING_MYMR, "၀၁၂၃၄၅၆၇၈၉"},
+		{"0123456789", NUMBERING_FULLWIDE, "０１２３４５６７８９"},
+		{"０１２ abc", "", "012 abc"},
+	}
+
+	for i, test := range tests {
+		if transliterated := TransliterateDigits(test.text, test.system); transliterated != test.expected {
+			t.Errorf("[test %d] %s != %s", i, transliterated, test.expected)
+		}
+	}
+}
+
+func TestFormatInOriginalFormat(t *testing.T) {
+	var tests = []struct {
+		in     string
+		exp    string
+		region string
+		frmt   PhoneNumberFormat
+	}{
+		{
+			in:     "0987654321",
+			region: "DE",
+			exp:    "09876 54321",
+		}, {
+			in:     "0049987654321",
+			region: "CH",
+			exp:    "00 49 9876 54321",
+		}, {
+			in:     "+49987654321",
+			region: "DE",
+			exp:    "+49 9876 54321",
+		}, {
+			in:     "49987654321",
+			region: "DE",
+			exp:    "4998 7654321",
+		}, {
+			in:     "6463752545",
+			region: "US",
+			exp:    "(646) 375-2545",
+		}, {
+			in:     "3752545",
+			region: "US",
+			exp:    "375-2545",
 		}, {
 			in:     "011420245646734",
 			region: "US",
 			exp:    "011 420 245 646 734",
+		}, {
+			in:     "+44(0)2079460000",
+			region: "US",
+			exp:    "+44 (0)20 7946 0000",
+		}, {
+			in:     "0049 (0) 30 1234567",
+			region: "GB",
+			exp:    "00 49 (0)30 1234567",
 		},
 	}
 
@@ -700,6 +1942,240 @@ func TestFormatInOriginalFormat(t *testing.T) {
 	}
 }
 
+func TestParseTelURI(t *testing.T) {
+	var tests = []struct {
+		uri        string
+		region     string
+		number     string
+		extension  string
+		context    string
+		subaddress string
+		params     []TelURIParam
+		canonical  string
+	}{
+		{"tel:+1-201-555-0123", "", "+12015550123", "", "", "", nil, "tel:+1-201-555-0123"},
+		{"TEL:+1.201.555.0123;EXT=12-34", "", "+12015550123", "1234", "", "", nil, "tel:+1-201-555-0123;ext=1234"},
+		{"tel:+44-20-7946-0000;isub=1411", "", "+442079460000", "", "", "1411", nil, "tel:+44-20-7946-0000;isub=1411"},
+		{"tel:7042;phone-context=Example.COM.", "GB", "+447042", "", "example.com", "", nil, "tel:7042;phone-context=example.com"},
+		{"tel:020-7946-0000;phone-context=example.com", "GB", "+442079460000", "", "example.com", "", nil, "tel:2079460000;phone-context=example.com"},
+		{"tel:20-7946-0000;phone-context=+44", "US", "+442079460000", "", "", "", nil, "tel:+44-20-7946-0000"},
+		{
+			"tel:+1-201-555-0123;rn=+1-201-555-0000;npdi", "", "+12015550123", "", "", "",
+			[]TelURIParam{{"rn", "+1-201-555-0000"}, {"npdi", ""}},
+			"tel:+1-201-555-0123;npdi;rn=+1-201-555-0000",
+		},
+		{
+			"tel:+1-201-555-0123;NPDI;foo=b%2Fr;ext=99", "", "+12015550123", "99", "", "",
+			[]TelURIParam{{"npdi", ""}, {"foo", "b%2Fr"}},
+			"tel:+1-201-555-0123;ext=99;foo=b%2Fr;npdi",
+		},
+	}
+
+	for i, test := range tests {
+		uri, err := ParseTelURI(test.uri, test.region)
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.uri, err)
+			continue
+		}
+		if Format(uri.Number, E164) != test.number {
+			t.Errorf("[test %d:number] %s != %s", i, Format(uri.Number, E164), test.number)
+		}
+		if uri.Number.GetExtension() != test.extension {
+			t.Errorf("[test %d:extension] %s != %s", i, uri.Number.GetExtension(), test.extension)
+		}
+		if uri.PhoneContext != test.context {
+			t.Errorf("[test %d:context] %s != %s", i, uri.PhoneContext, test.context)
+		}
+		if uri.Subaddress != test.subaddress {
+			t.Errorf("[test %d:subaddress] %s != %s", i, uri.Subaddress, test.subaddress)
+		}
+		if !reflect.DeepEqual(uri.Params, test.params) {
+			t.Errorf("[test %d:params] %v != %v", i, uri.Params, test.params)
+		}
+		if uri.String() != test.canonical {
+			t.Errorf("[test %d:canonical] %s != %s", i, uri.String(), test.canonical)
+		}
+
+		// the canonical form should parse to the same thing
+		reparsed, err := ParseTelURI(uri.String(), test.region)
+		if err != nil {
+			t.Errorf("[test %d:reparse] failed to parse %s: %s", i, uri.String(), err)
+		} else if reparsed.String() != test.canonical {
+			t.Errorf("[test %d:reparse] %s != %s", i, reparsed.String(), test.canonical)
+		}
+	}
+}
+
+func TestTelURILocalRoundTrip(t *testing.T) {
+	var tests = []struct {
+		number string
+		region string
+		uri    string
+	}{
+		{"+442079460000", "GB", "tel:2079460000;phone-context=example.com"},
+		{"+74951234567", "RU", "tel:4951234567;phone-context=example.com"},
+		{"+390612345678", "IT", "tel:0612345678;phone-context=example.com"},
+		{"+5491187654321", "AR", "tel:91187654321;phone-context=example.com"},
+	}
+
+	for i, test := range tests {
+		num, _ := Parse(test.number, "")
+		uri := &TelURI{Number: num, PhoneContext: "example.com"}
+		if uri.String() != test.uri {
+			t.Errorf("[test %d:uri] %s != %s", i, uri.String(), test.uri)
+		}
+
+		parsed, err := ParseTelURI(uri.String(), test.region)
+		if err != nil {
+			t.Errorf("[test %d:parse] failed to parse %s: %s", i, uri.String(), err)
+		} else if !proto.Equal(parsed.Number, num) {
+			t.Errorf("[test %d:number] %s != %s", i, Format(parsed.Number, E164), test.number)
+		}
+	}
+}
+
+func TestParseTelURIErrors(t *testing.T) {
+	var tests = []struct {
+		uri    string
+		err    error
+		offset int
+	}{
+		{"http://example.com", ErrInvalidTelURI, 0},
+		{"tel:", ErrInvalidTelURI, 4},
+		{"tel:2015550123", ErrInvalidTelURI, 4},
+		{"tel:+1 201 555 0123", ErrInvalidTelURI, 4},
+		{"tel:+12015550123;phone-context=+1", ErrInvalidTelURI, 17},
+		{"tel:7042;phone-context=", ErrInvalidTelURI, 9},
+		{"tel:7042;phone-context=-example.com", ErrInvalidTelURI, 9},
+		{"tel:7042;phone-context=example.123", ErrInvalidTelURI, 9},
+		{"tel:+12015550123;ext=", ErrInvalidTelURI, 17},
+		{"tel:+12015550123;ext=12a", ErrInvalidTelURI, 17},
+		{"tel:+12015550123;ext=1;ext=2", ErrInvalidTelURI, 23},
+		{"tel:+12015550123;ext=1;isub=2", ErrInvalidTelURI, 23},
+		{"tel:+12015550123;=foo", ErrInvalidTelURI, 17},
+		{"tel:+12015550123;foo=b r", ErrInvalidTelURI, 17},
+		{"tel:+12015550123;foo=%zz", ErrInvalidTelURI, 17},
+		{"tel:+999-555-0123", ErrInvalidCountryCode, 4},
+		{"tel:+1-2", ErrNotANumber, 4},
+	}
+
+	for i, test := range tests {
+		_, err := ParseTelURI(test.uri, "US")
+		if !errors.Is(err, test.err) {
+			t.Errorf("[test %d:err] %s: %v != %v", i, test.uri, err, test.err)
+			continue
+		}
+		if parseErr, ok := err.(*ParseError); !ok {
+			t.Errorf("[test %d:err] expected parse error, got %T", i, err)
+		} else if parseErr.Offset != test.offset {
+			t.Errorf("[test %d:offset] %d != %d", i, parseErr.Offset, test.offset)
+		}
+	}
+}
+
+func TestParseSIPURI(t *testing.T) {
+	var tests = []struct {
+		uri       string
+		region    string
+		number    string
+		extension string
+		source    PhoneNumber_CountryCodeSource
+		rawInput  string
+	}{
+		{"sip:+14155550100@example.com;user=phone", "", "+14155550100", "", PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN, "+14155550100"},
+		{"SIP:+1-415-555-0100@example.com:5060;user=phone", "", "+14155550100", "", PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN, "+1-415-555-0100"},
+		{"sip:+14155550100;ext=22@example.com;user=phone?subject=hi", "", "+14155550100", "22", PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN, "+14155550100;ext=22"},
+		{"sip:5550100;phone-context=+1-415@example.com;user=phone", "", "+14155550100", "", PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN, "5550100;phone-context=+1-415"},
+		{"sips:00442079460000@example.com", "GB", "+442079460000", "", PhoneNumber_FROM_NUMBER_WITH_IDD, "00442079460000"},
+		{"sips:02079460000:secret@example.com", "GB", "+442079460000", "", PhoneNumber_FROM_DEFAULT_COUNTRY, "02079460000"},
+		{"sip:%2B14155550100@example.com", "US", "+14155550100", "", PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN, "+14155550100"},
+	}
+
+	for i, test := range tests {
+		number, err := ParseSIPURI(test.uri, test.region)
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.uri, err)
+			continue
+		}
+		if Format(number, E164) != test.number {
+			t.Errorf("[test %d:number] %s != %s", i, Format(number, E164), test.number)
+		}
+		if number.GetExtension() != test.extension {
+			t.Errorf("[test %d:extension] %s != %s", i, number.GetExtension(), test.extension)
+		}
+		if number.GetCountryCodeSource() != test.source {
+			t.Errorf("[test %d:source] %s != %s", i, number.GetCountryCodeSource(), test.source)
+		}
+		if number.GetRawInput() != test.rawInput {
+			t.Errorf("[test %d:rawInput] %s != %s", i, number.GetRawInput(), test.rawInput)
+		}
+	}
+}
+
+func TestParseSIPURIErrors(t *testing.T) {
+	var tests = []struct {
+		uri    string
+		err    error
+		offset int
+	}{
+		{"tel:+14155550100", ErrInvalidSIPURI, 0},
+		{"sip:example.com", ErrInvalidSIPURI, 4},
+		{"sip:@example.com", ErrInvalidSIPURI, 4},
+		{"sip:%zz@example.com", ErrInvalidSIPURI, 4},
+		{"sip:+14155550100@", ErrInvalidSIPURI, 17},
+		{"sip:+14155550100@;user=phone", ErrInvalidSIPURI, 17},
+		{"sip:alice@example.com", ErrNotANumber, 9},
+		{"sip:+9995550100@example.com;user=phone", ErrInvalidCountryCode, 5},
+		{"sips:+9995550100@example.com", ErrInvalidCountryCode, 6},
+	}
+
+	for i, test := range tests {
+		_, err := ParseSIPURI(test.uri, "US")
+		if !errors.Is(err, test.err) {
+			t.Errorf("[test %d:err] %s: %v != %v", i, test.uri, err, test.err)
+			continue
+		}
+		if parseErr, ok := err.(*ParseError); !ok {
+			t.Errorf("[test %d:err] expected parse error, got %T", i, err)
+		} else if parseErr.Offset != test.offset {
+			t.Errorf("[test %d:offset] %d != %d", i, parseErr.Offset, test.offset)
+		}
+	}
+}
+
+func TestFormatSIPURI(t *testing.T) {
+	var tests = []struct {
+		number string
+		host   string
+		secure bool
+		uri    string
+	}{
+		{"+14155550100", "example.com", false, "sip:+14155550100@example.com;user=phone"},
+		{"+442079460000", "sip.example.com:5061", true, "sips:+442079460000@sip.example.com:5061;user=phone"},
+		{"+14155550100 ext. 22", "example.com", false, "sip:+14155550100;ext=22@example.com;user=phone"},
+	}
+
+	for i, test := range tests {
+		number, err := Parse(test.number, "")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.number, err)
+			continue
+		}
+		uri := FormatSIPURI(number, test.host, test.secure)
+		if uri != test.uri {
+			t.Errorf("[test %d:uri] %s != %s", i, uri, test.uri)
+		}
+
+		// and we should be able to parse that back to the same number
+		reparsed, err := ParseSIPURI(uri, "")
+		if err != nil {
+			t.Errorf("[test %d:reparse] failed to parse %s: %s", i, uri, err)
+		} else if isNumberMatchWithNumbers(reparsed, number) != EXACT_MATCH {
+			t.Errorf("[test %d:reparse] %s != %s", i, Format(reparsed, E164), test.number)
+		}
+	}
+}
+
 func TestFormatOutOfCountryCallingNumber(t *testing.T) {
 	var tests = []struct {
 		in     string
@@ -731,6 +2207,15 @@ func TestFormatOutOfCountryCallingNumber(t *testing.T) {
 			in:     "+4911234",
 			region: "DE",
 			exp:    "11234",
+		}, {
+			// regions with several international prefixes use their preferred one
+			in:     "+16505551234",
+			region: "AU",
+			exp:    "0011 1 650-555-1234",
+		}, {
+			in:     "+16505551234",
+			region: "SG",
+			exp:    "+1 650-555-1234",
 		},
 	}
 
@@ -761,6 +2246,14 @@ func TestFormatOutOfCountryKeepingAlphaChars(t *testing.T) {
 			in:     "+1 800 six-flag",
 			region: "CH",
 			exp:    "00 1 800 SIX-FLAG",
+		}, {
+			in:     "+1 800 six-flag",
+			region: "AU",
+			exp:    "0011 1 800 SIX-FLAG",
+		}, {
+			in:     "+1 800 six-flag",
+			region: "SG",
+			exp:    "+1 800 SIX-FLAG",
 		},
 	}
 
@@ -1025,6 +2518,117 @@ func TestGetLengthOfGeographicalAreaCode(t *testing.T) {
 	}
 }
 
+func TestGetLengthOfNationalDestinationCode(t *testing.T) {
+	var tests = []struct {
+		numName string
+		length  int
+	}{
+		{numName: "US_NUMBER", length: 3},
+		{numName: "US_TOLLFREE", length: 3},
+		{numName: "GB_NUMBER", length: 2},
+		{numName: "GB_MOBILE", length: 4},
+		{numName: "AR_NUMBER", length: 2},
+		{numName: "AR_MOBILE", length: 3},
+		{numName: "AU_NUMBER", length: 1},
+		{numName: "DE_NUMBER", length: 2},
+		{numName: "IT_NUMBER", length: 2},
+		{numName: "IT_MOBILE", length: 3},
+		{numName: "SG_NUMBER", length: 4},
+		{numName: "US_SHORT_BY_ONE_NUMBER", length: 0},
+		{numName: "INTERNATIONAL_TOLL_FREE", length: 4},
+	}
+	for i, test := range tests {
+		l := GetLengthOfNationalDestinationCode(getTestNumber(test.numName))
+		if l != test.length {
+			t.Errorf("[test %d:length] %d != %d for %s\n", i, l, test.length, test.numName)
+		}
+	}
+}
+
+func TestComponents(t *testing.T) {
+	var tests = []struct {
+		number         string
+		nationalPrefix string
+		mobileToken    string
+		ndc            string
+		subscriber     string
+		extension      string
+		leadingZeros   string
+		groups         []string
+		national       string
+	}{
+		{"+16502530000", "", "", "650", "2530000", "", "", []string{"650", "253", "0000"}, "6502530000"},
+		{"+442079460000", "0", "", "20", "79460000", "", "", []string{"20", "7946", "0000"}, "02079460000"},
+		{"+390612345678", "", "", "06", "12345678", "", "0", []string{"06", "1234", "5678"}, "0612345678"},
+		{"+541187654321", "0", "", "11", "87654321", "", "", []string{"11", "8765", "4321"}, "01187654321"},
+		{"+74951234567", "8", "", "495", "1234567", "", "", []string{"495", "123", "45", "67"}, "84951234567"},
+		{"+61298765432 ext. 12", "0", "", "2", "98765432", "12", "", []string{"2", "9876", "5432"}, "0298765432"},
+		{"+447912345678", "0", "", "7912", "345678", "", "", []string{"7912", "345678"}, "07912345678"},
+		{"+80012345678", "", "", "1234", "5678", "", "", []string{"1234", "5678"}, "12345678"},
+
+		// Argentinian mobile numbers are dialled nationally with 15 rather than the mobile token, so
+		// aren't a national prefix followed by the national significant number
+		{"+5491187654321", "", "9", "11", "87654321", "", "", []string{"9", "11", "8765", "4321"}, "091587654321"},
+
+		// Mexican mobile numbers have the mobile token dropped when parsing, as it's no longer dialled
+		{"+5215512345678", "", "", "55", "12345678", "", "", []string{"55", "1234", "5678"}, "5512345678"},
+	}
+
+	for i, test := range tests {
+		num, err := Parse(test.number, "")
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.number, err)
+			continue
+		}
+		components := Components(num)
+		if components.CountryCode != num.GetCountryCode() {
+			t.Errorf("[test %d:countryCode] %d != %d", i, components.CountryCode, num.GetCountryCode())
+		}
+		if components.NationalPrefix != test.nationalPrefix {
+			t.Errorf("[test %d:nationalPrefix] %s != %s", i, components.NationalPrefix, test.nationalPrefix)
+		}
+		if components.MobileToken != test.mobileToken {
+			t.Errorf("[test %d:mobileToken] %s != %s", i, components.MobileToken, test.mobileToken)
+		}
+		if components.NationalDestinationCode != test.ndc {
+			t.Errorf("[test %d:ndc] %s != %s", i, components.NationalDestinationCode, test.ndc)
+		}
+		if components.SubscriberNumber != test.subscriber {
+			t.Errorf("[test %d:subscriber] %s != %s", i, components.SubscriberNumber, test.subscriber)
+		}
+		if components.Extension != test.extension {
+			t.Errorf("[test %d:extension] %s != %s", i, components.Extension, test.extension)
+		}
+		if components.ItalianLeadingZeros != test.leadingZeros {
+			t.Errorf("[test %d:leadingZeros] %s != %s", i, components.ItalianLeadingZeros, test.leadingZeros)
+		}
+		if !reflect.DeepEqual(components.Groups, test.groups) {
+			t.Errorf("[test %d:groups] %v != %v", i, components.Groups, test.groups)
+		}
+
+		// the components should reassemble into the number
+		if components.E164() != Format(num, E164) {
+			t.Errorf("[test %d:e164] %s != %s", i, components.E164(), Format(num, E164))
+		}
+		if strings.Join(components.Groups, "") != GetNationalSignificantNumber(num) {
+			t.Errorf("[test %d:groups] %v don't make up %s", i, components.Groups, GetNationalSignificantNumber(num))
+		}
+		if components.NationalDigits != test.national || components.National() != test.national {
+			t.Errorf("[test %d:national] %s, %s != %s", i, components.NationalDigits, components.National(), test.national)
+		}
+	}
+
+	// without national digits, numbers are dialled with their national prefix
+	components := &NumberComponents{CountryCode: 54, NationalPrefix: "0", NationalDestinationCode: "11", SubscriberNumber: "87654321"}
+	if components.National() != "01187654321" {
+		t.Errorf("[national] %s != 01187654321", components.National())
+	}
+	components.NationalDigits = "01187654321"
+	if components.National() != "01187654321" {
+		t.Errorf("[nationalDigits] %s != 01187654321", components.National())
+	}
+}
+
 func TestGetCountryMobileToken(t *testing.T) {
 	if GetCountryMobileToken(GetCountryCodeForRegion("MX")) != "1" {
 		t.Error("Mexico should have a mobile token == \"1\"")
@@ -1116,17 +2720,6 @@ type testCase struct {
 	isValidRegion bool
 }
 
-type timeZonesTestCases struct {
-	num              string
-	expectedTimeZone string
-}
-
-type prefixMapTestCases struct {
-	num      string
-	lang     string
-	expected string
-}
-
 func runTestBatch(t *testing.T, tests []testCase) {
 	for _, test := range tests {
 		n, err := Parse(test.num, test.parseRegion)
@@ -1323,161 +2916,6 @@ func TestParsing(t *testing.T) {
 	}
 }
 
-func TestGetTimeZonesForPrefix(t *testing.T) {
-	tests := []timeZonesTestCases{
-		{
-			num:              "+442073238299",
-			expectedTimeZone: "Europe/London",
-		},
-		{
-			num:              "+61491570156",
-			expectedTimeZone: "Australia/Sydney",
-		},
-		{
-			num:              "+61255501234",
-			expectedTimeZone: "Australia/Sydney",
-		},
-		{
-			num:              "+12067798181",
-			expectedTimeZone: "America/Los_Angeles",
-		},
-		{
-			num:              "+390399123456",
-			expectedTimeZone: "Europe/Rome",
-		},
-		{
-			num:              "+541151123456",
-			expectedTimeZone: "America/Buenos_Aires",
-		},
-		{
-			num:              "+15167706076",
-			expectedTimeZone: "America/New_York",
-		},
-		{
-			num:              "+917999999543",
-			expectedTimeZone: "Asia/Calcutta",
-		},
-		{
-			num:              "+540111561234567",
-			expectedTimeZone: "America/Buenos_Aires",
-		},
-		{
-			num:              "+18504320800",
-			expectedTimeZone: "America/Chicago",
-		},
-		{
-			num:              "+14079395277",
-			expectedTimeZone: "America/New_York",
-		},
-		{
-			num:              "+18508632167",
-			expectedTimeZone: "America/Chicago",
-		},
-		{
-			num:              "+40213158207",
-			expectedTimeZone: "Europe/Bucharest",
-		},
-		// UTC +5:45
-		{
-			num:              "+97714240520",
-			expectedTimeZone: "Asia/Katmandu",
-		},
-		// UTC -3:30
-		{
-			num:              "+17097264534",
-			expectedTimeZone: "America/St_Johns",
-		},
-		{
-			num:              "0000000000",
-			expectedTimeZone: "Etc/Unknown",
-		},
-	}
-
-	for _, test := range tests {
-		timeZones, err := GetTimezonesForPrefix(test.num)
-		if err != nil {
-			t.Errorf("Failed to getTimezone for the number %s: %s", test.num, err)
-		}
-
-		if len(timeZones) == 0 {
-			t.Errorf("Expected at least 1 timezone.")
-		}
-
-		if timeZones[0] != test.expectedTimeZone {
-			t.Errorf("Expected '%s', got '%s' for '%s'", test.expectedTimeZone, timeZones[0], test.num)
-		}
-
-		num, err := Parse(test.num, "")
-		if err != nil {
-			continue
-		}
-
-		timeZones, err = GetTimezonesForNumber(num)
-		if err != nil {
-			t.Errorf("Failed to getTimezone for the number %s: %s", num, err)
-		}
-
-		if len(timeZones) == 0 {
-			t.Errorf("Expected at least 1 timezone.")
-		}
-
-		if timeZones[0] != test.expectedTimeZone {
-			t.Errorf("Expected '%s', got '%s' for '%s'", test.expectedTimeZone, timeZones[0], num)
-		}
-	}
-}
-
-func TestGetCarrierForNumber(t *testing.T) {
-	tests := []prefixMapTestCases{
-		{num: "+8613702032331", lang: "en", expected: "China Mobile"},
-		{num: "+8613702032331", lang: "zh", expected: "中国移动"},
-		{num: "+6281377468527", lang: "en", expected: "Telkomsel"},
-		{num: "+8613323241342", lang: "en", expected: "China Telecom"},
-		{num: "+61491570156", lang: "en", expected: "Telstra"},
-		{num: "+917999999543", lang: "en", expected: "Reliance Jio"},
-		{num: "+593992218722", lang: "en", expected: "Claro"},
-	}
-	for _, test := range tests {
-		number, err := Parse(test.num, "ZZ")
-		if err != nil {
-			t.Errorf("Failed to parse number %s: %s", test.num, err)
-		}
-		carrier, err := GetCarrierForNumber(number, test.lang)
-		if err != nil {
-			t.Errorf("Failed to getCarrier for the number %s: %s", test.num, err)
-		}
-		if test.expected != carrier {
-			t.Errorf("Expected '%s', got '%s' for '%s'", test.expected, carrier, test.num)
-		}
-	}
-}
-
-func TestGetGeocodingForNumber(t *testing.T) {
-	tests := []prefixMapTestCases{
-		{num: "+8613702032331", lang: "en", expected: "Tianjin"},
-		{num: "+8613702032331", lang: "zh", expected: "天津市"},
-		{num: "+863197785050", lang: "zh", expected: "河北省邢台市"},
-		{num: "+8613323241342", lang: "en", expected: "Baoding, Hebei"},
-		{num: "+917999999543", lang: "en", expected: "Ahmedabad Local, Gujarat"},
-		{num: "+17047181840", lang: "en", expected: "North Carolina"},
-		{num: "+12542462158", lang: "en", expected: "Texas"},
-		{num: "+16193165996", lang: "en", expected: "California"},
-		{num: "+12067799191", lang: "en", expected: "Washington State"},
-	}
-	for _, test := range tests {
-		number, err := Parse(test.num, "ZZ")
-		if err != nil {
-			t.Errorf("Failed to parse number %s: %s", test.num, err)
-		}
-		geocoding, err := GetGeocodingForNumber(number, test.lang)
-		if err != nil {
-			t.Errorf("Failed to getGeocoding for the number %s: %s", test.num, err)
-		}
-		if test.expected != geocoding {
-			t.Errorf("Expected '%s', got '%s' for '%s'", test.expected, geocoding, test.num)
-		}
-	}
-}
 func TestMaybeStripExtension(t *testing.T) {
 	var tests = []struct {
 		input     string
@@ -1520,6 +2958,107 @@ func TestMaybeStripExtension(t *testing.T) {
 	}
 }
 
+func TestLocalizedExtensions(t *testing.T) {
+	var tests = []struct {
+		input     string
+		region    string
+		number    string
+		extension string
+	}{
+		{"+420 212 345 678 linka 12", "CZ", "+420212345678", "12"},
+		{"212 345 678 kl. 12", "CZ", "+420212345678", "12"},
+		{"030 1234567 Durchwahl 12", "DE", "+49301234567", "12"},
+		{"030 1234567 Nst. 12", "DE", "+49301234567", "12"},
+		{"(650) 253-0000 extension 12", "US", "+16502530000", "12"},
+		{"912 345 678 extensión 3", "ES", "+34912345678", "3"},
+		{"2 2123 4567 anexo 3", "CL", "+56221234567", "3"},
+		{"01 23 45 67 89 poste 12", "FR", "+33123456789", "12"},
+		{"06 1234 5678 int. 3", "IT", "+390612345678", "3"},
+		{"06 1234 5678 interno 3", "IT", "+390612345678", "3"},
+		{"03-1234-5678 内線5", "JP", "+81312345678", "5"},
+		{"02-312-3456 내선 5", "KR", "+8223123456", "5"},
+		{"020 123 4567 toestel 3", "NL", "+31201234567", "3"},
+		{"22 123 45 67 wew. 3", "PL", "+48221234567", "3"},
+		{"(11) 2345-6789 ramal 3", "BR", "+551123456789", "3"},
+		{"8 495 123-45-67 доб. 34", "RU", "+74951234567", "34"},
+		{"8 495 123-45-67 Добавочный 34", "RU", "+74951234567", "34"},
+		{"08-123 456 78 ankn. 3", "SE", "+46812345678", "3"},
+		{"0212 345 67 89 dahili 3", "TR", "+902123456789", "3"},
+		{"044 123 4567 дод. 3", "UA", "+380441234567", "3"},
+		{"010 1234 5678 转 3", "CN", "+861012345678", "3"},
+		{"010 1234 5678 分機3", "CN", "+861012345678", "3"},
+	}
+
+	for i, test := range tests {
+		num, err := Parse(test.input, test.region)
+		if err != nil {
+			t.Errorf("[test %d] failed to parse %s: %s", i, test.input, err)
+			continue
+		}
+		if Format(num, E164) != test.number {
+			t.Errorf("[test %d:number] %s != %s", i, Format(num, E164), test.number)
+		}
+		if num.GetExtension() != test.extension {
+			t.Errorf("[test %d:extension] %s != %s", i, num.GetExtension(), test.extension)
+		}
+	}
+}
+
+func TestRegisterExtensionKeywords(t *testing.T) {
+	num, err := Parse("09 1234 5678 alanumero 12", "FI")
+	if err == nil && num.GetExtension() == "12" {
+		t.Errorf("extension parsed before keyword was registered")
+	}
+
+	RegisterExtensionKeywords("fi", "Alanumero")
+	RegisterExtensionLabel("fi", " alanumero ")
+	t.Cleanup(func() {
+		UnregisterExtensionKeywords("fi", "alanumero")
+		UnregisterExtensionLabel("fi")
+	})
+
+	num, err = Parse("09 1234 5678 alanumero 12", "FI")
+	if err != nil {
+		t.Fatalf("failed to parse after registering: %s", err)
+	}
+	if num.GetExtension() != "12" {
+		t.Errorf("extension %s != 12", num.GetExtension())
+	}
+	if keywords := GetExtensionKeywords("fi"); !reflect.DeepEqual(keywords, []string{"alanumero"}) {
+		t.Errorf("keywords %v != [alanumero]", keywords)
+	}
+	if formatted := FormatWithOptions(num, NATIONAL, FormatOptions{Language: "fi-FI"}); formatted != "09 12345678 alanumero 12" {
+		t.Errorf("formatted %s != 09 12345678 alanumero 12", formatted)
+	}
+
+	// keywords aren't scoped to the language they're registered for
+	num, err = Parse("0201234567 alanumero 12", "NL")
+	if err != nil {
+		t.Fatalf("failed to parse after registering: %s", err)
+	}
+	if num.GetExtension() != "12" {
+		t.Errorf("extension %s != 12", num.GetExtension())
+	}
+}
+
+func TestUnregisterExtensionKeywords(t *testing.T) {
+	RegisterExtensionKeywords("fi", "alanumero")
+	RegisterExtensionLabel("fi", " alanumero ")
+	UnregisterExtensionKeywords("fi", "Alanumero")
+	UnregisterExtensionLabel("fi")
+
+	if keywords := GetExtensionKeywords("fi"); len(keywords) != 0 {
+		t.Errorf("keywords %v != []", keywords)
+	}
+	if _, ok := GetExtensionLabel("fi"); ok {
+		t.Errorf("label still registered for fi")
+	}
+	num, err := Parse("09 1234 5678 alanumero 12", "FI")
+	if err == nil && num.GetExtension() == "12" {
+		t.Errorf("extension parsed after keyword was unregistered")
+	}
+}
+
 func TestGetSupportedCallingCodes(t *testing.T) {
 	var tests = []struct {
 		code    int
@@ -1647,6 +3186,389 @@ func TestRegexCacheStrict(t *testing.T) {
 	}
 }
 
+func TestLintMetadata(t *testing.T) {
+	// our current metadata should only have a handful of upstream problems
+	findings := LintMetadata()
+	for _, f := range findings {
+		if f.Region == "US" || f.Region == "GB" {
+			t.Errorf("unexpected lint finding: %s", f)
+		}
+	}
+
+	// break a copy of the US metadata in each of the ways we check for
+	metadata := proto.Clone(getMetadataForRegion("US")).(*PhoneMetadata)
+	metadata.NoInternationalDialling = &PhoneNumberDesc{NationalNumberPattern: s("8[00")}
+	metadata.FixedLine.PossibleLength = []int32{10, 11}
+	metadata.TollFree.ExampleNumber = s("2015550123")
+	metadata.NumberFormat[0].LeadingDigitsPattern = []string{"[2-9]", "[2-9]\\d{7}"}
+
+	findings = lintRegion(metadata)
+	expected := []struct {
+		check LintCheck
+		field string
+	}{
+		{LINT_REGEX, "no_international_dialling"},
+		{LINT_POSSIBLE_LENGTH, "fixed_line"},
+		{LINT_EXAMPLE_NUMBER, "toll_free"},
+		{LINT_LEADING_DIGITS, "number_format[0].leading_digits_pattern[1]"},
+	}
+	if len(findings) != len(expected) {
+		t.Errorf("expected %d findings, got %d: %v", len(expected), len(findings), findings)
+		return
+	}
+	for i, e := range expected {
+		if findings[i].Check != e.check || findings[i].Field != e.field {
+			t.Errorf("[test %d] expected %s finding for %s, got %s", i, e.check, e.field, findings[i])
+		}
+	}
+
+	// example numbers should be classified with the metadata being linted, not what we ship with
+	metadata = proto.Clone(getMetadataForRegion("US")).(*PhoneMetadata)
+	metadata.Voicemail = &PhoneNumberDesc{NationalNumberPattern: s(metadata.GetFixedLine().GetExampleNumber())}
+
+	findings = lintRegion(metadata)
+	if len(findings) != 2 || findings[0].Field != "fixed_line" || findings[1].Field != "mobile" {
+		t.Errorf("expected example number findings for fixed_line and mobile, got %v", findings)
+	}
+}
+
+func TestLazyMetadata(t *testing.T) {
+	// decode a region from lots of goroutines at once, they should all get the same metadata
+	results := make(chan *PhoneMetadata, 10)
+	for i := 0; i < 10; i++ {
+		go func() { results <- getMetadataForRegion("NZ") }()
+	}
+	first := <-results
+	for i := 1; i < 10; i++ {
+		if m := <-results; m != first {
+			t.Errorf("expected the same metadata instance for every goroutine")
+		}
+	}
+	if first.GetCountryCode() != 64 {
+		t.Errorf("expected country code 64 for NZ, got %d", first.GetCountryCode())
+	}
+
+	if err := PreloadMetadata(); err != nil {
+		t.Errorf("unexpected error preloading metadata: %s", err)
+	}
+
+	collection, err := MetadataCollection()
+	if err != nil {
+		t.Errorf("unexpected error getting metadata collection: %s", err)
+	}
+	if len(collection.GetMetadata()) != len(metadataEntries) {
+		t.Errorf("expected %d regions in collection, got %d", len(metadataEntries), len(collection.GetMetadata()))
+	}
+	for _, m := range collection.GetMetadata() {
+		if m.GetId() == REGION_CODE_FOR_NON_GEO_ENTITY {
+			if getMetadataForNonGeographicalRegion(int(m.GetCountryCode())) != m {
+				t.Errorf("expected collection to contain the loaded metadata for +%d", m.GetCountryCode())
+			}
+		} else if getMetadataForRegion(m.GetId()) != m {
+			t.Errorf("expected collection to contain the loaded metadata for %s", m.GetId())
+		}
+	}
+}
+
+func TestBuildJSMetadata(t *testing.T) {
+	collection, err := MetadataCollection()
+	if err != nil {
+		t.Fatalf("error getting metadata collection: %s", err)
+	}
+
+	type jsMetadata struct {
+		Version             int                          `json:"version"`
+		CountryCallingCodes map[string][]string          `json:"country_calling_codes"`
+		Countries           map[string][]json.RawMessage `json:"countries"`
+		NonGeographic       map[string][]json.RawMessage `json:"nonGeographic"`
+	}
+
+	// reads the metadata for the passed in region back out of the JSON
+	readCountry := func(js *jsMetadata, metadata *PhoneMetadata) []json.RawMessage {
+		if metadata.GetId() == REGION_CODE_FOR_NON_GEO_ENTITY {
+			return js.NonGeographic[fmt.Sprint(metadata.GetCountryCode())]
+		}
+		return js.Countries[metadata.GetId()]
+	}
+
+	// whether the passed in number matches the JSON pattern and lengths
+	matches := func(number string, pattern json.RawMessage, lengths []int32) bool {
+		var p string
+		json.Unmarshal(pattern, &p)
+		if !regexp.MustCompile("^(?:" + p + ")$").MatchString(number) {
+			return false
+		}
+		for _, l := range lengths {
+			if int(l) == len(number) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// the types in the same order as the JSON types array
+	jsTypes := []PhoneNumberType{FIXED_LINE, MOBILE, TOLL_FREE, PREMIUM_RATE, PERSONAL_NUMBER, VOICEMAIL, UAN, PAGER, VOIP, SHARED_COST}
+
+	// gets the type of the passed in number using only the JSON, checking types in the same
+	// order as getNumberTypeHelper
+	jsNumberType := func(number string, country []json.RawMessage) PhoneNumberType {
+		var lengths []int32
+		json.Unmarshal(country[3], &lengths)
+		if !matches(number, country[2], lengths) {
+			return UNKNOWN
+		}
+
+		var types []json.RawMessage
+		if len(country) > 11 {
+			json.Unmarshal(country[11], &types)
+		}
+		matching := make(map[PhoneNumberType]string)
+		for i, typ := range types {
+			var desc []json.RawMessage
+			if json.Unmarshal(typ, &desc) != nil || len(desc) == 0 {
+				continue
+			}
+			var typeLengths []int32
+			if len(desc) > 1 {
+				json.Unmarshal(desc[1], &typeLengths)
+			} else {
+				typeLengths = lengths
+			}
+			if matches(number, desc[0], typeLengths) {
+				matching[jsTypes[i]] = string(desc[0])
+			}
+		}
+
+		for _, typ := range []PhoneNumberType{PREMIUM_RATE, TOLL_FREE, SHARED_COST, VOIP, PERSONAL_NUMBER, PAGER, UAN, VOICEMAIL} {
+			if _, ok := matching[typ]; ok {
+				return typ
+			}
+		}
+		_, isFixed := matching[FIXED_LINE]
+		_, isMobile := matching[MOBILE]
+		if isFixed && isMobile {
+			return FIXED_LINE_OR_MOBILE
+		} else if isFixed {
+			return FIXED_LINE
+		} else if isMobile {
+			return MOBILE
+		}
+		return UNKNOWN
+	}
+
+	for _, flavour := range []JSMetadataFlavour{JS_METADATA_MIN, JS_METADATA_MAX} {
+		data, err := BuildJSMetadata(collection, flavour)
+		if err != nil {
+			t.Fatalf("error building JS metadata: %s", err)
+		}
+
+		js := &jsMetadata{}
+		if err := json.Unmarshal(data, js); err != nil {
+			t.Fatalf("error reading back JS metadata: %s", err)
+		}
+		if js.Version != 4 {
+			t.Errorf("expected version 4, got %d", js.Version)
+		}
+		if !reflect.DeepEqual(js.CountryCallingCodes["1"], GetRegionCodesForCountryCode(1)) {
+			t.Errorf("expected regions %v for +1, got %v", GetRegionCodesForCountryCode(1), js.CountryCallingCodes["1"])
+		}
+
+		for _, metadata := range collection.GetMetadata() {
+			country := readCountry(js, metadata)
+			if len(country) < 4 {
+				t.Errorf("missing or short JS metadata for %s (+%d)", metadata.GetId(), metadata.GetCountryCode())
+				continue
+			}
+			if string(country[0]) != fmt.Sprintf(`"%d"`, metadata.GetCountryCode()) {
+				t.Errorf("wrong calling code for %s, got %s", metadata.GetId(), country[0])
+			}
+
+			if flavour == JS_METADATA_MIN {
+				if len(country) > 11 && string(country[11]) != "0" {
+					t.Errorf("expected no types in min metadata for %s, got %s", metadata.GetId(), country[11])
+				}
+				continue
+			}
+
+			// every example number should have the same type and validity using the JSON as we get
+			for _, typ := range jsTypes {
+				example := getNumberDescByType(metadata, typ).GetExampleNumber()
+				if example == "" {
+					continue
+				}
+				number, err := Parse(fmt.Sprintf("+%d%s", metadata.GetCountryCode(), example), UNKNOWN_REGION)
+				if err != nil {
+					t.Errorf("error parsing example %s for %s: %s", example, metadata.GetId(), err)
+					continue
+				}
+
+				jsType := jsNumberType(example, country)
+				goType := getNumberTypeHelper(example, metadata)
+				if jsType != goType {
+					t.Errorf("example %s for %s has type %d in JS metadata but %d in Go", example, metadata.GetId(), jsType, goType)
+				}
+				if (jsType != UNKNOWN) != IsValidNumberForRegion(number, metadata.GetId()) && metadata.GetId() != REGION_CODE_FOR_NON_GEO_ENTITY {
+					t.Errorf("example %s for %s has different validity in JS metadata", example, metadata.GetId())
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkLoadMetadataIndex(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := decodeMetadataIndex(metadataData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLoadMetadata(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, entry := range metadataEntries {
+			if _, err := decodeMetadataEntry(entry); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkLoadRegionMetadata(b *testing.B) {
+	entry := regionToMetadataEntry["US"]
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := decodeMetadataEntry(entry); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLoadRegionMap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := prefixmap.LoadIntStringArrayMap(regionMapData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse("(650) 253-0000", "US"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseInto(b *testing.B) {
+	var number Number
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := ParseInto(&number, "(650) 253-0000", "US"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	number, _ := Parse("+16502530000", "")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Format(number, INTERNATIONAL)
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	number := Number{CountryCode: 1, NationalNumber: 6502530000}
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendFormat(buf[:0], number, INTERNATIONAL)
+	}
+}
+
+// returns the example numbers of every region for benchmarking
+func benchmarkExampleNumbers(b *testing.B) []*PhoneNumber {
+	numbers := make([]*PhoneNumber, 0)
+	for region := range GetSupportedRegions() {
+		for _, numberType := range []PhoneNumberType{FIXED_LINE, MOBILE, TOLL_FREE, PREMIUM_RATE, SHARED_COST, VOIP, PERSONAL_NUMBER, PAGER, UAN, VOICEMAIL} {
+			if number := GetExampleNumberForType(region, numberType); number != nil {
+				numbers = append(numbers, number)
+			}
+		}
+	}
+	b.ResetTimer()
+	return numbers
+}
+
+func BenchmarkGetNumberType(b *testing.B) {
+	numbers := benchmarkExampleNumbers(b)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, number := range numbers {
+			GetNumberType(number)
+		}
+	}
+}
+
+// returns the national numbers of the example numbers and their metadata, with any classifiers
+// already decoded, so that benchmarks only time classification
+func benchmarkNationalNumbers(b *testing.B) ([]string, []*PhoneMetadata) {
+	numbers := benchmarkExampleNumbers(b)
+	nationalNumbers := make([]string, len(numbers))
+	metadatas := make([]*PhoneMetadata, len(numbers))
+	for i, number := range numbers {
+		nationalNumbers[i] = GetNationalSignificantNumber(number)
+		metadatas[i] = getMetadataForRegionOrCallingCode(int(number.GetCountryCode()), GetRegionCodeForNumber(number))
+		getClassifier(metadatas[i])
+	}
+	b.ResetTimer()
+	return nationalNumbers, metadatas
+}
+
+func BenchmarkGetNumberTypeWithClassifiers(b *testing.B) {
+	nationalNumbers, metadatas := benchmarkNationalNumbers(b)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j, nationalNumber := range nationalNumbers {
+			getNumberTypeWithMatcher(newDescMatcher(nationalNumber, metadatas[j]), metadatas[j])
+		}
+	}
+}
+
+func BenchmarkGetNumberTypeWithRegexes(b *testing.B) {
+	nationalNumbers, metadatas := benchmarkNationalNumbers(b)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j, nationalNumber := range nationalNumbers {
+			getNumberTypeWithMatcher(descMatcher{nationalNumber: nationalNumber}, metadatas[j])
+		}
+	}
+}
+
+func BenchmarkIsValidNumber(b *testing.B) {
+	numbers := benchmarkExampleNumbers(b)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, number := range numbers {
+			IsValidNumber(number)
+		}
+	}
+}
+
 func s(str string) *string {
 	return &str
 }
@@ -1,13 +0,0 @@
-package phonenumbers
-
-var carrierMapData = map[string]string {
-	"be": "H4sIAAAAAAAA/1JmYGAIS81Jzs/lujDxwtYLCy8sgjB2X1gIIrkuzLmw6MJCHgYGhqlLmRgZOJgZuBkYLp8TYWBgZGZgZGBgZIIxmKEMQAAAAP//QXLPBVMAAAA=",
-	"ko": "H4sIAAAAAAAA/5JkYGDwDuEK9lZ42zrl9fLGt41ruF73bngzd8ubvUsCGBgYJjswMrAyMDAyMjAyMDAyMcw9yMLIIMTAcKlHlxEsygRDDBiIEZUNR0wMzDBxNkZUWSYEYoELIpnJjMyFqWRiQtEIQox4Eao7NzcdZsZQAwgAAP//cHjeWBkBAAA=",
-	"uk": "H4sIAAAAAAAA/zTIsQqCUBiG4e8/5nIggq6gm3ELcmqXOkIgunQBJ4duQYgmm9osEMTSa/j+O4ozBA/v8GYA0mSXbpPSne2+OmZ5VboNHxzVs9OGEztbnHJ3cEVheeWkNXv1ofyw58iZX8sbB2341ovW7NRbtuo58M6ZrxWA9mkES4NIIIsgNljHEEAMJIL8fyD4BQAA//82xtgvmAAAAA==",
-	"zh_Hant": "H4sIAAAAAAAA/2xTz2sTURCeN7vdmtn0pQ/xj/BSaAveRQQPehBEz0kMGqgb0fSQWz2J5lzRpIL1YL1I9SAEU6hH8VrwIIIiyTZ79+ZF3s/MBmF4+97MN9+bb2bfEQBsrN9s17NWlzYubK7p7yZdrj9sduhKPbvdo6u9Zv1ep9HeatG17a1uu9HrtujGg+37dztZi261Go12docmo3eT4/3pXr8YDIvhePb2qXZN9/qnB+Npf9cdZo8Oi52BOxTD8eR4Xx/ykXHnu6PT10dm9+y9zSt2Bppq9vhT/vIDFW9e5KNDHskEwMElgvxbrKAi4UeSgrBWBSQQzJJUe5IqoAGQWePURKvGfKIgwKrbOLMhcuCKiX78vUYgarBuVhdU/loFAs2qDJ/1K70RHEDeY+lrDu+y0B8JhJz7I/R7yWpEl4KGGW10FSKCSEGq5sXoMtDh9V76ULguRFGTxARx8JCXE9QpBwtZqMqEQVHFw4L8FSPcYniPg0d5p20gMjYODqJ8lp0CkidBhqT5RL4KJh6JSbJ0kjValsVItga1qXcutNJQ2cG4ZgVCZBYKkF4JehkLDZWeZ8UnSjaD8rB5DVzIq4vlF8Ltl3sBVfZzhneQwsn3k4gYnzGsgUj0lP9Ey3CddVIsgYAS9Pyy+ddtQplFCPjrYv+z5zojYdkJG2sFKkv6tnMLlAkzgrMG80SXBKa2JfiJEYgz0KzBl9VYM3+OFRQigX8BAAD//+G7sW0iBQAA",
-	"zh": "H4sIAAAAAAAA/2RUz2sTQRR+b3ea7kzqpoP4Rwgi+OMfEC8e9CCInjwkGjRQU5H0kFtzKFiL1EMs5geSHLQeWpNLYCWW3vwHBA9eFMluunjz6qXMz8xu4RFmvvne9973Zjb/AODqlfu1cr3aYNfYrXL9cZPdbj4qP1uv1Naq7M7GWqNWaTaq7N6LjedP1+tV9qBaqdTqT9gsOpwdD+PeUdrZmrcnJ59HAop7R/P2ZHY8NJv9afzqk96ctNrpZldu+jtpbyry+ztpt5f0t9POm3jrIN6NxHESjQQx3h3Poj5L3o7ng5YAkr2xEkw3u6Jg+rGTRKN4dJAOprOvreTDYbL3JRm+zOgpkpv3HgH2b1JIfhAOcYEBqgjAo4BOFJhACgF4ksDkL2HyNJBhEpGCF+iFDnVEHXIgOB41uEQmfy4z8KmISxSwZDK46YUDovzlsojCuVigS6AGUdolzddZaLYUMFzgPpp16DSOOsWTyp46PSeb5FDki2ZEG6j5Yh2aI1vOnqIQIRSIRaixY91xTbNZHs8KOo40zdpfkcYVxx28RbgB7QIdQZdvfZlEdRH63rhT13VBIZD2XyOF684gPOrYU7qhM/Qwayx0fq1zZsDcWKWUuiQ9OCuITtgGQmMJjZ/ccEOjs2ISQ+c+shfv9uAaGdzIfkJu/PbMd7B4qPZDYTD5+d0vyaerXm9J4yQnFGaieAbxSoAEcHmhvHgbJv76S3DXhXxAyKhcXDKtkHwBRPiPttMz8U5kECebAK6a5peB+qLahZwkcYLCecnZFi2B7M2HX+LpFPL/UA/VkIrwbdUT5UhWNiIcUiRwGgAA///z7ICw6AUAAA==",
-	"ar": "H4sIAAAAAAAA/5JhYGC4sfhmx42NN7turL/ZwXVj082um21cNxtvdt1svLGcmYGBYbs3EwMjAwMzIwMgAAD//6mdQfMuAAAA",
-	"en": "H4sIAAAAAAAA/9S8C3hcV3UovNfaZ2akLTt27NjO0z6EKFhO7CRyMJA2wGg0lsbSzMiakWQngdwz0pF0oplzxJkzsqU4baGBJjzKzeM6gZZnwjtOb0yB4t4ELgktLSQNEO7lFjBcECSBNr4pXAKY0v/ba59zZs9IDv3b/7/f/3/f0p6111577bXfa++zti54BWNXvuLKK83hcr+48pX9jm9PBuLKV5l5r+JUbdF71bhjuXYgeq82S/aC7YrefmvRLNtVe9Krid6hJjplz/i2XRe7xW4zF1hVxxK7A88167v8Xd4ucXVvxGqm+2QsCGNWRVw9Z5netFmaSBfEq2qq5PTOjF2tivRVIn2VWU5TaFftOa8m0pmCmfFqtYbrTFqB47n1VUhm6ZA9Zbsi3Z/LivRAbjhrlrPD2Uwxb44Uh9OFfpHOlUR6uC/UQ6SH96bj2qSHi008378abpZ2pXeJdL40NiTS+bJIj4ylRbo0kuvPjpolr9pQahTsKduvWu6U2bdrfJdIl8oZyVbODheyZZEuX6oCM+O4M42q5ZsTjm9X7brMGRzy/DmRLg8ODYv0RCYj0hMlc8C3XWvKEukDUn9ryZoyp5x64DsVKlFGJn1bopa/aAZ+ox6I9KRtZizZnNPTnj9lVaq2mauTVu0NOT0za7nN+k57ri3S074zSb3huFZGkh2/pH4qjbrZb0/b7qRtSnGleWvSlgmTdpV+FJsd1aYuY64dmAX7kHmdbZEOheKEJAcqyyFrwRbpOYpVzUGv4fuO1dSoujNvTVm+SFctR6Sr05ZIV6uO5cpiq1XZm2ZpwfadGRmfn7Wqjjsn0tUgzK3EBrZU4vJocKZrkxYluJZv1wPLFWl3yrcP1c1LzbTvetUpkXbdkCWQPPJXxSK93MVDs7ZvmyWnJtLz9uGmxvPOtOeKtG/bFUuk/RnP1VrDdy2RrtMcS9cda+eINelMO5PN3HXHUm1P5S1YqtzDqmMOO1Z1sS7SS7avmJaCcJL07VRTWE6svtlGoHVq32ATzZUfvVX05a4rZ4czRbM0nh3NDWTlDO3bVxZ9w0OiLx81J1HDdaGvNGBmS+ViIZc2i2Oir1QYNvPFvtxwVvSVRV/ZLLr2yKxUsa+ciRaTvomS6LPKdjXjiT6r4lQ9d2de9Fmz7qw3TdKtOapFn+XOVFXH9Vm+NWeJPtn2k57osysVRy4MZnpA9Nl20bXbR3CfbVcdWbBtz9dtd0oiS0uiz67KIdknE+fkMN1F+JJNbVGzpxzLHA6mzO39zozTI9Oqjjsza9VEn+3aNds1i4sSrXuumXOnPb9mBc5kXfRVG7ZZmltUSPmQR0hY92rDnrAWVqpYbYQ6Vht2Xeb1KqLP8+VK4czMBqLPawTOGxq2RBZnGnZd9PlW3anGa89AKS/6fHuqIqdPr+hrVOtWIDs0c+mEyKRHc+V0SWQyZZHJDg/nRmTjZrKFcrZQyKWHRSZbGhCZvcMikyvnMiIzfCAekMRZKItMMZ8WmWJxuJwdNtMjJZEplWvegl0VmXJeZMbzxfHssMhMlESGVpNL43VLyIVmvtqQiD/V51n+1F6nPitjzkDVq9hmKdhlDjlBQBzzsqXMCcu3Z71G3TZzvk1rQjh6h52aE9hTktO3p72GT5hj+2bWlQX7IiMHRk3+2OFv1czQjzUZ0O+k5U8pJExX2oVIXQ0GGfUt15S/tA639VlML7q2jAR21dxedma8HhlrzFsznsjYbmD7Zm9eZGS3mhV7acq3ApGZtW2/LjKzTtXeJdfPzKzjWtG0UJFoRrbEzO17fbmy9TTboSVZtqdVjRLN7ZnyQE/IMuY6sbidg547M+e5My2lzDQcuZRkZp35eUeq4TmTtqlGbma24c7MHtLUciqyd7W43FnkzyJN2UzV8j0Vmn2jIlP1GlOvEhmvZg7aNfkr1ys5aTNezWkQxfN1eV5t3nIdy6RGci2zn2YNdcCk47l23RyxfKtatatq440z9NuREJ1ZZpeSVmV+9GPTnuspJm9x1pJTTWOkVgmRUAq1sNx1Y3rcIV5tvmrf5Jl5y21MW5NBw7d9T4rOvqHhzHuqitOe7I46FZLZldsVZgtLihU3D8bcljna8K1mZVsq59RskfHcaavSqFoB4TNeZFHJmGtPSiXlZPbcBdufae6gkUSvTIV7Sge/bZFSpGbv+I5XdfTuqtc8WXCj4kyKTKNRs0RmMVCBsuB2jntTFu1S/bm06M8N5CgYL+ZGRH8hLfpLZTkV+svpjOi3XMfMpIeHRb/lz+kGSL8VWIO0MKixToQoITQV+63G/Kyj5bFlBfrtBa/qiP5Zx7esmYbodwYc0e9YFa/qEZdjVZtZHKvqzYQ/jRULttwT1HQltInJLSX8NdNu4FTlHKE5azl1u24ONBYt2QIhS5/t1xpTVhyPbLk4Ltmb0UHPnWr4lipf9qdc9vudmjfpif6b7KWlRdHvWzO0IymLRvT7TrXq1CdnzaJLW0w2K7LlYZEdk3WVAyJ7nTkyWCxko/06a9UXNdPc3N6XG+4v5AoDpfQAbRnmy1/+qh7FNuH51SmRnfRkUerHzC450lQ3h+26F8x6IquMvmzVrgX2zpy7YNcDka06dUtka64zvSiytXnfrlt1s6/hztm+GpDZmhyItKg77owpzR6RdZ2ZmrWztFgP7JrIkuWTpcWbwitKzvyMHP4qVrar3owzKbJvaFiB3fDjzs36jhzrWd+ZrMvtuzAustIysA+LbDDreE3GwKlbVSsQ2YbvOdEm7zVHlqS7lsg26nMWWZLZBStw6iJ7WNao7om96YOFiFsfQHtzhaH4/FHKjo7nMmJvsZA9IMNcWvbL3lI+LmdvKV/OiL2Wn7XqZTmF9lq+Z9ebE3KvVQ/aJuxeu9KsyV6n4nhmwfOnnEkS7rh121+wxV7HrwfRzrO3ah+QtdjrzcyIvZ4fzMp+3etbk0T1bTvmlHgsvEFbqeq3gdDOFAPZcraUFgO5gdLQwSjfwHCxLy1tCMKy5eJYZlDqMzBcLKRLJTGQH8mUxEAx5i+OZE3V33XT2lXfJQbKaTFg1eREG7BqteaONGAF9iFrsX2qDlhL875Xiw+LAzYNrAHbtX1nUpUTlSAGbI92rwGnIkfIQNUTtMzQ1nud5bg9Itxi41KbUblKS9s4pI3a1uRsiCuhhFYXzfTUgtzEp2iWzviW3Kpje2s4mAo564Hlm3KEzUciA6VTRcWj8gNp4fTtGje3j+8sS7ww3CMGvKgFvaq2fA60rNoDXkAHrro8ygRiwLdqtu2SBSYGfNt2C2IgmJI72Rsa9my0J0qSlFC3gpDQsNyZw3KWtrS9mfHEQMNxaVdTKxnlUxaetOlkbMa35mdNtSEvisH0waIYzO0eMNOTk9J4HBwqi8FioX9MjpnB4miulC1Is2GwNCwGy2Z2tCiPzYN06hm0ak410KbnoOVOLcpw1rLEoFezY2tOxYrT086kTbiyMaXVL6RxZA7JoM/3LGVSR2fvQS+eLIP+ghXU55x4ZA02gslZFTp1z21iZr9vO2a6IQ/llsj1RxJy/RmRy5sZz603qoFsv9ySVbGrlsjld4tcPiNyI6a6gqGhnBvZWXSriyvXE5EbyR4QuZHc3oPmcC6fK2f7RW5kqChyI7LZciPje0RuZNpz4wNubnRnPpMTuXLG3Gu5nlcXufJIRuTGzVG7Pu+5dVvkJv3mwVTkpmxL5KYPNweqZk7lalbNHGzU647tmoNeddEszfpys8m5lyr7MedOeZMtdm3OnWrUNYMqmLKEPEeRPZBzZ2zXadTMYiOoew1/UjZOyfYXnEk7Llla6Tm3HljzYRGBXZUHa9r7tHICWy5gzoJt0rZpVcN+PmOKOVCrDLYkT4XJtWbGSataDTE7ROgEGCa73pRC6guL1tLOXhUJYqUWbL+ujLeS5QaWuVc1gazRIUfkPNcMjZkcGfyyLN96g1yUcr4j202o+xqSRttUw7XEvnx/Tuwr5gpiX1nsKw+LfWOFg+PZ0RId4fdZhw5ZVbHPDuSyvs9zXDN7eN72Hbqt6bOrM9ISH9oZbf5yKRrq78+JoaFyRjZo3amJoZGCGBopZ8RQWQxZVadmBWKITOQhqeYVadliYsheXPTEUNUSQ1614pi5TFYMeb49J8NFj47yQ42q5YqhxpK9aA45FV8uTA1fnfiHFp0Fuf6J4QFz7DIxnMtnxXCxPFaS43m4eOAgHUP7xLBlznv1wI5t3WFqLS+QiOcuHhbDVnMXHLYrlm+FP8osa54oh+0Z252SLTxsz3h1MWzXPLIwCFlwJOKJYadi+3KLH3YqZBBPup40MyyiLDat6ktl2iwlLqok1xLDzvS03bwuHXacOdt17QXHt+qBFF6rSKaaE9DJedhx5zRmd04uuW22P02D4artTFm7ZLcOS6rcOei6dHhxMj5XSrzWjkbXovmdpXK6LPJXiXy6nB0tpNv30Xz6gPwbGS0OjGZLIp8tinx2NDM2etDMFShHOVcspEU+W5KGYl9utF/dcOazZbVI53MinzOj43o0m0U+V8iFvZnPFZvXBvnICJArypRcAKKekobqRLaP7qjzxUL5oDk2ZCrLQi5/ijZYNMvZzGChOFwcOBgue+b2TLqQK5WzmR6RHykLaVTly5msyJfzwyJfLsg/M2PVbN/zXBmR1JL8M7f3pUfTpcHWZukReUtu4LO2JbHAkSeYvDWzaPnxtpC3HFdapeb2kmP7vhXXUGauWoecqId36rfVdPWULw9LJrd5d5i3ZGnaFpe3fCdwau0nFHk4DlzbN7fnMyNShu9N6nnqIm/V854c1Xmrroj1uUXPE3krkIM+bzV8RyELjshbh526oMWRaPaMtVc2kD0jT3T0G9iTsyJvV50gHnB5u163Zuw+x5/ScbNvPIpWKEqjxA5sz5c/dESUEvZGyNh19DNhV6LfeJbFQ8Kr0PWG/J2jsGo5C3aIuYqhaptpP6ArrTC690BzV5GrXUgesRYjdNyZc9yZ+hX7cvm4WvQzZQWWWZrfZS6ZntxRiSqXNYXUw98glKT2KEIV5Q0NJ5iVoilGrepVnENhHQ/ZlfiDSDWu47R1SM7s7aWyHMBedYrWSolEy1/ec63J5oKX97wFkffkipz3gsATeW/Bbj34y0EwSaUvOFUze9hRXE5VLiYSoWVYIqSjNFQqi4GtsEl1s6AisvZ1hfr2jOO50i72LbfuBM37q3y53CPyDdcR+UWz0KhVbF/kF0n0ox+bojIKu/K7+naVdolCeigtbb0CLWWF/sywKGTL2XJZu5c2t6dzhXw5upPukRz54mjWHBgtjtE9ZyF7oCx3jUIu3zdW2psbz6r9vVAsiUJxoigKpfLIsCiUy2a/N+nVPFGw5mZJEcuP+rxgBab867OcaDmSNNnGBZpxzSOBPgvTjWDW8+UgUFw0QBR6yJmym+awKNjz2qmiYAc7ikQNMipKt3u+15iXkejOq6Bmi55thg4KTRs/Zpx1grr8pUuA0IgzvWmzPGurYzxNAC0hiBJEwT4Ur2YF+3AwYLvR0nyF2XqpW/Cm7Jp1WBS8OcdSofbtS7Ok60IeRs3wasos5dIiPJ1Giqsle3vaiW05c6cqdsir9RC3HKIFz5/2qs1tsuD5tBQVvAWLAupJChp2GNPuAgoNv24FovDoH37hkzVrarW7Q1GMjrTFXlGkzUoe20WxUNy7t3mIT5dEcaS8s5ARxVJWFMv0p05rxbHyaDafHTXjrJO25ZpyR1doXRQdUaypbznFmuuEv65tiaJbnJ42y49+rProx6hD06UxUVQfn4pu1anZ8meRAnN72a5OemYx1yOKrjc9HdtFRXfBWxRFz7enPC/6DUdUcd521V5QnK+byva2ffX9w9olivNBs1t00yNObtRF0bfcGTv8MdPlCFNGloyFFyehTeTIdj28OGO7rxTFJTkDRrLFkeGs7NKRoRFzxKvW5xxpnbmObQ55Vfsm71B43BiRR5eRYn+abgTM7aW5xYId9IiRYqksRso5cySdye3NZdJipDwuwg9onqk+TJR2jZAMa3Ju0g7o9n7EqlqNjF1VSFkhdj1wXPuM1/8jll+1a2IknsAjtjvTcFyzlMuLEXs+/OIzYvvT9mTQkJx+XS4TYsRZ8GgGyx6sOoEtRqrWohipNuoUXD0WTa7wrDbi2zV5FirYQXivF644Z04xS6PDcWoxxka9hjxmtsfVejji23SuGPGdml1WiJwvZrSRNT9dy6TDiteb8e26M908WI/4XmhkjvieG3hmbj4cKCO+d9ipNepifyY7PCz206q+/0A8Ffdb1aruE7C/IU9Gh+VvEPi2GBWj2XRmUIxmJ3I04zLpQn+ukB7PpcWo5VTNjOfPe+FAK5QmxKg11whst73rRq2aYw7bC4ti1K7InoiXpFF7asFz6hKpWr7jmekBczt9YpQbbY8k04dlc5/jiVF7vlGpOmRfy7kzatedqmO7gRh1Zmj/GPUqjhj16rOWK0a9RUtq2PADoS7yV9kutCN1Sa4VopS+biht1q2lOW/BMufobGP5l8txL0qZsihly+lRUdpLf1eMOtO2KOXSZiFb7ksPlxVuy12rGkSRJk6DMFqbRWmoTJ8450Qpnx4t042MKOVzw1lRypfMEd9bcKZsnxpZUgZzA4MT6eZ9Qylfkq22KDfsUnF4rFA0+zKyG0sjuUJf8UCIjubGSgpt3m6W5HGyVCqTrtrH5VJ5QJTKGt94tlAaMoeKhdJYPlsoy3OAKE1k+/cWC9lCtkx5rAqZpiVr2vLJJCxZs3Y1Q4isueXbco2h2SfDulcVpYrtVyx3bmdckq2S7VpszJZsnwwY+qTVI0Kz0Zyy6+aIPIHWTTtortL6eFOsjlePb+Wbn49KdiAt87iMBXkOnPeq8U5Rsg9ZrtkucZY+OUW56FhB+jrVOaqcU2s2mlM75HlTouS4M3JWl+YW5V90vVCaI+OrVPUWrDkzGtOmnE+HrMW6uX2glN852iNKNcunm2eJBCqMFaBIXKCMTfu2K0o1SvVkI1PfeJOOPWWRj4ntTzpW1Vw0p+x4x3n0D79wzKIDKcmJuPdaVatiV6vhRFMn3pI3HcguI8SWxirNG7LI52Ry1auFx6Q5Elazqk5zppc8ugUu0YeLkudbqkRFC+zqTMNRSM0SJa9RNUesIHDcuhxZ89YkXWmW5i1/TpTmbapJ2/mr6M9YrrOkrudK87YtjRVzOzVOjyjN+44biFJg+YONCv3KLq6LEn2lo6vPcB0IvIZvylOebPvGlOpn9Rtu36VGLVqDSg037pSGm/FqTe+hkYbtB5456kx6Mq1iW5Oz7StjqeH6Tl3m9Wq2a447/oK9YPtzczKLb1Ngbh9o2L5btxd7Wnx2opMY8UhTkpCS1whmzXRQtdzAmVyN1pqxPS2SEw+uBdsy+xzyxQhv7UXpkLXkmPL0HmKhp4DMe8iZpsuu0iGnTsfc0qLs0cWaFU+AxRp9GolHRmvcHCkfFKVFaaQ6C6K06KsTcOnRYzXHdUXpiw+bO82cO+1b9cBvzAUN3xJlU3aoKF8myjt7RTmyHiPE3B7OtnBw9jRT0uUmXp5tOHVRTo+OinL/XlHOCvLJUaE5nE33Z0dLdAci1wqT7uupJ9JXlIhUSPcNZ0cVmi3Tb6k8mu5Pq5FVzg7nBopFOkPttatVOUV7JHXYzJZojSaftAgdzZdEeWBYlHMDRVHO5UU5V8rn5Fm+nFeueeV8WZQL0fgrF/KiXBwoZrLDolwsx+SRAVEeTY9nh2mJGyrm82OF3BBdHoly2dzfsMjWCG2fMk3MsuWY5YY7IxG5HEayrKqzaMmfOfknytLipIkiMU+GS/SVPxo9Zas+q7yF5MFHRsh1R84CUZbn+fAAWKZzddmukqObZW6fcPyqXY9OKz0yyVrwDlPL2NWIe9Ibd6pVa8aWEdvspZ8wjH3QZGxIHpUkotS2q/aE5aukSWmkKsyuRr/mXqvuicg0uurKGM1YtYo35VgxQTlamiXZSHLNaOYaduzJ2cB264HtuDG1YNWcipa/4DTsODJuuQ0raNBMCmm9MbJHbvRhZHdz+oSUukkeH2I1TwyPjs2rpOStw6GpuEqitA3GXE9jsF0r+jW358cLWeoXe9qbbNRF7GRhmf3SzmmEn5U1elFVZloe/Yggxcu/TMMKfC9MVEn5Rz+54FRtJXcuVH9Onsaq3oLtOjfZTYpfcW6yTGvXlCqtZk2qzq45M9JMJitZxuW6RL+eH/2qEXZY7hkRRR83dWdGJdRDDehuhpCG+g28uejIpy/sMmnBqTuRZwB91lCtd4juoMp2ddrzSZ+ZBiU4YWhm5UnITJdUVO6YvkoKm6H5E89LioXjd86r1RVWo2yq0+qBErJE+tfVLLfrk15TRjNiZkZlPOiVu54oz/q2LcqOnOBOzWse5ctOveaokJYlbz6+XiiOibJXt0TZa8jO8C23Tst42bcW7Ko8spV9J+9V5E/FjouNvjNJMpXgN2Tigi0xdewrN1zbVNO44Tp1p7lVlRt+vMSL+MNGubFgVRtNpkNO1fFiJ7doL4yOgGJsZ4ZORZ47Y4mxoeYXSTFGFxFibCQj/9R52SK0dMgJlsKD2lh5WIyVS/LPzKRH07m+bEmMkSZjsvyx6emXiDG6ch2ruY41K8hPzG9eMI+5jhygY+pOYmypYs9FdwrjuRExnhtPi/FiUYwXD8i/vmIha5bSYrw8Gk4aMW45rjU3Gx/tMvtKGTFuTway1PDX7NddljNL9uRs0xilvWB7vuFOOV5PnCNsodZo0/VtdfYr+m3yqGzPXYui5BM4bruBXfXiuTdue64Yp50/+l4y7kwGnu9YLpnKZtm3JufEuDNFjrvjjh240bcWGZFNF/7SFY0tx23Ft9ypnpgeaeS44QfNccd1xLgzL8Ydf8Zxw5/w42UUiTKRO2yteQwfdwKZecGiQJJ35sslilANnQVPjHtTKqJ+I48dc/tIsNhDq37sshUhpvr+ZVfVrX1EvkL7thTT4jkyTo6EsbODigf2pETmzbRrVRfrDtm7Ytw7XFFZ6JdGkrdI2pVGRocpMmP7LScpOWHGvaVowI57S7R6jQe+2We5U5aZdidnLXM7TecetQ3IxNU+pE2kR0u5gjlYHO7PFQbk/j7RV4paeSKXERO5vUVFzxX6xUQxLyYs35kSE1ZgudIaIWTRzAaR79CEtWBP+nY9EBN2peK4M/LXlgF5HudGQhfLkFCPF4EJW62/sqrz0i4302LCrgdmaJVEFZ5wFEFMONXwYnjCcadkQJe9B4bFgeIBcUB1xkFxsJgXB8vD4iB5MYd1O+jUzJJj1cRBT66sB71GlOAt1Syz7NTsoOG7dbM+71fNnQfLZXGwUfECT1wXibgunZcr0nXZ4khMshyXAnNfUVynrjevs715cZ3juo4lrnN8zxXXee6MsKrOgrCqgVPxDgvrDVVRCf21TasiKqHLdbXumduDqckeUQlH26Tl22a9ecM8Z5lUz51OICYrFTWxxeSsU3VCtHrYdCPj3qqISa92tQyUF2f0rWWqIewd4UcqYYe1satVZ77umfX45s6m8SzHct0S045fo89h045fJxtnumorv/zpRrVKrDPOTH1u0bTm62ImdvIRs9bOBbtqOpHT3qztexWrbgvHzKQLNw6U8sLJ2G7g24fD9UeOQCefvU7QHYTl1uRRVOKu58/YElPc1bqn2suZ1PV24mcKzpm8HMyZWmVWOPMu3coLZ17ZD8LxnSmnUdOE3WQt0pfnufAtQlV9Xo+asupM29f00A/tOzTqw96rLk5aOh5+k56yqUJmNZgStWukzJp1OLCru6bmBNkVNcecDL8j16PvyDXHdeT4qzlB4JnWjKiF39EiRWqeGyyajTlTtbxGDz9y1YK6ObWLvubVaPeIVKst+uFeJFw7mPb8utlwnWnHnjJrNEbkrHbtw8GMHfltmNWpwAyusKSeUxXP8qempdEfMskqudJi2u5a9ZvoxnZOWWxzFK/XFt0pZ2bWDnqE53rT09b8vPDCy3tPXbXTMJ8MxLxXnbd93xNvqNQ8374iY02puwTfnjKLhSz9ykFYV5+fD0Uf2OtOLWw8UW/Mq1U9Gkp1ec6uhOfsuInrC74ZaDf45nZp3JrhV0BnwQkWe0QwNSkCdT6Sv/YuX7ZO9DFT0aRFEf6GIzagNZvC+MNnNK0luVGnSRPMLsoZFjgDRRGQpVdZEIHvzM9XbTOQm7BpK4+5huvslM28YFUtseDME+4seLONilgId5lDlueJRa9Rt22xJFepvkHx6NHRbOiO8OhRf3FmxqlP32T59TlnPnB+WmXsHmOXcQoOcON77KzTgL2A8+yKb3DDwLd1LnPYCLCE/Ep+OEDYgPACOy9AvoTGR/hLdjG4H/nnGOxi+Ao4v8SAcVjCa5Zh05dlLvgydHiIuyTDBXsY/AbxXn7ZLuCXYcfnAfYw2IpAyPQGEE9ixxa45Oucf52/fAnP7eNGFqCPwx6W/AEHgAs+z+Aqft7b+Y73MXgfg2Xo+D6H73NYBngfw+9zXAb4PucR5c2wDvqXOS7zxG6Ouzns5pPL/F3wQcQlnH8E4RGEI8jfxOCIRDrexPAITiwhLvNLXw31m7n4CWxf5kOf4fhdvr4LsQuhC1MXIVyEeBH+KbzA4VoOyxyv5fgCh072ZliWcVnzJYQGx4aMfo67BhBsmTXErPHSqnEH1AzDNXbWDKjJBF4zsGa8nPLhXXj+Xfh7Wzhs4d0n0TgI8DMGH2HJEwz+jkE3Jj8BvBvfCN3IT7C3J9/IL/4lGM8w/h72JriD/xROIZ5CGITzlzmkWILLFrgXUhxSfPSPEU+ys+/nsIV1bGGwhcFJBvdzuJ/jFnblKYYXwUW/RPgPDH6KyVMMp3mCWpCfYrjMN3wXO36P4e8x+D0mbN5dQSTo+DjHj/MNb+JdWyHxGoTX4G3JNIOPAnwU+DyDeQYHOKTZ1Q8C3oBQZJBgqXlmfBRApX4UbplnSDge4DDPNp+G5GlYe4DL6AF+0dOQeBrWnIan4DFMfAjgMYQPATwEz8LXuPE1jl/jsBM2/zcOOnwjQgyU8N84fI0rBL/OgaBxjD0K1xoSLpfA+wzoM+BqCU/DRzn/GHXoRzl8jN8G+zkcR6iwS85GuNCAX3L4LIebORgcboW3wKVMwmcQNgJeLsenhI0An8HrtyBsIfpPmBz2PyGYAf97AN8D/B7AToSbuXiaXfl5DgHyzcbmAPceZG+DuwAOAXwK4S644NcAoxxej4lRfs7v4iWPcHyE8wTetvEiY/spdu+6DzPYiMaHGXyY/cn6U+z7+BL2vcRRljjFxk4xfop9gJ9giSX8G+MUg4sNfrHxyw1reHGzAcvAhxF/B3AZrr0D4Hkwngd8HvgdkHweYBf+BD/CQcFXQIanpZbwEf4zuIbBoxCGX5DwbrgfY0hQ6J0LOA7GvdHM2MR6PslAA9SjPwcdUI++0AKo4W9WopMsKiMGxnW49l/k7Ie3AX6QQZ69dCuCAtVbyxBn3BYnrZYKL546AjACvI2+m714rsRvo3AXwcXEEl5OiAS9pjdyjOkrkiS8aOrlS3jFEhpbMRnT/1uk6h7WhBdNHcjwzq3YleEQAd+KEiHOa+NatzUCIZuIgq8PWwkjtlekgN/HPgo/xMQuBvfRfnIfQgAvi1XZFarVs8z5a6VE3MPAN+Bc2LzMDVUGUXAZPoPrGC4hfz3H1+LBlyP8EODlMvNrt3N+N0tRmNjOgSBxN4O7mRlF8W6GRIG72fUP8447GdzJHG0jW/t5gNXg0q14yVkAX2H4S7mjwTI8KFWmDQyX0DjbCOuxiyWjxrzhYoQ/4woSF+N/WEJADmXo2cRnNnMgwAiBzTz/nxj8pzDvaBcHgsJmA2iGy1YZQths8GXI/a5UIrEMd8Lvc+O1mLqSGVeyzisZXMnWnkb4fQ6/QjhN8NoQxGmEX2HHrxDzcHgPg28gvI1f8w0EBYqyh+HbuMJH1Rr7PwGepL74K5QhKYe+Ad8GKHJVfwnnUtJhdv0eBp/k+HqA86QcVH23i/V+DnZ8DuBzwP8XwudAjmBCZvIG5I1QyKUIlyLuhr9U/b3hHEMhL10i/d7JE7ewZyCD+HpuMAAGo1N80/8EmCLTIc3P/0e4FX9Gk81m8DOYvoDDEwgXcLiQP9Wci99v6p14O99MCCeTowU0tvakWNTbuYTvt0NC0fUkir5kGVJR3s3f4tlYQgw/C3N9tfNcDqsBPwP9qnWwbJxiFzyM/GGEhxG/BfAtgFMMH8bUKQanGH8YLzcBzgBXUbjmPfyDyYQBy5w/xeAp9h9T9wH8kMN9IGEHl/ibCXZGxJWpP6RURf8hxzdz2EF2gEf0ndIgkMwK3hxlUTLf3MwYhj8ktvtAJv0t3xRH7wN4vAl/kPyvHNah8UHsWOLrljisgG92KJPxETTIXoRH8DxFOQeMI4hExCOhWRnCmxjsANgBIX2HIeFNDN7EXkbh1Y8DPg54AvjjAF/mKWJWwI8gmBxMjlG5EnaymOGhJFmb/LM0YLtRGirdEfJJhG2ct9G7EdcZK4lhI6wzQttVT1XR5+SK2Z7UjZ1xcTEshVHspn2kG7kqV4WRBIyFL1FSLHmdAd34LL4a8K/AfgXiKxBegectc3wF8qeg71Y0lpAXuN/BEBFupDyXGXA3H6RSDVX2Mk9NIETApcZN4N28cC4DMs1nrobEbwCvhrXL3Ogy+DK/8WGAP6Vt6ZsMv8l6/ojDtxn8EcdvMzTAMOB2+B6H9Qxu5n9g/D2Dv2cPGhMc5lDC9UwBTvDPwG0cCWCCgwGgkNv45tsoOiGjg3NgLGHqwrAlUhcgXoBwAe6ZZx2f4dIe/gxPfJe/7LuczzP8LofvcviMNI8lPs/O32t0PA1dX+EP48t5osL/Apb52gFZC/jPAP8ZEgPGhg9w+AOEAQPeCRDRJSzzaz/H4XNkvt7M4VAISJTrhwx+M+cNLltpyICb+b46wIJh1AHq8LycfxsYbGD8AOfTANOwbT2DBd5JIS7wJIVre7ixnsm2OszhMD94HQMFN4CEIUPBl+O5zL/JIYbH4Sl8O+y+lvNOZizz5DL/iHgc+H+CZINDkl3zjwxWg6+mtvKOszmsCls5ns1xK088DvDfMQbUcAlb+ZngTrwRN+3g78R/xs5JDt3At3KZY5K/0HmTIRwwzmZrLudrbsaum/Hejnu4cSPyGxEp7LpRjliJR7OFL0l6x42Iv2LwK2nD4K/YXXzWAB2qTcC2JA2w2sL5r831f1/gS/5t0joYrIR9pN6LaP7bkjr/TXnX/NtKPEOn/JZc0eG/jc7/HbX+P5NkUJg6E//rjK64am6LHLykSW/p7kv+v1K1f1V/KeUvMRTg64yOf4fYf9uQ23wGtlDavibgvv8fjKh/T9Nx1QuXvFg1zf+DWq2jNpeNn5WAtGC28SerBkyFS99K3fDfUfrDiYMcb+dwO4eDFCrkFglrb+f8dp64nfNbOD8o6XiQbznIYQLwID9t0KVjPMwlUjMgZ6hbyu01A3PhfSR0R/OgH9GVFNQywtbw8rIZRpLnasaTilsVEAO4IRFqRoK411DIa7J44bbOvkg0atJXwhf5XTi6gyfJhNh2V2RV3oWgweYdHO5GOEO4+0VTNy3hi6XeTakrYQdv0SE+sNyF8EbJsEPjhLsRKbWTlB9VbBSiXtwO/hbcwTt3SBMXuiClJMbG9w4uTeIukHAjfiPVYBABJjgQvOZeDjF0Y/JeDm8hUCa5or8lZOCK8hYy2CPO6W4UKkrHQomcYI+H54sTTEI3vvoTkPoEnHWCwQnW9QmAFTCgMp9gGHA4yvAoA0LWnIO/MwDypPAsgwFIfgwSj+OQOoCeYqhyKXg1NqE16TMpKrjzBEMBQLgOsjYnGAgIgZRuZ4uSZBiflFRSjHdjnJqgslIxXQ8jNoyyY5QLBRh0RsJYuFJPy/sz87P4d4lfcP4LLsX8givgSoYAJKLMpJJi3UkG6ng3GpQaIlEN+AlmRNIUJ+/GhDq7CXgI/4KviY5wxgnW0Y2byuxDHf+bYcnAS7CDwmcS18nayOKi4YXX4egYwBgghfBTFsOREyxxgn0RvsAl3CFb5gHVdbIb3yiHVoKitUEGg6y9A4g4cIqlPg4y15+zs0+xm55hqWfY5vcwOAPwXwI8w+DDXEafYfBLUMjmXwKcAfgDHAhwjcEf4H/ZeQeHRQwhnnZ3RLD8/0RqjNyhpf4LpGK2+PSgMxAkVa5FeaJfmQqLyHXJ728mfUL8MQcFNQghZSjAKKljOwOCxHa2NiJizE9ZNsS5/gmBwIiQ5CzoxuCdqVN4wyDAIMApbANUdC01RThflYeiyUFIKE71+Ws1Nq6EaMI58adaRf0t3MMkPMfhHGrq5/i+ZYnzFONfgh93ZTk8i/Aspp4EfBYTTwISJJ5FeBLgWURKxVTIBoTwKBVi5EmQSRQifZ7DJ6FJVBmVEE2simKcnSjGk4BZyvUkQJZjliez3HgSklHpTYF60bFAhcfhk8Ajfv4kqAq2CHkWua5kJHBtLFAH0kpXHlqboiVJkwbP4nOG+kgZAZ6kD5YKWpNgRZJixi3hB064n4stK3IR8C3sHWHkfo5t0unTKG5ZrWD6jtosW+WNhOAWxk8yIyrbOMmMOPvJ1uyKRxcbQbsyJ1epJ8T13MJQZVHSVNjPm4XqWbZEWfQ2OhnWtElR2dVH4wg6T7JztKjk0WEL62rTU2e+n+fexeEdBE8RvEvCbfBx3DSGfAzfDN9hCozvMON9CAQ8IsJ32KdANwPoYyZcbOBb5baB32KpU2yENhI8xmE1SLRaC9N/j0DwHhU31Ea0zDcvcx7dKKrwlW9CeBNuux273oqv/y7Cd/FW/nuhGPVdHE6xZKt2QzMIM9ioIKwGWMG/gv/O4TuyhCdgK+BWOP81uO01eOcwffuGA5wrZIDhAW7QN3GMvoy3METQ1RpVzL+G06ADp3BNK1EBRqlwGjoIX0/f4+E0rF3BzCM2v7VUHV5zgEMakmn49Jk4JDwNqacBngZ4P2x4Gs5V+NOAT4NJ4Q6qKEbV7TnAsVWPx9a+gPwaCa+/BuEahBcIPgASXpCU11+DSEl4DX4RHsMNH4LPbN4JoMMfSHgLHoBfyv3gA0zCfzBkqDaVDzD4Nu7gmOQIChSdY5KY+SlMnELkxMkRKaMKQ+YPsLuMhxgoeJDhMQYaJB+SlOSDDIlBUCj5j0lmCVFGOMbwwRAJQSUd05gflDx4LKIT5e6zPsuwCDDH4Hr6XFcE+DTCHPsG/C+AW9C4hN13/nHECoMKO/s4Jo8jHkcgSESIgt17GCzzTsI37pfzxehlHUfg4iOw/jgaexgcx47juOkIJI9jB13R82WePAL8CMAR2HAENtwqh/5a4pSwn8N+bhwBQaWnoqIvWuYdVFZymSf3MOhliV5m0PdEvochgdyt97Cvrr2c8csZELzzbPKx0AEJzv0edCjfi9UYeICgwesCxFaKgm3v5fC7rAXey2N4qzyLnuCwxXjDJRwu4bAMBiH7FgEfAKgijDKgD7SfgPORn4+wCYFCJKonDHgKcRmMtcbrb0K4CZMBriVEgq4LUd4DDeRvw+s3AvYYuBGgxwDCb3gHQqcBH2RgA10zcxwGeA8M/gVCjcHneQhHDAk1BjV27ud5+gYDlzm/wTj7BiNxg9F1g2FEHTg1wnMP88TD/LyHOd8J2IHwMMeHOTzM4R8whJ0AHShB4RR9svP7AEMcr+OvPARwCH4Jgxx+DZ2jPPFrOG+Qd/wa8NeAoxxGOcwyY5bBKH/1azjo8AzE0DPBtl4AxgGWnGAwwYybWOJ38GU3sZf/GODHwJ/gW34MPa9k+BO88HqEjwB/JYPzGCQN+A2uvR4T58mkrvPYpyGBqUf4WuWQlED4NJfwCMcEwn9EeITf8wjMsBiQQkOjKEjOsM4ZBhcZJ/E4gIJT8nh79np+7u/jL9YyI3GKbTzK3spPsTvWHGWCNiN+iiVuY2uOMn6UffWyK+AZfkqmdMZnolPs8qPs7/Y9zyCC5PPsuXP/icE/MXEZg5ex7svYP98Gb2FwlG08Cl/vIsefLyb2wPlvxs5XGECAn2JA8PXOlzBYZLjInn3VUbaFSkgeZWujXeuryaNSEpBGCvlLlbjxFLsf38rgrewn6+/jq5ycTzC4j8NJLsP7ON7HUyfpVHqSiCcY3sfDg+4Jxk+wpKJEgK2n8SZ+MsoVIcZ93FjCOKNMUl8p7+OP7SSTAC42XnaxMXKUpd7F/iSh6hFVMLYAMKpfu1VxahVmiG4iwraJKBuonfAMcr595Qn2L3fALsRdyCkEBc9DCLsQ7gAg36sQUQx3QJP+vMagZ9Tz7sIUUXgsfLWyEkQxtIxSMVXo82HpGEt+vqnVd/e+H4Hg/q4lPJvxv163FUUEH+m5j8F9DH4ACnakACJIqiQFivgDQMWp0+PsxPPtBBr/E74Mf3tu7Avymsi/pwdv+GdQvjRwEBVdhnsYnMWauJZL0s9i0MFHlYuN8gp6TeQeRKmc6Pga+LNNPwTQodWvTE/90JthEvifAHKjI3aIOStyjLoEmvCdMPV9636KyTx/Ytcj+JfKjclQTid6IT+DECJ/lBaKzqMy6vgKCZ/YX+JA8PF74WHsfBifH5hmyYQBEfRon9BjMDWGNrgttw/g0wD3ATcZRPAdeA9uNPHrr74C17yab3q8xcvixeCvuAL8B3K4eB/A+wAvBowZboNUDeHdBBdD8I8cGeJ6+MzEDlDuGPAmxndA6H/xJrZtJyN3CfK52MlAd6Mgr40WaNNG5YrztjJ/Az8F0ArnfQqaXh/v4yFEqfvJ2aMJmpsH7IALief+1DmgK4hHUHl+pB5B+BKDEwAnAClswiMY410x8b0RcTPCRibzfol1RbrzWDkCvhkl29dZ4r/AYlyBVVsoBqVeFDXb2lUJ3AG9Sgcfu+NmOgd+9upuTCxzdwnDpZpaW/bxZzV4HOB/MIyjrSMSyN8lsYTpJVQ8nY+Ho2TskwifpGvCTzbhrHUGrDMSr2WzxIx/yrAbx55jsBr8DD5Ee8rbEd+O3Z8FTnn2x9ePMawzztWi2I3wOn7xegPWy8JCWG/AZgjhnbh1ndHxJ0wKX2fAnzBcZ2DEmYiQl35MmsiL5xvKzDXWadI02EHOMsluvPwMDFct84/BW3AbtfF95ivwL3CZG08BEDyVuBVTt+Lad0HqXfBI52OAjwF0sB/zbs5dABeeBxfw3TzxewZowCcQKVmehglR1uAm0lV1zqu7eaJbHpRv6ebJbn5s/W8gdbXswY90fZvht9maPyLvFwJuABrwq67fZ7Cepd7M+NfgJ+f+Z/6Ja8g3RsEa5VIzhxuUe80chkkKb3W7aVIIkJL+9KzbeNdtnN/GlUcORJAkl+9/TlEbqe8u74I5gDlACmEOnun6ijyVwlc41FmIqFPpV/iPr7oS4Ev8H9cuGBDDRoIoihuNxzdOQ2KeA3nJ4DS8P3EpNxY4LHA4gH947jc5nM/gm7xjBzfoW84P3wKdsHa19fH5/Vt5r+aKcsXZ3N3KkXDcytedzc/RUi/wGHgs9Tcc/obvPs6B4O5VfVr4I+zj55BrSvMb1Y2hb9Xrb8SLdmjOdq1wcevE/Gy7iGX+4dRlHGLQ9jaMiHhZu8P2Jp8bviSiz0HBWgZr2X1rJzl8g8WQnOTwc/ap6cs5ECQp/O8jpPt/BeVRE8O3OHyrqfCWezgQdMQM91DSPVHd7+Fty07stBMy/IphnDEGtZot83Bx05PIvYfrVV0m/mXewhYJDyEuVPkJxlG9RIV8icNzoIviKunGSI3liL+1Xp26hhrgjZr+y+2poetdDK2pa1UtyNlJIq0djHFZS6HjU5deU82PcO0yR72DYmhz8dcz3sMveQ5et8y50lBljFs1amRjmeM9EkI2XVu9lzXAuF9iToIOZb79Sh4+cGOzE5tu9DdqSr4bmhWMhVBThGGkQ4emA7Y1Dmkeduhyc7aqIddxT1hr1GsdaxIPb61/8Z7IcTNu3rhond42/JZ5c6YogeqVQtzpbbn0ga2GkOp3Ndj0XtYqazwHiSVsyRXDu0EJWX8jJjTJqaX2IRcOPGqijqiynTfiAxe7xqNQMGCQYLsBVxByyIAOhlXj0WTV+B/JyHkp/Hal+ScsG5HfG6cQZ40kUS4jpJQ2YIb8h8YiT5HInQg62CESsenKUG7HoIFZg19iJGZlmJo1kPxdpMDBKO9UFE6RF9oa9ruucVbWSB8w3p6oGtuzK1xFAgJH6nGFa3wEZ8mnaKkdcNb4UexYs3uF955RNboil5QLqoYgvFM5h9kS338z5Z01mj5ekZNcVweDOQN6SXMlZIwa5ArjownbON1sEg1CltfKtuG9VIGxJqyZNTovoRJ6NTUvMSAdSUgbMGzAjUYyLRsVdNALqhrvTCnqlNaqMWQNqK8ganq8iINOV9wIbXkPSIFbZw14tVTx/NdouWbDPpUlaqWAaXTtNkDBLDXK9payNl1j4BuMjkViuErypHqNzrTR1WvASyKxU62g2k6ntFWhN+rB61fkbVBqG7/GIHs8qlFKS8Kq5j0ZeYteEzXOy2aNZ6/5MsItHL6MeAv5Mx3k+GX8tMJiwIcZ/C3yryF8DZXrE94Suj4p6JoAiKAtif+cw88Jv70F1lwFQIC3h35V2MqATwAouIVzh7epJAV+GNv11MrFf4DOWzj8A+CrjJD4aobnMCn8Fk1IDI/LcE2r8nhLpMZEK7SWu6lVc5nxYFjK1188Z1ujtJW0PlblNwzjLFG7SMpV0KYxHOSc3M8kojhvX1GKpmhb3haIO4CY/7Y9WYdX8nNa67n+TwE0uOwW/tNd2jKeopAfNJKa15mEyQiIkoyiW9q81DwDD4beaF2ejHZSiLVIcuTYxl1yquuOXOu6NR871/gAv86AnRKujrzwQHnR1YyumpHSnN46iG2Ta8DvROC2g+KXReYktLvWuVLmKh51btMtr9kQMZDGmzR+VcqGyEVPeReeVTM6CNm2mhwkhVW9klQRST9IonauUpEY1tc0j0C3XWcVKv/CPzOnDSAwHLl7DHQ3Gx5zYYsoOEgizo4aeiOFu1drmrgnztRq//5UoXs+vkzjae3jC/U2XdnEXgucHVd2RdHnuIb9+tABM1Ezdu8Mxx/s/C09ocCoGaKm8SsdWpt3G2lutBJ3t8q5nJRZ5xrr+2kKdhtrXaOTAFzjGgrXrGw0DX750ruQk7Mkvxv/IHk3/gO22n6JJdx9V9M7U7lmqvAHybvwx3AXpiJfzU/gXfhaOsWm7kahqK3OnfxuvFbLENM3RJR7w5MeeXFijN+FV98d5dENU3XwbTNYW4u8uvXs/dIlecB4MEmuocoP9KwdPKGcTiNHU645oJ46Z5nDj0KjWh0o+NO8Zy3wLkAC6AJYA1034m3llfdd3XiW7jh6Lz+/G68k5MKIsuneyI+01cW0CW/RfE1bpcG9fCPxCMV2L/kzxvwEGCFJwjtISEeUvfNe/mxqhVDjXt51iokTbE03XrPalyr1eemSbsQoy4Z7o+JjLRWz8g2Jr/wUcUWJbVmwG3+4TiuvUzli6n6k3ZjUrxLbVGzthI5/BQ/XkhIvyvnB5Kof7wju5CdY71H2qoBDwN/BA1mltXG6+s5FaTFytoquCvEnNoKuo8yIvisaxGAE/M9U2qcOUAnZtu9/sa+ugGsi9Xs0B96dWtUN9U2RIEWXs6o3z9aaAaO8RtRIBnm9rtJUhMeNahDouNLwbCpU6NK01lyZq4lrVWsH3edV9zvWVDJoTHFtUKRoQMXuukCarI+aRVVTtUxX7OOsFxEV1Nk67rq00rk+jqKMXVGV41JinrUavi6qmmJIaO9Dr9c6tL3uJ5gZpT79N/gFtvkLDGLYD/DXzSh/noOeqvKNSuCRDNSISGzN8AQz9LytonhcYlxclFdlTwjA/QD7gT/PN8cFKfgn3j5XBfwiSSJiDZJRv499gX0JvsC+aWjy+fP8dV9gD6AiTyk09Tx/FL7AKir2JXgDf3+SEo2/Zu9df4IlI5fxEA6hhNUGXKhsxNkR0Tv+Y0jcqvIewperGtS5hA9K2HzmtSSGjrjqSbhAa4ZwbP1cwpZD2FQ1dpWnpGvbFD7DsibhMd4CitjWDjo/CUxR2LVC7bWvk2qcQ37zsHLon2AbNJXEL3hn26uBlQr/ttTUSuKKdVD1l4gd93WxlHoRZdm0WsaWCda23GgSutratk2U/rogejnQInZFv+DKsuKnECQtueoKqDGHbwxW0FWJLRL0dVZvIrX6EP6XW1bbvY0VlIQyUTRjQ/FcvtooN/5fIJ61mkkG3bjlDHR+hhl4JvpaDX8blhmsgHf/cfsyoUGXhnNiOJnUnnvAT5nxUwZjsOmnrIMeg5zzU3a3OMHgnRLC/w7wzqYK4kRoafGoKk2T6Z0h8rl1K7rprxOn2EMdb+Sgwe1r/jf/hfHnDCLAP2eQYw91tD0Q+Q2HZ1gTFFE9BPkNV6nGhznooLLEEqqtcBvKLCQhod6d6PAMgw6jBehJyuYHOET/zgsf4IqI9HJFRt/LYI0RFiolQCgqeqcSavIA71C1SEfvXSwjoR6y/IbYfqOBKuW9sqD7OIk2nmE/hjUGX2PAGmPLAxwIST3ADUIS2qsYiCgJQr5l9bDL6UnJlcD/7ky+HiV6htJ5J8IdPHUn9t6JWy/Hrjvxjr+Hn4QOKMY9bPfFHAjCT2/qVcY9LPVyxn/E3rnwLC49iz30ciD5JGylVxDwLCaexT1PQvJJ6HoWN6mHBKnoaYR6ikBRQz3YeBbXpngHSUBK4iluqMcY8VOEtscSscz4eUaEY/SaoutZ3PkkrEnxC+OXG7EQPdTfeDyLBr0SMbS3Ewm9FB2oChtIya6Ih9NbFFU1Hj2rMIjYpVU/GQnpeBJEXMEYtFI6tWqqRyBromiqrVVXPvlIcRE9WUmpZn8SBD0mMah32ssdNyRUWiHL26rcZD4cQes7EwVCqTduqCjPcv3BSYfCs7wzyxNZrh7MYNyJ6sWLYlZFxHmVnCxPUgsbBOptTIokr22rFAk/I7Q2WpdewZWcrRRj5TObtixPwtX6KG2FRyDFL40i2yJkS6vEl2ojW8H7IcWPYYqrIZQk2j6q+BevPMPTmt1noMNJdsMWdinhm87Es4VdFCEf0N+TXLHae6BjXfGjmdbnJnA/H1UPU+gpT5sWBj1PMU6y1P08fm1UWyEhhi1b2MVRwWtOstQWllzxKGdthONJtpGQi08ycZIJemGTovDSSGAPMXSQtIuijIW2cluLeEf7O6BW7vb2bE3tevHUk+wPz1R1uJ/z+/mGttc+raq0v/xpLUzcz1V7dEWvj1ZC579Ozjn9vFONnlbOx3R1JyIq38LWbWFP6IrvprBGMq46yZ44j54WPSBW+Pt+vvOtHDR4eKybiW72V9u3AqwAQ8OP/iaxDWEbrqews/WxDhIRV3sIgwf47gM8cYDzp6FToyeGGGpPfDBi5gf4GsKNlc+AngZF3Kj82ucZPA38NHQ+DXgaUqcB4kc0T4MUSBRofWAUItuQzzM+z4xteOE2fCNQBTZtwzV6qdsQtJx8nnXMM3gtV4+K4Lc9U4olPIGv5TLb0+FzpJbXSVQP9VSIPw0JRd+Gm05DknSCbWjE1Z1nP1/fmv8xpMdMp3XaU3AaDMJSEWk3aRDq0Qpdr+XHOqgc/jRsj8q88GlIxcW2vs+CA3yWeH5nG16wDQ3C10XM67fh5m34BX7mp1GbDnDcgbADMU3a7UDcIcdOUnUUJUnVVmRcEz+i0hv6WvzoWZHWsA3HI6YQOCQi3KDwNj238S2Eb+GzqRU1bMo/DZtWvEnb3xo1VnZ8K70jQq6MkAqFNeJPRsRzNP7E6XCwd62q1TsAvsgVJFfTWSJfAd76quxM0NH6XE5l6Vk5aSLoJPkf/SO4BoV6jxaBeo/WhBdCQJ3tGkxGuPFCk0dBQvFHUf4CJrS8XL13O4ZwLJR5MkWkNdGjuPh1XPO93DX4ztQ1LS/nmtpFgjsUt6Z7i8ovIP8A4AdgvZalqTWVoaqVbEtd2R66GhrnG9c9hvAYwkMQwodWgPo/wTEew0PAI3pS8WhJMRgxUZPJ9WjEiVquEPTiVkKrnvf/AI6BhPvhW2c/xPgxCZcfY3AWD9/yHQCMEOig8ADAMYaKfgCQok0GSoL41d9ZXPGHuTpCRMJzGNN5RyRBERUoaR0kUIXPIeS4VPIAdClNIuHhv/lS+HMYJsXFxakdslzjGDNiyrFQZ4xrpzI+h3Eu1OlaoWEtOrTqH6eKKx79SWRMUeExDYmVjCnHWEu9ooyqI8IXlVFrN9sz6pQOqv474Bi7WmsIPKZVT8mNO+A5hJgzpsRhXPOHoqS2ZiVRqpPChosF6v+EK1ZAJT0UNW5M79AYlJzjUUYlsIOB3klxo68s9wD8wriL3px2MLirmYx3RVFFv4vesT6HMedaRYzzPiijPC47Sl3TEWZE4nlq42kGpxn+hbQR8GH+utPswZe1Pq7V4XsYvZBtTpT4Ce1D7F0fAJclXPY3L90GfBvgpxnsJ3gZh30cPs1C2Aa4DUCBosfhPt5MUpSYR4GikBDYx9fGWfZx3McTn2apfVyW+zIKlQ77uNRhG8hwH0ddn21w3zIsczgCm+g5LD8CnN7ObjkCqSOwYQ9LUOr6PeyKI6Aey8IyTxDzBorGz386jsA/GupBbvz/nenFbpjtOPmF9kZJCj8CcCsP2XqZxPdoDEfoldV+HrIpgb2aZEVXlOMYIirjMv3rdXopDPT4N0RUFoXfGhURJ6nontYiFB5LVjqQttfr/+eaIBnlSlHtkvujInojWJGlIy4ogmRctT2an3OUilFG1JNu5QpS6jW07hBLvWXQ8+dQgTY14tfZR6CTKF29rENjSFEVklrPrutlvMLCdo6GBVSiHlTKxNljYi/DuB1IzxYvZeprQ9EVHEf1TlyQbkCDUg1WKXaZw83ciB6Yd0ZVkBnjUo63OnvHzUuNYKzs67hzexmq1u5dpe+Sy7yzLZdijityvPlPO8PnAJVmV8Y9CJUQOiIk7I6oUzjNSamq0ieeSlqhKfL9XncEjBX6NCeCgmWunsaj1u94HNvHpNYCSXpfn9wT/ZPxI5DYw/gyDxtHl7Zag8TP8yF6d79hDzvnOJ51K19lAKhho/+7gHjKH0cj1rYVmrMgqqMc83r1aWSiKjEee5qELqWnLny5dYnQlWxVryMedSREzmWaF6kjcO0etlJbOI5rKGN7o/WyZtHR2GgOp3hU6NMnXrj2821tLRmvWgRdvatpUomI+oKwHM470Kfhfk3s/hWNsHLN3885LVNy2VFZFCi8dbCJuHlVuVGNFHTR/4JQYyylSqFlRPUvEk/HHsYjNoiRVUejPmjjaqow6lyVPXXmHleFQi/jakSpsMLCsMJU24YUfUJF/Y57WEs7xKXE2RUerwPR+GyvY6W5gKTizU6NJX38a02Bt2rvkiI5iT3M0BhaVrx4TLYupKgv2rfyDiV21XUgnkS38vYtNZZM5UrKf4kGyX7esoao2sVjL9oEUR9L0WqT0nWO98q2YUCTFKNNs7n4LLfuXBWWituhdU7pywLqu54i0oRKxPK15o13aqxELVNhLdsH2SpxC7d0RytgXDVFWVnBNjuhdZFsdqvaGuLp39b+8eKm8sYKxHWJGzni6YrNFbXk7udNA6w3/Acv7XZRb2SkUZVT0djDqM1b9tP9zdHL90cUlTduybiDtFoktPZv65rV14qobVtG2jJv2gPaHGxZwFddkdqWU30namsKpXxsPcYjeUWv6VHUlmLUmkhHwuVOKaO3QzSdE6Qhjw3vI5CKi+tlcVNjPJFV56pyK60Wpt44+3l7Halzk7pikTJcH6VaH/F4juh2+x5t8MQ7V3wW0FdRbTNCfS2KF5A9mvIxs74zxlBp7/pUtE0LfQj9CNYR/4a2F3lUBVRFxNb7zVFNb+XNIRFPE31IqzUwHhuq66mtkitN2T2aJaybl6ph43OT3krxGhsfQ+JQtXBsFx0BOIFNOXqrKs7ecEPkMf9+TY39nK8chLrZE0uLp0x8aou3FW3r4cdxvTLRo9Z7xR625QhcvIdt3sPOOgJ8D1urLSYdNErrbdZv29YTG5nxFhCNq/hfSK2SS18WlluPcss8buQw1M28NoGtG1nLcI0WzJYdcNWNWNvNO+IBENdFZWybBStPwW228Z7W9UrvJj2vvt2srEU0uVBjxiMr/oeDflbVV3UqPXEckRaH1J4VB1u1FVZal+7Wzm3WLm6leAWIzado0sUbdFONXtbCHw/UuCX1wRPfEsR7SlyXuKxYmbZ7CVpPQD9x97aeweMhoXqzbdTFnR6rrdcuMlr4MldTFaOTnbGHJfR7lbgisTRdjj6ilrVDSryJKERXu1db3/QGUedNOnKCdihurlc/kg2CWiOjrtWqIzBSD49AxzJPUCqqCa5fWOmatN3A6HXUuljoFWnrWTLScJmn2gZVzEwVSbX1Ji1iqDVsapn/8Iv4ov9Brj3aytxDUVwt40YKkVLPftEivr5+GZAAWoGvoCj42Dn/g0MEPP4vLZvwvrNX+xd5Tvzv6lbAZy/0Gd7JjQcR34FwJ/96xxGDHzHemFi5uy7z0g3Gl+AGA24w3n/+VxGeJ3gVdn6SYy9CLyauQiDAPgQCfB7//HX9rHsZxV3wzOZBbgxyHOQwy2CQ37WW/l+cgltTP4bkj4FfC5fQv40zrgW8FuBagH7o+hHCE5w/wfm1gE9wxcZ/DPAEh2sBiV/ihLwtlTSW+XkscR6DnyCcR/9r7if4tTUJNAgwgecmMJnADRRdT5StCRSEJCMeJKSDkPMJf+a5s+ifzq2n/zK3cYZlVvzrOZhhx2GGvftCzXnhg38MR9mteJTds23l/zLbxnAbhadC6KYobGMJnU7/0ezexVPsr+E2dsVR9sU/59/mD+K3+X+59BT7YzzKuo5DUnve8fQPUpexh+/vOspuT9Vxcx0LdZyq4+V1fOX/xd6bwFdVXfvjazjnDucmXEJIoiDEoUypUkWLY2prK6iVKBSVir46lQevVLj11VcQ2whxIIqgjCJFVNBaEGNEUcIDuaCAYxURnIBAKpOKQB1B+H/O3nef7DNdAoaQvv/v8/nmZJ89rL322msP9wzfcxO164I9b6LzbqIbumDiJiq6ifp1wZyb6Pqb6MlLb6c5Od3ReBZenCnZ2orcL47gJFjxi0kw36Fde2ckngAZqLh8ke94X0mcBBMKFkA0GcJZtwCw0o0k26gEqoSjQooUV0JM5LFCxBYlmZNMSY4LOZTkmCNWf7A16LlfPSYmqjiqEnId9eTTvEk2pEBRi30UmpuiOqwEQ9WlP/rbJsnZm28mOSICVAlRZQ1TyImJSFO2WpQyK4FFnojSgWWljj4C/mePSWTzQzbhONG0qJDASY45SdqxUByfc5ptVIKhtJHNcLowEyO7QeSJChWdzCzKRpVoKaeFKoWVcNduw005LLGejjfOOt54N+rzN4dasL+PQnBOt0nwM3dUsVbAg2HhXISnTfLGoCJzJIHirFSGYZGXiGOeOB43CaKT4CJNHw5Skt2veIW15cpJYE2CnpPAVBp++0+uhIQwepuklwAy89x6JZxeCa1EB5sL7H49vRK6VsJC2Vdx1Wmyn0+rhNai67ougH5JbpPk/EpoXwntKmHFvMSZgGfCS89GgKv+13gUxvEmNDR2wueW0G7CX9ugB9UXSrsQxhhjvPSOvEWCl+1qGGOoNzcTQ+nje0z1qcY3khv5bz028riy+r/KSSTSem7kv7g/9Viwkb+ZkLcGtmeuUQ4AcwDgGuh5A80m0/hujXUmrh8ZPdrAEYSfA9YBDiB8liOSpu1qwBRhih5u10MQv+kYgnguSCzGHujCKtAxOcPsVki4kHChoL/TON3GRJ7AzpWIlcglhi1Z8uJNt9MmmoWEp0CG9k1CU2LWhRpznCEDmwhbo9Ua8XyyoXTMaPYU4FNwi6cpCh/hj9jWrzXevBBRR2tbPRubyIan5CaNze5yKHUT2p23ifBoN/oSPoHWE3ic204ZiFKmaI/VGulqjLVGuyeuhresrCyDxe7Tt+8yhxPej/i5oKQZSLhD4za6DXp6NkEvuGjyPsTd+I9k0tgSlX8LzaRxw3sgsQLFv2daFyAuQrwF8HqB04D+Rngh9X4QVtPNlLuWnqHdGHsQ8EFo/yD03I1+NjljN1oiQ2w4RUUMvweow50/4UkVZZ3U2lZjCcdSZCxt4t2IY+n2vH8CjkRjOuJpgGfDmx+R742BZB1L7rqY+CKkg4jitJP4wRmEZ5BxDZnubJMeQMJz3mcUiE0FnApF7hoqN9K7gO8C/g/gNMY+tBYjhD8CvJQyuBOxjHA54XbGFGArJgHsTfgS4jI7Btdioj3gVMa+jO0BP4TMUeHuT71cToFX4cOTnuMqxirOr+JlK4wruJsgvjP6Q4YTTyGihfEKbqmdvnK/w8C3prX4NuWv72LjCqYr2FK0ez+9ixcujvQ10I2le3AZPPUZxumtyrygLyx2+Qt+GhvIbyaeJhSYs8pPGye/dIQ15AHVKOoohS/rPw2plbyTfba5KMh4VMfDerDlpvCi31DucraWcxshbuvlOkGdG7v5U9pXgdezhLEJUCEmI018d1QsDzAP8BbCPJjyfORGpm2EGnIvgt7LsXU7uFJSW12s4ZrMr0O6Dnto9GY02cUJ13s2oAD/J6MBeDYjAk6BxCxGgYg49pkC+DY4/syz2D6dzHizKDJV8MONYywBvJHpRl6pq5L8L8aFgN8ing9kidwnCDOeIKRs56unMk8hnMs4ly2lWZcVgnduB9rHrSKcz0iMU2z98Ay08QDhB4yng51tMuNytDGZS0VZWgb0c6zFToh3Q8ZmUvp8kbutgZN5JU7FQPznFsIbwBbzFmAe2loXGykPjcJKjcfuKOqyl9FiaifM/i3iPRnKsZWYS7GFQLsQaxD/iHgh2sZ9FfAVtC1wCeE8GkIQK4U52MLAP1MG0gQSk3gt5fOX5kLAnxHls5FvG6Sa3wIMQu/ToVA7fcDsiNjO7g0qNvAWxGLD7vc6xj/TL9SlpqvE0ewOmM+7vPR0Gi5cCOY1zH92+T8+D3q22djf7rlMVEd0cattRdvXfkN2Y/X4YgOfh9l2Z061c5ji+JeFMMWYRbYLLRTOegvatrlKOOhw3LGF/mhgbwN/LuitLhDHHMArBLPZT41Xu5QZy670cWgtPHmgURD+ndGEpCYL/+aoqWVj9wdKE1q2vKCyR/mqy/Jx03ZK/n2eb7c6p118ZbxfedUqyB1oxAcaa4aKTNGBxtlC38G+3EkVjrkDccVCpyOuhaMDDUvVtBZDpBja0V84sAJ2W17Cn1O3gaXJj/tO0d3FY7oIv8jvbh//R3G/FIjvZF4/2DhjsGEONmiocfJgY8hgY6D8AOOBvgxpuXHsYCM61KDBxpWDjSXRgUb6wsEGdjTwBKHDpQb2MfAcgwcbS3pdbPDFxq7NRgJO+wng64yvC26yMsQypP2MQ/F/W0i+MYlBYONLplu58DeAJ9TTb/GtbPTlkysgjNJqCZ4uOLHeRUoA/Y+9S/lxX3uXsRz7svlr7ngrt7yVI7cyX07tVtHppwketTaIbfAT6su0FzABdCvjCMZVhG8SvkYlrzO1YZT4L7QjBcYSGjga+NeMb4o0SceVyDTRkkRqDh2XwHcO2ZmhVJ7R2p2FyhBXZxD/0t5C5fbNfJ9yJp5KXtxqYA3jaqxXUYD72g1osZ0p4TVWgc9qdCvX5gHgXkDIgPoyHoO4hfEqqDHKAcvB6MvPrGM3Z9I17lMPbdLEeRH35yUjS+noCWQupei1gN0Zu/P+fhMIn2YdNJyWG+MJkTCtlZYEP0sVs1CazO6UM95bGE9jHIz4HeB3cP/Vx0DBeDrmGMCW5MJsxq/JznecLdoaT8bLYCxlHE9PFR0Ddg0vg6yWZLWOCiJg6JRD3XhOdDydM56wEDBq62wonZ8tnkDmXYhp4hWUmEBVxhSUZEg9xtNjF98J/3gTR2P7k6n9fviAH+TEN/DTznRyGbyNl/PrL0fu4PVx7a3txRHtC5SZD0nmuLl/dBiSBEgT0FYcP8MpfI3zCryImtw76JLcH0SOeXZlvAO2G/qb8/pFkA7kJf72vHB+wNQd4L+YUezRxy1kFN6OLrhzr9oY/LFKgUdlwquvR0MyLPbSAS0ASYiyNKa9v/9MVNNnuDv7dSpwvs7b4ad5ENwyK9DCc1SBjhbeKN7r/8qjfkcV6OqOH+TTdbWHfqNEqPs5aQwyBeIoG1AgCB+edHhopqOFvdTJqyT4H55xWj2SVNH2HWjl/fm1jDpqwCxl9KMGsAZIHDNwF9wQrYFILW+U/zZHReSWqIzBGtgcrYGXk6VsaSJeSIpcjlRZMw8i1JClzvej7qxxEZtOumNjInZ2UVBsgJDqIlmVO5aFauYw/sCTve4gz19LZk+fX1QDsVLmUn6+qJSfLKoBruVJxaXMowBHQUSZgjU7VBXVwJZoDZi1XNd1FGyJiqyzi2UHcC3fWyzLeCxbytUeq9i9aGRX2Hsujb4oWctGUMdRDZBojtfOVAMbGxQVK+V3o0HSvXnNGlh/AOtzDdR6olYkS32ya3ldNCgWa9lb3FsD2R7//VzEe/7XuS5qJg2UdvPdzAfJyIQWJncyKrTXwl3SvnsTTvE0SJ4oG6n6IhLFluCeWg5ODKmArMsUQiytSCQNLiGyOhEuCGyLgD+pPlXqbBjJPohzhLa3KoUNI5PqJrxymcgJp8HLOuQ0fAF0uIjwRLSxAKILoItgo6LHSabaR6059Uo6zZQZRGTxG3Z8bCcnLTuSDSOT5GR+geoVVno6y5qxk9lCw+Hmcvi+HG2FSryTIzsZy+2Kcjym60GO0fAiiukd9zDZrqKrLZpDThMkdCv1ztSYgdtD7LIOmZGsXbZXmctcALYFnJ4S8Wwh9cGiFAfwQzk9/oa7Uc69QtVxhmHg77m+paLsKqwiR2+a7xoqeRvIMwzyZZIYP9FdjLsY/0SnyGVUDqo+7sYvAFyJuBKfPd8hB9J9QfQSpZW/yKHjQBZRtuHlGcu1dFzDaaiSmVgOEelHFpLf9o4/vgH140DGzwec7/Z3TxF93OidsARwAx1TLr6O3gdJtFbaIh0RH9OWsiI6+5zWQtpA0hYkW+WpxvEuyzZTUuOXs4dtOc+O+jmYdrmmHvKTjbltRkHziKGFKQ1mGuLlnCuU/ltUzE+kW8bHGqdz2MVUc/PL7VLxNDzSVeZztFOw+mCWaTyaYmsBRISLxWR8ijHFGYOkmFRNpM9g6XoVjQWQ1HqXUlofv+EdrZmWdPCx420gSnFL3S3mez0jt5wjKW4h5NREUtosn878CmDf9CpjSJt5MzFOwTTklHPEQhItjKf4r9EUk7bIOM7it5/TI+SZNha4pn8ZjriViJWztRziKb7XHlrC5h7fIU+M0NpQXWOIictIseHkkUJSTOVszFHaqFba6kp+N8+6I5o3p2vYyuduN+kTqJwtPGNXWisdvMw784cdWV5ffF5UGJzcs6zLaxZAYC+Qj69PzofPB1ANarsFz/hmNSLZLf3JpGcs6wutXrM0ZTpotdBzXkAZdwgbkE6j1X6lfirVF++0tjjJZa8PRsrtikYlnZFnia2KNueRf9Luk9l8JdwjUv52yyzyIiamEWRmei4N1EcQNvbGZ6KeaV0tJHJGyoxFsSep10mjs3PCrco50oGSO/nLwgUZqtAM5qhRlbY1aqkNJtPtBQ8lRSYzpQ1b6YgLwBTzU0SOFUl1KAeN/M3vJiLVFTTLOT/F06JqiLmwU4xBOVpTdgWUYvbtGWQ1mUak3ENH6Uo+s8T9FZO7enJmbSVRDhd7SkwxOzmdsezEOGo5042AmbKHtuEMO+FXGWfTnFnqGk3x7cmlQspSsCG1WZrpMxKRztE2+NL6x5QiS8HUljYUW8697d37A3bm73T93oLcnhNzMsgpX+ZRaxarNdJK8ayoYwdVJjNXqt0Tp+39jqnMGPNMS/qmc77dl/W7EM8+y5mxynlq1BnhmvqOXCvt3ngq/SJp0Ker2HJ4MJkGzzB2yfLM32rtcZYr+3Q5kHs1d7wuR/fVcntLH9/JU+7xrtUK6+lOov8GHIU2ZoKO9dFtjBqqo55rWFUCt6HE0/VcyCn2L/34t3pDZPA1emM6kCem/0WEOtypiS+8+XEUW4+T9Xu2hkOyA5k7ufXv+E+DeV1UfljXgZvmdmI0jzAvQ36bL44Oz3Kl/7qdJGntr5HAuq7XdaDF3st97t8LCY3SNSNRiOgpTid7GXR9RsomejxP3O5cXx0fSLw+M5SRfQofH54UCPKFTwqKxCmcOIEwBNQwTT53Zp2H8moJNUSWIPog2Wfjv0TrFUAdbqmJXEYNta9aO8Rnypyb4zsAF9OS3kGbDsPCPN+YIguXPKl3UfHYDIdrBu4OLO1A14RcIm7rLniN52qvTw465OS+PH/tvqi+SeYOmD0NF9dH0GLRTNHYutfwMubLGDW8Hr2M+QtGHe/RoxUujtR9jNcaOgo85K0e7lfJG+vAQzXroYJ1pyYUqWoGHlF/ZBfcohI6g62PYbY4q1YFDoeshDvzKT+DLd5meEzg0fugUreAy9buVK9m7o6JaklRp+X7uIti7Y2pVMmJO7rbHLbm8ATTI9Wt4JJ0dA7hHMJPAPcjHseR+8XNM+SF3f/BuB8fmFEcxDQp+QknmhpZ4UNR7WS2KfgIv8N1YBbB/pY+Pkodo2gd7B0SSMUoiBvPKYKd7C7yFIVwRhb8gq2szI9/c0LT40GVSZwlit7hkDf+pAgmh5F3SgzQwh/IfzcWgbUOPu2khP5oJk/HImjlLvhzLbyWioBFKK8IJIMlqWOuu9ijEdULXszk3+lMl1r4cSdTV2GspVgEYzz2me+QjR63Dh4110GZYKm8vYWW5wWeyd9GlKw8jf60RBwrdH3GbmY3saSOO9dGOsAzt5+rOPpGeQntNILDAPjoA9FD0qihwH3qKdsznJVwGu/BG0P47rAf9/eT7AXy8umnJaRzZHrysM4Q+AHhZqzP9ks4sYRi/diSQkTYCFcd+/HjrrolSeJmxH7cJSi3lCXpEO0KRM57XSLWg4S/lWZQWx/GknrqzKP7cZEI7KQ99UyMkT1Y6hHmpmqMKLsUKA7PDPQigpcwkFwyEd43x4ZY7UV0Ex+eJOk9Za17UFJ9ooz8Kbd14vfga06xjirwdK4mLFfmFf7LHr5Fd6vXenR6oY3IlT8UIk4uWc49Kk71SHWnFrhP78Ni2ikz7nlH/h8isA734PQbHTbQofAODoWYqPVUt55f5LpHVsJ9+jgV0/aOWjvywp1V9s5Ef5Q8zg+m4nwCJBJ7CR08Yc8OfgLMQHCIs9QjwnQvYoQd6LygGWJSgc2eTvQIqvyPfvz6mRvJk2tewVBYWTgUhgyF7a2HwtfHhdvnbxTi3FZImftyfVGL44pRdHZUyX3S+ICiH9Bqe8Z5DV1wSo7BRMgQe9Yb5fbBK7WkMSx4f/2WRy1e4oSgqla9Yn1FVwpmzBnoIwl1cMFXdEpQ/Im+mDt1Hs62gkA0EIVuQtO/9jqLClSxzReIfxdpkuLi2F+Sn4pjV516VBGeOjhFxCTcrKb4MEY1VT/HsyiqlTGUaHwYUzqbqM41Ko5Hi/IRLTXXR8X6n4JUFb+iWQ/4qTwfmpjfglGiH9pHwR5oeOgGHVpEgYQvhlowdiIcU88/WM9gqbCdZ3FvPep1RHYJ6jJLqdKJPBVgDPago6kPU+wSnai+dCfCGxSn5RiIi4fKCzwiO2l4EvJFntG2Spx5EH09xQC/Y3Ib42pppCp4ueVvbIsZbrHPG/1wK3aifT/+jEokU6SIN8dnmB9xPCS0MMuCWoxOeJmrhR0J0fGQiClmybmuPDhXQwxcYsdDO5EnOtedbS5ERfxvY66qE+6yev64rpU4ZsgoJbWlaJFeS0IL52oy4yKGxkM9W+fcjEDUTKfzZspOjAjOTal2rlO1zDleZRZWTfwK8VeIHsrOWKZFQzxtdHdlgdsgHmN63SlrZo/BN//lWr5Bw1pDhWZEtOiFpuuE6QaFCe+3eg7u7eRQcSrGTgeRTow+tFCp17kD5zs8n8W4Sf472y0OtRwZGlA3zGJsr2vjBumZ9SSP2GKMykBvqIfWgAIpIahthbpAke1oXYiGcx1KU8dqQsLoexJP8ObcpxA1jIw+gqiwY2lkBD5/8ghMF1XT3Nxu0L47lHaHmHgPZhFWsFXHPUegpKss7i54K+u4ZwXHBBnD3Q6BSazhAfkxfckP4w9IhkzJD+ZweIYEhoXRmAiUeojUFAr9ArOrnV0NYQhpDp0ghbrD2R4KNe2lEW+8qMgS4bhQ+OzuUBJmqKw2OUDgQL2T8MUUqNNWinLnOkego9gtHLtFMCwFBkTmLp4muykQrxdyEuFNPtWpVFk7OJDdCMp6Uc3s0Ya45cEHEuJ4viSS8qHQsXMDTHfo3X3InhBiXolfhDi2HqlzPEbDW5RogLYFBztOFbGYd7roDjd6IkdgcXfoJBhv2ncDx+GlBfLVRNRZzYgNn7tQ+aqHfE8SAUnjnOqjHyTBziTV0I15hqqli6/eU/2ONwLbq1Fzps7/5jFa9ub4rFfaHSyBA/egchgagedqbpChkFI6RBVV1ClOcYewV8ZUU+y6A/hGQ/wny2wQOuEccE7zcQtnCzg8gbJ2lZQIzKymxFhvHjoCY9XUXnAuddGppB3mNGHA9oLKtZty4CFOM3uzwxgZU9xQoe11JvzAmTbrdEGaYR0OWD97rTOK68eC2z8zo0+cFigutTEZ5l99lfLFNChwOKbRRnHH7NPKIbRUb3JvtirYUqb3D2D/PiYgkL2uLOuxoBQs0BbaMR7iTEvv7WoqlYE6fjhTQwUXVPAuUqMi7uyrgpZVBzeLduWJ42NUTaNYpfSrpqiqfmg1xdVUozuhg2E+Ikoda0xBnG3Iiey6epVurqb5/i5k4bUeQnqqpkgFt9Y7Q40YGTOkcX10BP720DypAcOpZ8i+oLDhw0PNfJlABZcIH2qtr8y9OV+czsYQR7gqiMnc7m5ZvpraidMxgYznWE1zbM8UChQLxTK7xYogSleFS6qJhI9cr9axQZrERzNztWe6q+Or/FQJwvvjdZxShh6mBkK+m2/2t4F7yzqusLuognPc4+yCauqsXHKUHJxRtTHJbC66w0h9e1ysq+ojyRyjZ02oybpnd5jheFpPoUdxd+jdHb61q6mwNzBddJuGhZVOpartp6g57FS1e+mi2ldcl/k52r+be8c4Ai+Ry5/aHBaodX+IrrusqxvMDvOHPhXcXpS8wJ9BmGZYlsI54nimaE2LasqTtdnzoGhIBWoU5F6MwP5q8ihQze59wF+kFXLuFCYpllNwd9gcpl/YYNlAUtPe7P3l5na43g2XK5p5F2edUx8l//5B1HOB1mX9hWNZgqLW+cXas5r+nllxRuA4ug4mmtXE1fSCd4S4m3O0iHxJjkKjO/ysIvN9A6rg2+V0Nl0uEtQdntQH3Ivyqw2naMK+q19OPOuKiOldwfUc2WKCsbrBTEubNqeSJMwUJe5yFiqqppejfp5aTfqJQRuu255uOxlxMh7/GOJjGCuiH/wJ8U9oDUP+M+IEG9+MxjaEbejVNTwD6H7maxGvxej9jPcz/hDxh2h9gTgLIj0Ae4Axl5IzAAWqLxf0kOhD8e8BfZiyJ/4MXvMsocJXm0nxPW443yJzG44yJhNdCnV4KUS34cxPUTAwJk1KmHSOoF+cQyahSe1MKhNcjMUmRU3KFZESvUxikyagom0kk04QYm4nkWKYVGDSxSblmRQ3aYhJ3USkadJvRF3vV83Fu2Ai3QWosG9XdShF4QLY/uFVQdF5QZGznnmUnYe9hrpAiqMqNpSiQ8m4GuJDqcBDnuJhs7oaPEL0zImrwdDlD6dvOrvFnazSIm7RNJyMReJZrUUUXZTJHNPqiAiONXM4mUMJCwwJHk5OfeZwYq1uU3/GTdTFopadW09TvGu8kZ8o28iSlo028qe9NnJ7cbKzVz1v27rnaSP/4w7eyLt6beQX7TOd1s3+q9qGMjBjWiaAG3ls/T87Zm6ZFGCHP7OljVR57b+t0/4X10ANrQEcAMYaOGkNmGug/RrY02IAbIkOgK+t39IKfA+vHwCBx9PbQv4Au3DuAEgMgPt4AGzDN3FIZztlBS6DG+7AhXg9vc2TiF6kCybROwXL4Mb3sNUkoovBagvJNXBSW7Dew64D4Pj3MP4mzm87AHJaGUdNImoLpw6AsV/+bjg/N/2XknaukHAk4EmCDa2Qpj73EPYk7Emz4uH8Vf1VuONbjG/xm1THd7Tpyqjhu49O0nmrNHB/WI794YaQ5D79IdYf4iJ8oyKxwiu4lRaWSIg8Rf1haqEIvTczIaiwSHFibTpe5Mu/go0ruPwZvew9eAXf2eouRoG998ykEu5xNPI4eAlLeFp+CfNlQG+huQzwJbIxijx2KCxh+jlgCRslfHvxMkDLBh8PLf8O+PdMgoffqmVPTlxinLcei3ryBSnEFBpfwyQjxNL2FubHRlgS1nGiJ/fqybk/NqITiUp4a/RXdFYJH13Cg0vYVufH2GUi40TmiUwiUA83xVBiCY3EEt5C26C1xtNFAq3E8ber0OpF2Ivia7D9WYwCbS8xWq/C0d9FzoOCm/HZ9dNwBETy+ZM1ncNIWgYaLQcaL72WN9BoPdCYkzfQ6DzQuPuLs/cC7RXkHiogj4aKlGFW8XYgAehGTsIV6c/giVxECTgqAR33BuSzs+qcKjr2Apbh9WVY9CZjCvhlwBzCFNCV2EErHleBhKJTcWArsRoxAS+Pw3MQBfmLDurLPVcjpgDPMHSscXIY4vgffTnSl/t/hNvZw5eSAlpnC9jRYh5SCnAe1uNawmupOAXxFCRS0F4EvuBbBRHeFraRAqMvr0imANsgfaSobfoynYd/5d0i43nox9NGGeJxAqsog8tJ1vcVi3/4PtoyBZmNjDFEeIUhqr22L68o2sJ4H+CVyPdCm71ga38mv8L/ouRsotmEDlJg4w+4ueBfhG6QCvQUFDXXJ8BuRhAKV9cz2XhRhvWiUkB9eU57USf5s+4VXZWAjFIp202NcNHHhidteMUWFpK4K1TX1Vj8a6Y/IAYhS3VYFpr0SK7yttMSwKJVkS1cnIJoynbrAeFCAwykkJB+/QfE18VRhgXS9tAW1fCVdgI9RfueRt/gkfim8n3P9ujjsxZAhqrFiZ3CmWfLncACcJG6LACawgkPYW74ds3B5h+5H2rfH8sqY8xWz45u3dgy7SUNifkLlmAtd1YUIAmNFMRwk4JEVCCmE4doaC1SzdoMp4lVyuymGOkn2STcJAwSa8sGUSctdwdV2eJeIqGzOn+kTIW+7eVhWNHwQq9BdIpPwdvKVKiiLKQNpbW8v1ctx93ijPCaJKZPDZT27SW+nFsfDMyJNXBCiOwCcWRf/Ohtnoiq+TqlSz83z8ylwxg17Lw4RIuJOIxpGI8rG8a7ZJ4eQbQ168uUpBnTcBiPLnOLd1C11SP/B7W8q1dQxUf5E9rUcrdSbq9VS1p4ql/M0bU8rqyWd/eqgXa1PLVMM84vwwpcLqQGpEkU1XK/Uv7Y22sRX3fsDu7YCdLTVju6zF7FPmvOc3mI36+LVWBsmf7v3jJP/L5etfywN+3rAN+8NdyVj6nl+6ahj63GquVVjs/Mlw65LtCJHnRERX3CN3t1eQxr2fJl41quCx5RM6f5NR4UlPF4ZdvPLtay/lFRLZ0ZyMUk8M7FvhpuUlLPCvFFLOVVerG/+PRJlnJFr1q+wR37S0+50VgDJUriWplyfVDzHnOcpNyf2LKUv5Flb9OjXwidei4M8YXzBxENol2ebr483HnOGURv1/f/ol41MK6s/s9T690yauabuSG0EqXai6CdLYz0wSVeYjL3y2Wfez4Q8IXnfHrYq+lV9SwQbu6PU+OMOtzyHvDKcycvirmFfZHrISuRnC0KC7kPeqo3fDEJzxt17hoXFyyBxxZ7X/tUWOxtuFv0GBf7TR+v7GcDySEwDbMybzDPAT+eMKtow7lBJp/g/5hDRyHkv6toM6ZhR8+gUtv8u6LfW3jeHPhTFf3rwgbWc7TWBEPLqocL5nhbSmkoqKKTLJz9D14JqOEufJx0tBHW3hFGgoBp2J6FIyGQpkkix13PDzzutByeppXidW83fuu3QBVZS4D6YJm7ghnsdoGPz3W3VMII0u1TEoZq6cvNQoeEkBfXdPhFGqw0tBOFu6XBqKJIGnqkobWSmCeOD6OF5Y6wx3r45JsH9JM8oQFZ+Bd/YaFWO6enq8hIg6R6iqZhaBpiaSgUYekBMZEaU+HVpAQ9N8FvpzBTvaG/JdvWV+aEoBmF01Csv/zu7s9jnaQN5EIaHO4kzzgwgmYIw0c4kuN76Z7SUCTGimQUkeOGdZoYhcLwqdFLseR5STitUd24NeEG5JRKehpykcYAIeFwp3jZ3iykKvI3J9OX7pynpb2f3ZGt5iASC4/yBZ6ud4+92a9zkPO8ZZt6iVe3rlVkBmlbge6J+TLfVDD+guBZU6qhFf2Nr+iZFnIVvRU47boG4MfSpBcLh5GmcPzKTMMt4iiTWDBWoaCdYC0yqo4zJgVOjW94O3ED6cnjvLQBGjJvji+BH/qdUmvEaKOKOls4fRJWUdTCH2tJ5a51cYlr1Osz/UO/lKRqVXSSVjpp4cO0BO6z599NgY17icT82DIN38iIeWjh6En+6WY5WrjMH52ndWPLFMeVV3qcNEfRr7TUMtASMLQi8RSf4jZTvoUdtNb8zv2yv4uRooo+lwrFVsKjnpl8rmejE6kiQ1Xa3cdJk3QGlq+xdiv09S/F+gC9nSysJqXEQ7oSkZXBq+6+AEqcFI9w19rGNzqSQaVaBEWaAs/rUbEUWymOpNixgSEKmyk+OsVyiTdFnqMsHFRFNyg9XpwQ2Ia1kqLGg6PEMV9Tmqqo41KIinjPClGOFm5/xmPvSFBlX3q+S2a6y7T0TS0/q6IhFl4qBv25vk2NxG6nfFWPkO3R/rB7nnKBYjf9lcc5T3XGr28x0XeBmIbctNc0gbM7qbUxcA9gBhWRa3WgcAxafALr9dblW0XNNKQd07SqomgaWsg1JaRiPyGT6Z7gDF8G21hvuDCqZ8N2bvv0jmurPCSahp+Laq00xH2CpBdKCq+48C5LhtNwZVAdXEUFQpSZhsvEsa2FPxduFteOpjiyOC53Jq55vp1eUBUb5CIeUQ04w8JrhIoFaTCrqKvkYRKn7STRYYjf/1AzR5nYL091xqAhjmvqEi8BanjiDnavcAUeD3Gn7tjWKpy2pouPaOYDDiKkOTEo8sMThdqPPik5ZyZ+M2gxmYvdHOnz2JKBOi1Su/Ue3QGGQzWzIyBPXh3wDojVAS4mXEyxeYwifFwdRH3ScDjdN/8oEc0eeepZgrbiuOnBHBFt6p/XC9KgWNSKi8kaTo/fOy1+FuJmclBXEvSi7Tu7RrHnVTX3u2ldsr4TV2mMhwc+fj9yNo18ILoN5xilaJbiSaVoboTZq4zt9EpFC/FoyZaoSYuKTPowqj1vIsECKJ5UYXGMmbQMTWpr0iaZJSKeU4mqh1BkCVM8oGKpR1gi4hgXkU+wEILqy6N6dYUi0jktN4TgN44XBVf71UNRU65IZpOOVV8/neA8IzPdFM/FyHRSrfHDcJ+SSU+zkoG+YqaKIdXmqIqJi7CnXQkRSSY9LrM+QVriPFOJQpMWSGuRSbsKlOneJU11Num1UZ+cuABe/W7KZdpDA9+svKNHyM61q/t060v3JtW1t8eplhNBl+jGknYyWFyBkzd9VnMNvB14Ve9ELZwn8l4afvk00YCkHyhpOxza9da+W0LHBN0n2jUmEi6f/k2Sdslr6wmtXQ+z7/Lobc4tn6dua5pG04GKjAy8d5cYRHdI9R/136WS+HsTteAQksoD2yTxEIVcMPd+suF7J7EvEJbZaBKVvnFueW7eH/zdioO3NKnj5ECTOx9RmN5kVm8mSbk1sDVs5HxW3mxHzsEmbTdCZg8n/IDHuT/NPE+gcFX4nSdq9klZkKUUu7PN0tMStdxSO31pX2ON1ENLujPLTBq6ytm9fGQ76PTm4R6Hw98c54nU8rimnkjGS3fYSTWQp7r6/rBpLktTDqFIc0hqcaQVOOSkLAbPCU/a2Jj+RaX8VTx8Ib36kDRsDrY95KTTGlvgkFreckeOZtQZ0SO6ejRlUjJ8iaLwpE8qm3gGfS7qdvtrmsFetYmT1oRNRivG5BzmzuhQyq84j7pmmYyoBv6jBsb4nxF95YFWzXUAHDDJKOU9Hu/Lawbu0OhJlko1auANfbacmu2KxdJYDeTUQLsQobnh9eXUQLQGlvudJek+tQ40j8fVOi2fRDWbwSo1cmorX3vXZrHiaU3o56+EJQQWeyHwWWYJOlCSP8MBi/zfSKLwImGWOahawqprgpZiKVvhSDSP7th0W8Q3/rYYR3Qx+ZEvaZHZsLZxSIMjjW21nJD8gfG2lcv9Vm4mq9rtjX79R/9J0K6Ui0Rm42D7MjApMYxbNXZfjq4fAY9nscUFh2QL/2sb2YskSnmhcZDN8OS/uLEN1OhJheFJnzTfgfI9kz47spPqYU2KuH3daen7B+vKjZt0ekhObh7D4DhfTMW9OY3kdo828VV9T2q0lI8tZTNoIvT6xDXNozMafSbb2EQz2ZQje/+maZICrzJ+mGV2wWHcPzypd3jvPvJgK/G9/kDQv23SOMd3NjeTa9P/Lyl70iTPfmHWyMhBdvtLnhunn4ZVfsTbuj5sDgvbPh+O+Sbw0vABn27CUn5rn1nKjxzuofyQ05ujmq3PNm7SQ07v/3PUwfr+AZOe4hrY02wHRGDSg3ceghX2N/3PnzHNwXY7j9S26PvfEN1ZHtjPlYHPwUgE/myY9n0sendDblu2FNt8f+pXwS1oJtuhGmnIVYE22NRkW9zckPjdYVKa4b684UlhN143NS9XmRI4yL7491ooDpj0EId02uHziJHNxA8PwUUPg/e+7rjZP/9NXWvd91lfo8KiRinHGqmLau45hAc7ZOXL4zVwo9awCWMPxzMiFU0zlX1khmwQfloD002hSYsD9nhp03pXiAGzFHmxSZ9Z3hvWA1n65xF57W/vER+mTZD0Xlim5qnu4U46nM45shGnig2BL/o1K1NmSXr3xR+E2+snjd0Bc4/4b5bGSiJ1lIE9fra95tbTTZk04PDUFfFlO7N5tDdL0jrXNPb/n6e8HTzXlPPjITjer5qHn2RJOjY86XsvkvcckQl0YaKW2ze2mQoPo5kCk5rot4iTNEYSIxuC29XzzHHYC0/6s85cy7kqJl8EXtVfODJqubU40mHz5ELf+01LDtcub1Qz3DQ4+DrWPGaWRkzaEJa7OSgncdjm0TGN6zVLGrJidjwkEzT5HPk9k7iUt4WZ7wgO5Nc8b4h846Vp0C/GTDmE+lYnxGTcZEOjsf3iviM+xTY8yQlMPUw71fxDmpLeG+PcjFz+f4aFwoNYKY93NgRvhVmi+awgjf5+85YHPBfJjVIureWP98nrz2vl70XPALS06/edlIlJmzKxlD/O8k5aTgPUI18RQ4tfvz/w+vjSg/XTQ74Z21K184DvBcTcxhUPzoabplgL3xyUgZUJGtjfFLKdvvMe/VGDdNSnveFeRKTqsttLVJKM3ORvz/0NdD9PFztXWaZltKuKCufjhl24oNLgmxCm9nSDdOX7wl4s/m6s7pyr/B+7yfZYQVwLv1uufyRkbJY3iAu1cEy8kypxbg1MC6vJ/9SB3vAtWXTcuc8/MMd4tNO1sGrgJ44iq7IIHrc/bMQ/YoW0nIX429A9c+iYPtL/qRWJGbrOnWvgr1lM1UwWm4NN2vBv1KTIoUrY7PXHd8O85WnpHq0buw0rA0bERP/A/4Vw1g+b0rp3yLR4Y8s9JevzuKtse1R7emF0WO4s9/L1lwUNsWvYk0VK0xj20N7dEk46WzfJBxxSpiRErqlMtfBQnnBoxKRtgYNrauA8W5OFD+UwJY0KyxFIHxcoMSqgx5iDqFBIcDI/b/9g3iHbPtt/7+wTHET3NqQZrQWFXXQQWQff5lyN9dDBwfzanXBQD/c+Gbg+Z9mLNpPfPI2etMxvdQe7PC539CBqpTqxZVB/4SB6p0kvXOl1/Sus9z0OUKWP7jlhzd/q+Koca0eJozWI4iK+hc+ZSaBhza8nU61pDmwlWZI+8hAZHjOI2vgGMWm+QJrZ3m4cXxiv996CZm6wAyb9Iws1ZNjkmAyhgA4rYmZsH/Yz/2Fp0boms+Xjjn/McBRuLxYMZyXLPwLTx+FOynhueZid/035HL9P0v1Z1pvse4wpDdyCyGk6LzxzIDyjkn3L27Ij45l3O5Pf4w0frWHXCZqPGxxC0tMcsrGUS8636FupZKClby6NBnU6DaLZu1/BJaBj0e0Rd8SKCZ6IGWtK3BELx3tyTJuQ446Y84mnyLQxniIL157sjnjtiVbuiLX3e4tM9dRS9/JR7ogpozw5xj3iifjmjrco6NslEs+cJP4t/LhmJdaCjje+Pak//X8BAAD//3RXLX4dhAEA",
-	"fa": "H4sIAAAAAAAA/1SOMU7DQBRE59tJIAu5AnKDxAkQV+AWHCVI69gSK9HTUG7iBiybhiI9B0Can+1ccQvQ36RBmuLrad7uTAAY2fGDUb1djpGD7hn5xqgbx6iP/LSz4jaFikMKWv/DnfrDjvFI64qd1U7uhpE9B3YnkAL7jAf1LlOfAqOzR7SZ1uGwS4Gjs1YKRh17bTmaPdpA9ZXW2nJrBfVOvbbstOUwrY+CNlrnXxqb0aq/AvByX0AKiIMARQmZ5cDIb7mArCBLyAJPz/MSZQnJ2d8I5AzFOe5W1vl6v53j+gJuge+f19klHpbZy/kLAAD//8dz6atOAQAA",
-	"ru": "H4sIAAAAAAAA/4QWXWhcVXPOuWd/cjdnm/bmZts07bf7tV8+Ww1K4ouKiCD4IH0QQx8UBC0RC2kLIoIPQprSotJq0h8M+Vnd+NOCRDZp16bZ7lbUF0FlLlQQBJ8UkSK0D4rYPsicc8/d+7NXYTg7M2f+Z87cvdsCGH3suVfL4xOTEweOHLKfODAxOXnvowdePviKvW/8KXt837hNd6P2/olJEpg8+MLEg3tsnMEFXMZzuEBotYwz+C6ew3ms+fQZXMAqLuEM1jRvwzuB69jGFja8KWxhGxtlbOOq9wY2vKPeNK5hu4w1bOAa1hWjjdewjU3vZBmXsYFNbOAGtvGqjTPKQt3GWcUnpSYR69jEOl7Blo2ncZ0M6TtsjuAiLlMgZ7HuTXnHsY6thNl3dOintQ2se8fTROaw5U1TIt5RXPPexsveyZFRG+e9YyqClo2LWMVlnMPTtvG8iA28iHX8gIpg4xK+j+9hDWdw2caqNkuSiqrqkpKTujdFBQq4Z7COl/GaN0UBlSmKcHyBUJNqi3VsJlLwRc7iCi7hXBnnsYoL1Ef/IshNNelSwJ8fSXG2oOq1gXW86ju9Qu3wb5e8U950GWeJHVAfUmPxEq5jCy8GojWcw8WyHis1TFVcDF2uqqDIfgs3RlLSqvkjNh3iULHWKauA9xE2iPamyqMhFyvK3lVv2js1QmXANT2aNn6iveQEwImsALEJii7wHuASjpeGgRfh1s4cMEZgSWASmACWg/u3AB8AVgAGkJMgmLqSJPaaJG0mgQs6B1zIuiCFIgXs4JDh0OvCXz8zCflhJTsMmSL8v0geeBFYkThM4xIGs5Dvh0IGbn6hTTABlka2klGhTHPjICJgoBAltfAd5kIYLHX2RpkauLllLuQV3qfSYi7IhLBlxF6Keg3DIwLYIGQH4dM0CQIOOU4n2wFbOGzVOAfOoazOvSpRbtLdIyjQcBzzx7ZkgPUDywDrNUi/j9w8v80B5kCfOnuipeOKybuFxQWMCcgIsHhHiwnI5DvyBdMOHVyvwkWyKdxnOtIMEKe4ezhlknOVBZ0PVwbdSAcDnBCHxseSIBwYcuAoUwm4ju+bGSEW0rQk5CUwy28x+7ehCSx8yy2lxo1meFZUHrpxFoeM5jvgupBVMTEHRJCuhD/6ovpfcjVat8O868wFobCcYY2pCPw4olCwYCWv/Fgc7jI+hzi9VGYecay1LyqZhxzY7lAFmQObjHCfAwMOfG6lD6orgJeAlYAPquhKRHIBWd0odUWhJRR7g5EOF/o/cKFoomYO7DdCPvyPNojGhTpfD2uLCrAK/JpLZNix71LAsXSejJIi2fgoP2+Q+wzyvDoPCT9xzewPyWdcX73QNaohYH0+ZLvFTMhO9d67bagY5KPLS6vsST4aAz3K/g/1VctU/Vg8RPmPkBgIFnt2IXCjZEz38fQ5W7RcOJySARPwTLJsXSsdJksQ3noxGSvc84p60IFMHu4pUVdtbUThIj10JuB8xLfZ5HpvJ6W1LT3g5EBJvhUxsd2HZJaZbrnWWKmzDLcJKCnkFnc7byvrwsMxY9HHlzV1cc1W9iGsoiat67oopPemnFK1z2Lf4RFpNq9avnp5M+mvvu0B34WvA7Vhg6yGv9ZSy6r5tWIvKJr197GYrgwqKUdClodCceOvYixmNXrrRslZ5sAtLXj7uv49ouBH5kL1cLDfJVynf07K61g0zt9l9GUVouR57sCN4VAem9OHVXfnXJKlz8vdl+tuHwr/BRbAbrpKrrSuYKUMSwd2Ad9MZwDhTe9/ahT8EmtizNCbzwr45gE73upLroSvBiQckXCjX8KflfT6XOApw22n6MzKBOtaj/lGfJwzdldEBXIV+I42zs4oBJpD5K3rE2vEWdEZfDp0dcpS/+SSlWchvoZd3VxdvPNbZRuwcgd+2ttN7u8AAAD///pB9LeNDwAA",
-}
\ No newline at end of file
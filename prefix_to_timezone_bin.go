@@ -1,3 +0,0 @@
-package phonenumbers
-
-var timezoneMapData = "H4sIAAAAAAAA/5R6DZwcR3Xn+1fPzO7Ofkta+UO2ZRuDY7CtYGLAC8tGWtmytNr1rnYlx16RpWamNdO7M92r/pBYXe6A4AvHBZschy+GALmDmBAgwWAUchjZ4ZLgS4AQ4hBiONAlHB9HwCYXwOQM5n7V/br7Te+u+d38ftVV79Wr916996rqVU//eIRo7wnfqes9e2tOY0W71RSs132dAY2GEyzvrelajgo6dk6wT3f0qpdDbjNyBLQStTOord1w3bcz2NdnzuhTTrudo6KVqFOLcu5T2vEz5lOeq1f99RTcr1e1nwP+sh0sL+i21p0MueLUvCjM9NnvRbqd8b7Ft+3QO53N+4Cueb7nZsrcqn2da3vIa2nXtYNa5DdT3LTurAmG0y3th16UiZ92mrrt5JAbtHSQUR/WTS/IAKfm212mOOx18nak3UY+MKpFnZoOWk6OCfRq1j+j27rm5dBaFAoosP0og4xb8wnPeE3dcIJW1j9rrF/LxMw2VnTHdjNBs47u2Jk7Zr1Ir9ZbXhimmNsi3dQNL2p6Gcc5zw+962a9U5lGC9pbXhRzXYxcJzPL7Y7baHn2anVvx+Zw1AJw6y3P101bYJqR0zYeyRCh04wE7Ec1AQWRW3c8N0Ps035NN4xbUoTdds7k/Pd5TS/UAnQC0RnZrhcs73V8O2cwpX1d1xJet13XlnBH5wpMtZy6bno57AWhXj7i1HOhU5HhmJPst91Ttp+DXsdxJf3NjY7nhmKSN7fNMjmlG14+6hbPD5dn7XYg6A54jbClaznsa7exvBj5qwJlu7qRyzoQ6Ybd9qI1W6JCu6PbXUTr+mTktAVmXbs5wa267ZzQrxbwqa5u2+94gdNu5zY4pDtazvlQ5No6ysBpX7dtt+Gs5Jof1stz+kwOOp189GHjRbdpt4UfD3unbX95znfcusDOaFdHEvRDx3VORrZAndFhW3h4xn61U/eWp5xwPceZIW1h+hnPDe1TTsP2ulCB7fs6zFCzOgjELGft08t3eMI7s2Y7a2kJh63l/XrVC/WeKdsNRdjMaVcLE8xpX3e079RyBeZanu06uVfMUr5OR9clNulCL3snlhfWtJNPaC6y/dAzgZwzPOJ4y/t87QrUgnZDR8a/QXjLcUx3Yb3lOR2JAFioe74d1NaDyG3kyHD5kNdyA4mYdsKwC3E4qjtaIhZbXkd3kRwzE3Rzuy/azahu9va1fOBiK2rnRlh0ViIZsovGE6En4dATS+KYsUIkl/Htjus6a3azutevh059z2HPba7b2q+t2251b+CYrTBrtTvaRFPc7sTbSdx0dWPd5/bJ0KvZ3A5aTV0zcWSgfbrZauhGCrT82G0JsBqlLbe56q0yYDt+lA52gtaqnXb4kWs7SXtKt+tRaLbKGGp5Tk23g1SzKa/tdUxoGWC/7uigblZRDLXikyxuOm3mtj+q6awZtLSbTuVWz20uT3tuMwVP8UQO+qtRGLBih0yikOpySK/rtTjDiCHbjwLdtjsJOK1rJl9Jmp16S4epMtNmH285KRB2tNtg60z7OnC9de2n8qajestJdZqOTmuHzTVjzsPUCDN61axfP4Vcp82yZqKgnrpn1ql7gcMd5twMnJqTSbqtk7bmWq7XWZ6z3VYCz+swZX1Eu03PY6lHnHXdYJoFvdrS7dTdC7aXzn2hpd1mKzX5guM29Zrns80XtbOWunlRG/+7rOtizWk7Qdplt/x0qostp7PWYnMteqvr7Pqjba3dms5VPebYZgNwWdSxtm44p7wgTGPvDi3ceoe9qkPbd9wkJUtQvn3KSDVbrlk2e8948WmcwvtsvxOZAytFTGlXm5Qyh9fs5WO237Bz3C3a9j0BH7HXV1f0KWc1Ry2Ey7fa7Tg3ylHabZv0KApCX7eTFdvWjuGcoW6O6sbrGXzY8xvLt3qnJc2c7YctAS+sN1x7vXpz5Htr9p69nSC0/YbuZAi34fm+zsCwZbtBCu2z201fN+wc9k0ApJCvQydo61M6x0RBYLfz8VG9pX07CHNEQ68JeMpbs92WbtoZ0/1RTYg44NR83TYOTxGR7btBPp9b7XbguKtOCh8M2rY5T2a0m6NC7ZqFyvAh2xcMDjtBzctoD69EtfaK2YtThOc2RHf0artT80wAMWZGN3ynkUPtUOeA79gt3clGz3iurns5FNS90yl0W9DOeua072QmnPMaTc/s8RnC180o88gRkwtze8GcwTqH3GWjguvlGF+v2KdyeNVbW8lHeyecfHDo1VdbXjsLk0XHN+uMoWO6EZ3JgdCp5+Y2izK33+3aD3Q2yzt107drGRT5Tr1VPeg2HO2a3Fu7Znk5p7wUN9WKbz0Z5DtBaE7bFOHVRa/X8fxs5Ixu2Xm73XBO2UEOR74TOpFArHthmNEfsSPXpPlzuu6cMLvCmqNzIKqvtrXbyBBTLR22dCeDb9ZmheXgCR3aOeQ2bL8W+esZ5ha9qr0TXg47K04ORK4+YW6kKeKAbuu12CY5plNzhDyTU+t2Xbu6LXG5frd6rteO2lGGmHaMNTpaiJn2Al/nWs/olcj3BOifjOxA50rM6sjPGc46UT521vNPeO1VAUcdOzfnnG6a5KzpCUxb57zmPFev2QL0w+WZJHnLkEe074We28y5LmhnTecOXNQtR8xuUfv6dE68aIaGei0XuuhHucK363bbCd49QIRrrqXr6HraQzfQjXQTjdMEvYImaS9N0X46SIdphubpCN1Bd9ISvZJeRTWq0wlyaIVWqU0dOkk+BRTSR0nBwgBGURk5Tet0hu6mt9C9dB+9k95F76EP0QP0YXqUHqfzNIgq9WA7WfhvBPwxAZ8i4MsEdZa+QAr/nSz1VfomAY8S8I+k8C1C6TP0l/RX9BgBf07A/yKoiL5EwP8m4E9JYY5QnqajdIyWaI2AQwS8mFBaoOPUoCZB2fQvBPwCAS8hhf9E6HmIPkHn6GF6hJ6gJwnWPfRmehsBbyeop+hHBLyXgNdQvzpNZ0jhcVL4IAG/QwqvIYX7qYTPEfB6Av4jAf+BgH9LwH8hC++nk3gOAWfjuVl4Pik8SgoVAgYIeB4BJQL6CSACribguQRcTMDPEfDPBPwRASMEgIALCbiKgDIBwwT8IQHXEHAZARcR0EtQF9AlBOzikd8l4L8S8E8EjBGwkwBFwJUEDBFi7QYJ2EFAHwG7SeFSAr5DUN+nHxCwjYAXENBDwOUEjBJwBQHfo7GYs0UV/DwBHyNY+6hFHl2PLxA2lE8T1IP0eQL+hoC/YP2+TsDfEvAOgvosfZGAr1F/7HNZPkLA4wR8koC/I+B/xnYE/oSA/xHXCt8m4K8J+HtS+AcCvkqII0iJZxJTO/EVAv6MFL5B22Ci6TGCZWLrMVJcW1yn+DF8iRRMrG1dxvBxAl5EwC0E/CIBLgHjBLyMgF8m4HYCNA3gGKFQlGjvRJMgioUmlWATYJPiGgIew80E3EbArQR1gH5KwEsJeCEBiwQsE/BLBPVymqUBPEIQxcIjVFL3xBF/Dym8jRTuidumDOIpwhZF4Skaw78jhSqAXzXRB2AYwA4kv/9LwE9MfAHYCWAEQB8UfkiwnqYStgPoB/AMwSL0YBuAHxOsMoYwBqCCa3CagA9zeZxKeJR64khIys64/zT1CZzF9WS8Qn+dgPtJ4bcJ+AMq4/4YfrbSjzdQBb9Lh/B/CHgtAa8m4NcI+BUCfpOAdxNwioDf4ih8IwG/QcC/JuA/E/ABgnUXvYl+n4C30jD+PQH/ioB/Q8DvEfA6UngffRpYoryEHCrzMaziepxU3DfPfSnteBdsdeFOkuI+xX0KAdOY9p1Uwg3M9yQBgeCVyhynUkxr2tfz2D2ZPDO2FPOeZxqD96mMkzSQ6ThRmNuE0HE+0xGb1vn8lKAtCbpcblq3ma+Z/00ZXYl16OW5WTxvQzeIJSqznsMZ70mhx0SXXdK55/3zbMNxro28FD9P5bgdCh0nSMXwSYY/Kvy9FNuvNx63RBWWZ2F/5sdYjpqgV8WzGi14LdH2YMGb0tInC1HEuJhjNbPPOOudcOjByVh2b8xJSpuP8XlcjWd+LwmblDJJkzSU6Z9gghh6ZSGuJb+TG/C53Y2ur+IYnO+KmXLcF2b05cznU9nYSlwf7orJxE938Jox8CgABaNppeB7hQEYOQM8g/lum0pLqTo5sV2lnG4vTHA5wvacF/Er1/J4ZvNS7JvU/qu8TvL1oPCKrn0gk6luJOO5oBDtB0mhIzSr01DXCpZamflZAE4UVkRx9aarw0T0JGt6ossKFR5XYj55FJ3owufylzaRaeC9BNQIWMl2MMSRezimvSCG5dpgHymT+kruXbaKLTC/YX8qm9gXcbyU7Szdu1o9G1XJdrJ54aFkTH8mJY3epcLuNZ55bYk+buECAkyGd0FXUUneFz8fBn5A31bYR9ikpPjXlfB5TsmsJDHb8JzEZwn4LKlC/U+VQnb0s2q1Bf6DChF9zcJRApcyjtLbFCc+SiRBlUJSBCyI0ojrwQ003UV1jUlLU9RNugL/0pVgvU9xRnVg06JwgF6MWQJmSeHlWf0Q1EP0MG18Pl+kV3l5W1aGu+B76MI4OavizQQuFbyZ/tBkYBU8RfdZ2IbtGEM5TZu4fkbhbgIeoKtxnn4O7yLgnVzujYuFe0mJNnA3jeABUriPFN4Sjy3jQ6TwIQLew/U6vUPhDIHLCM7QActcjPLEq8QwuN6tzJUvSc/OZ2laN3yeLJynEh6n118RB3t8WCatJPjj51ddfJ8q+AH18+UkKRWuf3wJPFJo0Refi7NUjq9dZ+mXcZYqOEs/qHD4dpcvxvV3b94iVLcK3bT+h5sK0btbHTN3T2XuoC6OUi+OUknQKBylV+AonYdAJmUtvgb0Ypp2YI0sZS6ySlBYmCaFNWazxvhj8chktBRzTHDuvmz08dj3KZFC9mOJnhnDcVI4TuDalKcsXgpfMY0fbY/Xw/kdcVx+X8XVhcrcmV8St28UwRvfJfh2kWMtLhLXH1P9RsKuJxkSP8viqcRT9g7Fz+H42Rs/E3aXKHOZL+Me2m3WTgxZYiUZwSWuU7ziUorLPfRoFT+iD1+VXVCS8mZgCGWU8fGvAibPT8ub4rqyCe5dZ01MlwtxXc2ivVSMeEzQkDk8M4q4CAolnnj2J49WXH+6N87x89P6r6/GOH3wUkzQP96BJapiiYb4ODRp8tN358fqOF2Bcfr8IQYM8smbsmRznvbEqWFSPnUJ55N/fCnGaQeTmzKGcdqGcbrrWsyQwgxZmKEKZmgAM/SVPyhlK/v79MjHyniQLDxIwIP03ZuS9wRbPNUGzO89nbwH+MCvW/gM3Yi/ojvxl/TaPT9jnadwSpceeW96pPT/u6irapqS51GqmqWnjtJa1/DNF/bRTRd2eloOMt3DiDeclcJS/p23J6fn8fj1AeI1ndZJeRmfePf3oEn70KQHPmclBxUepk+eK2EIJsotDEFhCD0Ywyc/OIq7qIG76A1vHeL4rHBUpzE2yLXxf0ngVdZOYrskYsYUkwgNcNvw6+H+4TiCy+IU2LgGEnxFPCVNRdBYG9ZMaQueSsjFBs4bpWCjhmL2W6zJrGWxhfL9obJBo+4RG9f3FhRpXxf9Jq2tNBecNupe2mAlbLZfdeuQ4cs8YxNDVUxQH4/aUYgZq1ArbpfQrasVx013zKUx2NMVg4lMcPxW4r55GpB25DiV8qyMx3xc90Hs3YwfLOhlZTw5q+F2ideHSnFb2HDzvT+VariXt4jX5NkrY531Nlwz3Z89SjbITXb2dAYb433DuC5/dK/9YdHuj2NhPvOZEvbfKspKG1Yzr+9nWXWbx6kldqPSFnuNxTbM40CJmM105TlUhPdUIY6UwJez+Eykb+eYVIKnKT+8jU/cYYzTdj5GdzJuEOPxLVeJM93QjPI7nRKfw9vEqZ3SXsrjdwpcj+CT4q7i8YZnFeP0XIzTLxZk7opv9ePUz0d8meUrHid5WpvAfQxXsveQ4zTAKYdivNSvX/CoiHaa28wIfJ/QJ7WJYl7v/Q4wSdvj9yFJmeVaxe8uJukWTFKFYYgygUn6hmm8BJPUKwjS+m9/0wi/RCiSGqTMSvRyXy/3m3oE4/QcYZgyT7aX4e1ikulEdrCRewpOATuuyjxSmbsKgYCCjmnQ9LDxBnh8avQdTJOOqQoeZeGw1Jn9HGgjIhC3bxJw0kZgXpUtaKS+Kf6CAiz7+wtjB1jOoAg4GZR9ImB2bGJXi4PTyPzpu82a3Y4J6uUzzMAvwARdhAl6PiboUt4vzB7iYoJmCueSqS/DBGk+D08wr29+uYKD9EIcpB9djqX4zV8v53sVLNGYeBdWEXlgeZP3ZeUCDbJ/CRKefdyuCvpy9oZ8qetfh14ug1zSd1aG//aC7IrQycreyif9QwyPMjwi3twrzmdTvj2iL8VVsrei3XMqCzyEzNR+lpBrZe8gc95lrvsFz8sKsg39gBif2kwJH23mGxR0k/pbYk7yH5dSQcfUVpLnoGin+B5BXy3oWdRPCT1Tmt5CX7lgp+3Cj6l/jJw33GuC28YE3WdxJP/za80K+hWM099/J9nrJqg328MmNqwtK7svTnRt7BBrM8FPxPvEoFjLfYI26ZOZyHi26oySw12H3QTvzxOZDsVTHNxn2iPxapUH60T2h9dIvG9NxPvyAI8xe9BlPJcerqs8x1JcxjkzGOfsNT8IBwp79lBXZpHgR8QcUtuU2Na7i5n9htK9P/axLirbxxNe8vDsPsAnMl/sim90+VysTRKLNC5SO1jiTKuwvmqT/btU8Osg61cSvMtC1q8ZBvd3WNoDT8mzLTmbEhsOiXOpJM6vYrKS+sgSMntFklPiRK0kbGMJna0NvBLfynPP2P6iwlnUI/xfEvFWETqUu+J+Iht3IfsuldHLPEc5XsrsD8Ux2x/HVxJjco4VjtdqYa2auLumkMtYm9ggTzJlXG19tkPkE2m5iOdV2uSMV8JOVpfeE1meYsqLs/hJ6L78Ujik4FAvHNoOhwCHdsIhCwfpCBwqwaGb4dAot3uZfgwOXcvta2L6hOZ5zENxSccYnn1Ml8rbxf0W95lxFe6vMGxxbcoFTH8909wAh/rjvoP08zhI3/qJudJclv3rxNe1+HKRtIe5rxJfvuaz/1L7uO9yxg1gnkYwH/8HnL6U2Z1d8+b5RY+5+M4n/40xrWJ6i3m+gHn18PhUlqmvFPzS0sf1WDxmIoMt5qEK9FKn9FJZ7bpsd19fpZxeAZcLPCBkDbJNUeirpFdHrrexnGnM08VCl3tMxvc8zgI/814gpBchpE8m3wmENISQ9iGkBw1QRUh/Zhpfu1Jc/SCuQ4O8QsfEqn8pxukAxmk3002J3egWbvczfbozXMA3lAGx45jr4Pl3y/VZLpw91ib5cn9hD0fhQofCOpYl3RcHChe2XsGvLO5DfZyTY4s9xxJ7txJ3juJePMx7lxLjBoSuvYV5F23Qv8UZURF1iS1b3LNQOLPSM254E7vKMVUhv8ylWuCzTVyqS4X7WYkjJ42qipBZEdE0Ku6exflv43poizOqnOUo3XMZ2cKeaX4wWrgDypwhtcNjr0FAL0NAkwhoDgG9CJN0PQIaQEg74+8bgvj6fTEm6QgmaVnc2a/HJL0s/jplkqoI6LUfMGLebx4NjNOdvKJSnV7Id/FU/k0Yp3vT/yy2if8vhrldxgyB20rghjBDIwLuxwxdzLRpsQpwmXGDBbzhUcUMXcU6GNwOxvfxuJ4CvcHtErTbRH+Jafpj2EKZefexbNO3m/+jKXOtmEefGF9iOJWZ8t9eoCnxvCwx58tFXyoDPM8Rhvu4mDFXYoaeixkaxQw9/cw6vklvefsJNOmXcJwqOE4l/niyJ/uW4Lj4piD/J0TiVIbvphno+hYhwZVjOd3fGFyEJlXRpPfMsGCjwAWMNIIuFh83KO77XElwKHP951WB/Imh+IISH0UY3j8tMeP4f5xfvQoPkyW+NegxcOGDzz/djSdJ4Qn6xuu/BHUX/T59qyeu/u573x7DAv3wdw3jz73DcPybd5r2nYUZDqNJ27j9LTO711vxv0y5rUpsR4vrQdFncD1ddja2PU7DTDcgPnq1xFSL33KM8MwvLuCr8ceyzZh/pSAn96uMgY3x0L/h49viuGaBZx4vvRvwxxnfPd7MafumfDfjnduywu0yxzd4vhvnszU/JeZW2WSuqS+vYjnyi4DqFqVciIFKjGvGtkxlXF74nkd+3zMo5nJ1IU56WUZf7Jv8y4Q07qosL7dVM1v7Jbb1L3CsXMhyXyxkX4EmvZzx16MZf4C9A026QcRgmft7WXfD92OXMuIT5vGpsmC5reCCm7h+/+cl1dcNpwHWytpkD7hCbBbXiQi/QeCrTL8m9gJTLun68inxxG40aYhx/Ywv8fidvHsVo6cPTdqFJj0HTXqhGFti3VO9x0Tb4jGG/yjjLmW8EuZRrI8SfCH0KkZKuSvak77H0gETQviVaNJD120ym7NXo0kffQnr+0wamtcVlsFvmcZ9asMBktdXCjelgdvP8dAjAjDtf6PU4qVo0uv2FFTbxfXVhVqWa4STSqzNH41w4+4eMQOD+IwBbmOlVNcqb3adaOnBsquwIuUuXNlCJTOX31aCKO24X07weVybCLqKIx4FdT/y9T9ROEfX4xzdiXN0C87RFM7RLpyjV+AcXYtzxi/n6HuGyjQ+doAbpoziHO3FObod5+g6Lm80lBfjHD1hGh/40nPwBAFP0iWFehceJsTtR6g/PjtH+FOIpD85T8e2+KYveZpDtZq04ufoJrDh/9aPKOsh+oTBc/21kmUIniA8a/3khnqU61dyvatAf0kBVgW4SH9jAb6W61S/agF+PsM9DN9YkHtNgd9fbDWx3i0m/lRK8P8CAAD//54IjFmqRwAA"
@@ -0,0 +1,188 @@
+package phonenumbers
+
+import (
+	"sort"
+	"strings"
+)
+
+// RegionInterpretation is the result of parsing a number with one of the regions passed to
+// ParseWithRegionHints
+type RegionInterpretation struct {
+	// Region is the hinted region the number was parsed with
+	Region string
+
+	// Number is the parsed number, or nil if the number couldn't be parsed with this region
+	Number *PhoneNumber
+
+	// Err is the error parsing the number with this region, if any
+	Err error
+
+	// Valid is whether the parsed number is valid
+	Valid bool
+
+	// Possible is the result of checking whether the parsed number is possible
+	Possible ValidationResult
+
+	// Type is the type of the parsed number
+	Type PhoneNumberType
+
+	// Reason explains why this interpretation won, or why it lost to the winner
+	Reason string
+}
+
+// a criterion we use to rank interpretations, which returns a positive number if the first
+// interpretation is better, a negative one if the second is and zero if they are equal
+type regionHintCriterion struct {
+	description string
+	compare     func(a, b *rankedInterpretation) int
+}
+
+// an interpretation along with what we need to rank it
+type rankedInterpretation struct {
+	*RegionInterpretation
+	hintIndex int
+}
+
+// the criteria we rank interpretations by, in order of importance
+var regionHintCriteria = []regionHintCriterion{
+	{"could be parsed", func(a, b *rankedInterpretation) int {
+		return compareBools(a.Err == nil, b.Err == nil)
+	}},
+	{"is a valid number", func(a, b *rankedInterpretation) int {
+		return compareBools(a.Valid, b.Valid)
+	}},
+	{"is a possible number", func(a, b *rankedInterpretation) int {
+		return possibleScore(a.Possible) - possibleScore(b.Possible)
+	}},
+	{"has a known number type", func(a, b *rankedInterpretation) int {
+		return compareBools(a.Type != UNKNOWN, b.Type != UNKNOWN)
+	}},
+	{"is a fixed line or mobile number", func(a, b *rankedInterpretation) int {
+		return compareBools(isFixedLineOrMobile(a.Type), isFixedLineOrMobile(b.Type))
+	}},
+	{"is from the hinted region", func(a, b *rankedInterpretation) int {
+		return compareBools(a.isFromHintedRegion(), b.isFromHintedRegion())
+	}},
+	{"was hinted first", func(a, b *rankedInterpretation) int {
+		return b.hintIndex - a.hintIndex
+	}},
+}
+
+// ParseWithRegionHints parses a number using each of the passed in regions as the default
+// region, in order of how likely they are, and ranks the interpretations by validity, whether
+// they are possible, their number type and the order of the hints. It returns the number from
+// the best interpretation along with all the interpretations, best first. If the number can't
+// be parsed with any of the regions, the error from the first region is returned. Regions
+// which are hinted more than once are only interpreted the first time.
+func ParseWithRegionHints(numberToParse string, regions []string) (*PhoneNumber, []*RegionInterpretation, error) {
+	regions = uniqueRegionHints(regions)
+	if len(regions) == 0 {
+		regions = []string{UNKNOWN_REGION}
+	}
+
+	ranked := make([]*rankedInterpretation, len(regions))
+	for i, region := range regions {
+		interpretation := &RegionInterpretation{Region: region, Type: UNKNOWN, Possible: INVALID_LENGTH}
+
+		number, err := Parse(numberToParse, region)
+		if err != nil {
+			interpretation.Err = err
+		} else {
+			interpretation.Number = number
+			interpretation.Valid = IsValidNumber(number)
+			interpretation.Possible = IsPossibleNumberWithReason(number)
+			interpretation.Type = GetNumberType(number)
+		}
+		ranked[i] = &rankedInterpretation{RegionInterpretation: interpretation, hintIndex: i}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		cmp, _ := compareInterpretations(ranked[i], ranked[j])
+		return cmp > 0
+	})
+
+	best := ranked[0]
+	interpretations := make([]*RegionInterpretation, len(ranked))
+	for i, r := range ranked {
+		if i == 0 {
+			if len(ranked) == 1 {
+				r.Reason = "won: only region hinted"
+			} else {
+				_, criterion := compareInterpretations(r, ranked[1])
+				r.Reason = "won: " + criterion
+			}
+		} else {
+			_, criterion := compareInterpretations(best, r)
+			r.Reason = "lost: " + best.Region + " " + criterion
+		}
+		interpretations[i] = r.RegionInterpretation
+	}
+
+	// if nothing could be parsed, the error from the first region is the most relevant
+	if best.Err != nil {
+		for _, r := range ranked {
+			if r.hintIndex == 0 {
+				return nil, interpretations, r.Err
+			}
+		}
+	}
+	return best.Number, interpretations, nil
+}
+
+// returns the passed in regions without any which were already hinted, so that no two
+// interpretations are equal
+func uniqueRegionHints(regions []string) []string {
+	seen := make(map[string]bool, len(regions))
+	unique := make([]string, 0, len(regions))
+	for _, region := range regions {
+		key := strings.ToUpper(region)
+		if key == "" {
+			key = UNKNOWN_REGION
+		}
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, region)
+		}
+	}
+	return unique
+}
+
+// compares two interpretations, returning which is better and the description of the criterion
+// which decided it
+func compareInterpretations(a, b *rankedInterpretation) (int, string) {
+	for _, criterion := range regionHintCriteria {
+		if cmp := criterion.compare(a, b); cmp != 0 {
+			return cmp, criterion.description
+		}
+	}
+	return 0, ""
+}
+
+// returns whether the number from this interpretation belongs to the region it was parsed with
+func (r *rankedInterpretation) isFromHintedRegion() bool {
+	return r.Number != nil && GetRegionCodeForNumber(r.Number) == r.Region
+}
+
+func compareBools(a, b bool) int {
+	if a == b {
+		return 0
+	} else if a {
+		return 1
+	}
+	return -1
+}
+
+// returns a score for how possible a number is, higher is better
+func possibleScore(result ValidationResult) int {
+	switch result {
+	case IS_POSSIBLE:
+		return 2
+	case IS_POSSIBLE_LOCAL_ONLY:
+		return 1
+	}
+	return 0
+}
+
+func isFixedLineOrMobile(numberType PhoneNumberType) bool {
+	return numberType == FIXED_LINE || numberType == MOBILE || numberType == FIXED_LINE_OR_MOBILE
+}
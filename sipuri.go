@@ -0,0 +1,119 @@
+package phonenumbers
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalidSIPURI is returned when parsing a SIP URI which doesn't have a user part and host
+var ErrInvalidSIPURI = errors.New("the URI supplied is not a valid SIP URI")
+
+const (
+	SIP_PREFIX  = "sip:"
+	SIPS_PREFIX = "sips:"
+)
+
+// ParseSIPURI parses the number in the user part of the passed in SIP or SIPS URI, e.g.
+// sip:+14155550100@example.com;user=phone. If the URI has the user=phone parameter, the user
+// part is parsed as an RFC 3966 telephone-subscriber, so can include parameters such as
+// phone-context and ext. Otherwise the user part is parsed like any other input, which allows
+// for dial strings like sips:00442079460000@host. Numbers are parsed as if from defaultRegion
+// if they aren't in international format.
+//
+// Like ParseAndKeepRawInput, the returned number has its country_code_source set, and its raw
+// input is the user part of the URI.
+func ParseSIPURI(uri, defaultRegion string) (*PhoneNumber, error) {
+	invalid := func(offset int) error {
+		return newParseError(PARSE_INVALID_SIP_URI, uri, offset, defaultRegion)
+	}
+
+	lowerURI := strings.ToLower(uri)
+	var userStart int
+	if strings.HasPrefix(lowerURI, SIP_PREFIX) {
+		userStart = len(SIP_PREFIX)
+	} else if strings.HasPrefix(lowerURI, SIPS_PREFIX) {
+		userStart = len(SIPS_PREFIX)
+	} else {
+		return nil, invalid(0)
+	}
+
+	// the user part is everything up to the @, and may be followed by a password
+	at := strings.IndexByte(uri[userStart:], '@')
+	if at <= 0 {
+		return nil, invalid(userStart)
+	}
+	userInfo := uri[userStart : userStart+at]
+	if colon := strings.IndexByte(userInfo, ':'); colon >= 0 {
+		userInfo = userInfo[:colon]
+	}
+	user, err := url.PathUnescape(userInfo)
+	if err != nil || user == "" {
+		return nil, invalid(userStart)
+	}
+
+	// then comes the host and port, followed by any URI parameters and headers
+	hostStart := userStart + at + 1
+	hostAndParams := uri[hostStart:]
+	if headers := strings.IndexByte(hostAndParams, '?'); headers >= 0 {
+		hostAndParams = hostAndParams[:headers]
+	}
+	params := strings.Split(hostAndParams, ";")
+	if params[0] == "" {
+		return nil, invalid(hostStart)
+	}
+
+	userIsPhone := false
+	for _, param := range params[1:] {
+		if strings.EqualFold(param, "user=phone") {
+			userIsPhone = true
+		}
+	}
+
+	// users which are phones are telephone-subscribers, which we parse as tel: URIs so that
+	// any phone-context or isdn-subaddress is handled
+	numberToParse := user
+	if userIsPhone {
+		numberToParse = RFC3966_PREFIX + user
+	}
+
+	number := &PhoneNumber{}
+	if err := ParseAndKeepRawInputToNumber(numberToParse, defaultRegion, number); err != nil {
+		if parseErr, ok := err.(*ParseError); ok {
+			// offsets can only be mapped back into the URI if the user part wasn't escaped
+			offset := userStart
+			if user == userInfo {
+				offset += parseErr.Offset - (len(numberToParse) - len(user))
+				if offset < userStart {
+					offset = userStart
+				}
+			}
+			return nil, newParseError(parseErr.Reason, uri, offset, parseErr.Region)
+		}
+		return nil, err
+	}
+	number.RawInput = &user
+
+	return number, nil
+}
+
+// FormatSIPURI formats the passed in number as a SIP URI with the passed in host, e.g.
+// sip:+14155550100@example.com;user=phone, or if secure is true, as a SIPS URI. Any extension
+// is included in the user part as an RFC 3966 ext parameter.
+func FormatSIPURI(number *PhoneNumber, host string, secure bool) string {
+	uri := &strings.Builder{}
+	if secure {
+		uri.WriteString(SIPS_PREFIX)
+	} else {
+		uri.WriteString(SIP_PREFIX)
+	}
+	uri.WriteString(Format(number, E164))
+	if number.GetExtension() != "" {
+		uri.WriteString(RFC3966_EXTN_PREFIX)
+		uri.WriteString(number.GetExtension())
+	}
+	uri.WriteString("@")
+	uri.WriteString(host)
+	uri.WriteString(";user=phone")
+	return uri.String()
+}
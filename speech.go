@@ -0,0 +1,241 @@
+package phonenumbers
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// DEFAULT_SPEECH_PAUSE is the length of the pause between groups of digits in SSML
+const DEFAULT_SPEECH_PAUSE = "300ms"
+
+// SpeechStyle is how FormatForSpeechWithOptions renders a number
+type SpeechStyle int
+
+const (
+	// SPEECH_SSML_DIGITS renders the number as SSML with each digit written out as a word, and
+	// breaks between the groups of digits
+	SPEECH_SSML_DIGITS SpeechStyle = iota
+	// SPEECH_SSML_SAY_AS renders the number as SSML using a say-as telephone element, leaving
+	// the speech engine to decide how to read it
+	SPEECH_SSML_SAY_AS
+	// SPEECH_TEXT renders the number as plain text with each digit written out as a word, and
+	// commas between the groups of digits
+	SPEECH_TEXT
+)
+
+// SpeechOptions are the options for rendering a number with FormatForSpeechWithOptions
+type SpeechOptions struct {
+	// Style is how the number is rendered, defaulting to SSML with the digits written out
+	Style SpeechStyle
+
+	// Pause is the length of the breaks between groups of digits in SSML, defaulting to
+	// DEFAULT_SPEECH_PAUSE
+	Pause string
+}
+
+// the words used to read out a number in a language
+type speechWords struct {
+	digits    [10]string
+	plus      string
+	extension string
+
+	// the words repeated digits are read with, if the language uses them
+	double string
+	triple string
+}
+
+var britishEnglishSpeech = &speechWords{
+	digits:    [10]string{"oh", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"},
+	plus:      "plus",
+	extension: "extension",
+	double:    "double",
+	triple:    "triple",
+}
+
+// the words for each language and locale we support, keyed by lowercase language tag
+var speechLanguages = map[string]*speechWords{
+	"de": {
+		// zwo is used instead of zwei on the phone so it isn't confused with drei
+		digits:    [10]string{"null", "eins", "zwo", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun"},
+		plus:      "plus",
+		extension: "Durchwahl",
+	},
+	"en": {
+		digits:    [10]string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"},
+		plus:      "plus",
+		extension: "extension",
+	},
+	"en-au": britishEnglishSpeech,
+	"en-gb": britishEnglishSpeech,
+	"en-ie": britishEnglishSpeech,
+	"en-nz": britishEnglishSpeech,
+	"es": {
+		digits:    [10]string{"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve"},
+		plus:      "más",
+		extension: "extensión",
+	},
+	"fr": {
+		digits:    [10]string{"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf"},
+		plus:      "plus",
+		extension: "poste",
+	},
+	"pt": {
+		digits:    [10]string{"zero", "um", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove"},
+		plus:      "mais",
+		extension: "ramal",
+	},
+	"pt-br": {
+		// meia (meia dúzia) is used instead of seis so it isn't confused with três
+		digits:    [10]string{"zero", "um", "dois", "três", "quatro", "cinco", "meia", "sete", "oito", "nove"},
+		plus:      "mais",
+		extension: "ramal",
+	},
+}
+
+// FormatForSpeech renders the passed in number as SSML for reading aloud in the passed in
+// language, e.g. "en-GB", with each digit written out as a word and breaks between the groups
+// of digits. See FormatForSpeechWithOptions.
+func FormatForSpeech(number *PhoneNumber, lang string) string {
+	return FormatForSpeechWithOptions(number, lang, SpeechOptions{})
+}
+
+// FormatForSpeechWithOptions renders the passed in number for reading aloud in the passed in
+// language, so that speech engines read it as a phone number rather than as an amount.
+//
+// If the language tag has a region and the number is from the same country, e.g. a British
+// number read in "en-GB", the number is read as it's written nationally, otherwise it's read
+// in international format. Digits are grouped as the number is formatted, and read using local
+// conventions, such as "double" and "triple" for repeated digits in British English. Any
+// extension is read separately after the number.
+//
+// Supported languages are English, Spanish, French, German and Portuguese, with English used
+// for other languages. The result is a fragment of SSML without a speak element, so it can be
+// embedded in a larger prompt, unless the style is SPEECH_TEXT.
+func FormatForSpeechWithOptions(number *PhoneNumber, lang string, opts SpeechOptions) string {
+	words := speechLanguages[normalizeLanguage(lang)]
+	if words == nil {
+		words = speechLanguages[baseLanguage(lang)]
+	}
+	if words == nil {
+		words = speechLanguages["en"]
+	}
+	pause := opts.Pause
+	if pause == "" {
+		pause = DEFAULT_SPEECH_PAUSE
+	}
+	if opts.Style != SPEECH_TEXT {
+		pause = escapeSSML(pause)
+	}
+
+	withoutExtension := &PhoneNumber{}
+	proto.Merge(withoutExtension, number)
+	withoutExtension.Extension = nil
+
+	numberFormat := INTERNATIONAL
+	if region := speechRegion(lang); region != "" && GetCountryCodeForRegion(region) == int(number.GetCountryCode()) {
+		numberFormat = NATIONAL
+	}
+	formatted := Format(withoutExtension, numberFormat)
+	extension := number.GetExtension()
+
+	if opts.Style == SPEECH_SSML_SAY_AS {
+		spoken := &strings.Builder{}
+		spoken.WriteString(`<say-as interpret-as="telephone" format="`)
+		spoken.WriteString(strconv.Itoa(int(number.GetCountryCode())))
+		spoken.WriteString(`">`)
+		spoken.WriteString(formatted)
+		spoken.WriteString(`</say-as>`)
+		if extension != "" {
+			spoken.WriteString(`<break time="` + pause + `"/>` + words.extension + `<break time="` + pause + `"/>`)
+			spoken.WriteString(`<say-as interpret-as="digits">` + escapeSSML(extension) + `</say-as>`)
+		}
+		return spoken.String()
+	}
+
+	groups := make([]string, 0)
+	if strings.HasPrefix(formatted, "+") {
+		groups = append(groups, words.plus+" "+words.speakDigits(strconv.Itoa(int(number.GetCountryCode()))))
+		formatted = strings.TrimPrefix(formatted, "+"+strconv.Itoa(int(number.GetCountryCode())))
+	}
+	for _, group := range DIGITS_PATTERN.FindAllString(formatted, -1) {
+		groups = append(groups, words.speakDigits(group))
+	}
+	if extension != "" {
+		spokenExtension := words.speakDigits(extension)
+		if opts.Style != SPEECH_TEXT {
+			spokenExtension = escapeSSML(spokenExtension)
+		}
+		groups = append(groups, words.extension, spokenExtension)
+	}
+
+	if opts.Style == SPEECH_TEXT {
+		return strings.Join(groups, ", ")
+	}
+	return strings.Join(groups, `<break time="`+pause+`"/>`)
+}
+
+// escapes the passed in text so it can be written into SSML markup, including attribute values
+func escapeSSML(text string) string {
+	escaped := &strings.Builder{}
+	xml.EscapeText(escaped, []byte(text))
+	return escaped.String()
+}
+
+// reads out the passed in digits as words, using double and triple for repeated digits if the
+// language does. Anything that isn't an ASCII digit, such as a letter in an extension, is read
+// out as it is.
+func (w *speechWords) speakDigits(digits string) string {
+	chars := []rune(digits)
+	spoken := make([]string, 0, len(chars))
+	for i := 0; i < len(chars); {
+		if chars[i] < '0' || chars[i] > '9' {
+			spoken = append(spoken, string(chars[i]))
+			i++
+			continue
+		}
+
+		run := 1
+		for i+run < len(chars) && chars[i+run] == chars[i] {
+			run++
+		}
+		word := w.digits[chars[i]-'0']
+		i += run
+
+		if w.double == "" {
+			for ; run > 0; run-- {
+				spoken = append(spoken, word)
+			}
+			continue
+		}
+
+		// runs are read in twos and threes, e.g. four zeros are double oh double oh
+		for run > 0 {
+			switch {
+			case run == 1:
+				spoken = append(spoken, word)
+				run--
+			case run == 2 || run == 4:
+				spoken = append(spoken, w.double+" "+word)
+				run -= 2
+			default:
+				spoken = append(spoken, w.triple+" "+word)
+				run -= 3
+			}
+		}
+	}
+	return strings.Join(spoken, " ")
+}
+
+// returns the region of a language tag like en-GB, or empty if it doesn't have one
+func speechRegion(lang string) string {
+	parts := strings.Split(normalizeLanguage(lang), "-")
+	for _, part := range parts[1:] {
+		if len(part) == 2 {
+			return strings.ToUpper(part)
+		}
+	}
+	return ""
+}
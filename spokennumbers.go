@@ -0,0 +1,324 @@
+package phonenumbers
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// the words used to speak a number in a language
+type spokenNumberWords struct {
+	digits      map[string]rune
+	numbers     map[string]int
+	multipliers map[string]int
+	plus        []string
+	extension   []string
+
+	// the words which join tens and units, e.g. y in treinta y dos
+	conjunctions []string
+
+	// whether units come before tens, e.g. einundzwanzig in German, in which case the compounds
+	// are in numbers as a tens word is never followed by units
+	unitsFirst bool
+
+	// whether tens are counted in twenties, e.g. soixante-dix and quatre-vingts in French
+	vigesimal bool
+
+	// number words we can't convert into digits, such as hundreds and thousands, either whole
+	// words or the endings of compound words like zweihundert
+	unsupported         []string
+	unsupportedSuffixes []string
+}
+
+// the words for each language we support, keyed by language code
+var spokenNumberLanguages = map[string]*spokenNumberWords{
+	"de": {
+		digits: map[string]rune{
+			"null": '0', "eins": '1', "ein": '1', "zwei": '2', "zwo": '2', "drei": '3', "vier": '4',
+			"fünf": '5', "sechs": '6', "sieben": '7', "acht": '8', "neun": '9',
+		},
+		numbers: withGermanCompounds(map[string]int{
+			"zehn": 10, "elf": 11, "zwölf": 12, "dreizehn": 13, "vierzehn": 14, "fünfzehn": 15,
+			"sechzehn": 16, "siebzehn": 17, "achtzehn": 18, "neunzehn": 19, "zwanzig": 20,
+			"dreißig": 30, "dreissig": 30, "vierzig": 40, "fünfzig": 50, "sechzig": 60, "siebzig": 70,
+			"achtzig": 80, "neunzig": 90,
+		}),
+		multipliers:         map[string]int{"doppel": 2, "doppelte": 2, "dreifach": 3, "dreifache": 3},
+		plus:                []string{"plus"},
+		extension:           []string{"durchwahl", "nebenstelle", "apparat"},
+		unitsFirst:          true,
+		unsupported:         []string{"million", "millionen"},
+		unsupportedSuffixes: []string{"hundert", "tausend"},
+	},
+	"en": {
+		digits: map[string]rune{
+			"zero": '0', "oh": '0', "o": '0', "nought": '0', "one": '1', "two": '2', "three": '3',
+			"four": '4', "five": '5', "six": '6', "seven": '7', "eight": '8', "nine": '9',
+		},
+		numbers: map[string]int{
+			"ten": 10, "eleven": 11, "twelve": 12, "thirteen": 13, "fourteen": 14, "fifteen": 15,
+			"sixteen": 16, "seventeen": 17, "eighteen": 18, "nineteen": 19, "twenty": 20, "thirty": 30,
+			"forty": 40, "fifty": 50, "sixty": 60, "seventy": 70, "eighty": 80, "ninety": 90,
+		},
+		multipliers: map[string]int{"double": 2, "triple": 3},
+		plus:        []string{"plus"},
+		extension:   []string{"extension", "ext", "extn"},
+		unsupported: []string{"hundred", "thousand", "million"},
+	},
+	"es": {
+		digits: map[string]rune{
+			"cero": '0', "uno": '1', "una": '1', "un": '1', "dos": '2', "tres": '3', "cuatro": '4',
+			"cinco": '5', "seis": '6', "siete": '7', "ocho": '8', "nueve": '9',
+		},
+		numbers: map[string]int{
+			"diez": 10, "once": 11, "doce": 12, "trece": 13, "catorce": 14, "quince": 15,
+			"dieciséis": 16, "dieciseis": 16, "diecisiete": 17, "dieciocho": 18, "diecinueve": 19,
+			"veinte": 20, "veintiuno": 21, "veintiuna": 21, "veintiún": 21, "veintidós": 22,
+			"veintidos": 22, "veintitrés": 23, "veintitres": 23, "veinticuatro": 24, "veinticinco": 25,
+			"veintiséis": 26, "veintiseis": 26, "veintisiete": 27, "veintiocho": 28, "veintinueve": 29,
+			"treinta": 30, "cuarenta": 40, "cincuenta": 50, "sesenta": 60, "setenta": 70, "ochenta": 80,
+			"noventa": 90,
+		},
+		multipliers:         map[string]int{"doble": 2, "triple": 3},
+		plus:                []string{"más", "mas"},
+		extension:           []string{"extensión", "extension", "anexo", "interno"},
+		conjunctions:        []string{"y"},
+		unsupported:         []string{"cien", "ciento", "quinientos", "quinientas", "mil", "millón", "millon", "millones"},
+		unsupportedSuffixes: []string{"cientos", "cientas"},
+	},
+	"fr": {
+		digits: map[string]rune{
+			"zéro": '0', "zero": '0', "un": '1', "une": '1', "deux": '2', "trois": '3', "quatre": '4',
+			"cinq": '5', "six": '6', "sept": '7', "huit": '8', "neuf": '9',
+		},
+		numbers: map[string]int{
+			"dix": 10, "onze": 11, "douze": 12, "treize": 13, "quatorze": 14, "quinze": 15, "seize": 16,
+			"vingt": 20, "vingts": 20, "trente": 30, "quarante": 40, "cinquante": 50, "soixante": 60,
+			"septante": 70, "huitante": 80, "octante": 80, "nonante": 90,
+		},
+		multipliers:  map[string]int{"double": 2, "triple": 3},
+		plus:         []string{"plus"},
+		extension:    []string{"poste", "extension"},
+		conjunctions: []string{"et"},
+		vigesimal:    true,
+		unsupported:  []string{"cent", "cents", "mille", "million", "millions"},
+	},
+	"pt": {
+		digits: map[string]rune{
+			"zero": '0', "um": '1', "uma": '1', "dois": '2', "duas": '2', "três": '3', "tres": '3',
+			"quatro": '4', "cinco": '5', "seis": '6', "meia": '6', "sete": '7', "oito": '8', "nove": '9',
+		},
+		numbers: map[string]int{
+			"dez": 10, "onze": 11, "doze": 12, "treze": 13, "catorze": 14, "quatorze": 14, "quinze": 15,
+			"dezesseis": 16, "dezasseis": 16, "dezessete": 17, "dezassete": 17, "dezoito": 18,
+			"dezenove": 19, "dezanove": 19, "vinte": 20, "trinta": 30, "quarenta": 40, "cinquenta": 50,
+			"sessenta": 60, "setenta": 70, "oitenta": 80, "noventa": 90,
+		},
+		multipliers:  map[string]int{"duplo": 2, "dupla": 2, "triplo": 3, "tripla": 3},
+		plus:         []string{"mais"},
+		extension:    []string{"ramal", "extensão"},
+		conjunctions: []string{"e"},
+		unsupported: []string{
+			"cem", "cento", "duzentos", "duzentas", "trezentos", "trezentas", "quinhentos", "quinhentas",
+			"mil", "milhão", "milhao", "milhões", "milhoes",
+		},
+		unsupportedSuffixes: []string{"centos", "centas"},
+	},
+}
+
+// adds the German compounds of units and tens, e.g. einundzwanzig, to the passed in numbers
+func withGermanCompounds(numbers map[string]int) map[string]int {
+	units := map[string]int{
+		"ein": 1, "zwei": 2, "drei": 3, "vier": 4, "fünf": 5, "sechs": 6, "sieben": 7, "acht": 8, "neun": 9,
+	}
+	tens := make(map[string]int)
+	for word, value := range numbers {
+		if value >= 20 && value%10 == 0 {
+			tens[word] = value
+		}
+	}
+	for unit, unitValue := range units {
+		for ten, tenValue := range tens {
+			numbers[unit+"und"+ten] = tenValue + unitValue
+		}
+	}
+	return numbers
+}
+
+// returns whether the passed in word is a number word we can't convert
+func (w *spokenNumberWords) isUnsupported(word string) bool {
+	if containsString(w.unsupported, word) {
+		return true
+	}
+	for _, suffix := range w.unsupportedSuffixes {
+		if strings.HasSuffix(word, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// returns the number that a spoken number followed by another makes, e.g. twenty one, or false
+// if they are separate numbers
+func (w *spokenNumberWords) combine(first, second int) (int, bool) {
+	if !w.unitsFirst && first >= 20 && first%10 == 0 && second >= 1 && second <= 9 {
+		return first + second, true
+	}
+	if w.vigesimal {
+		// dix-sept, soixante-douze, quatre-vingts
+		if first == 10 && second >= 7 && second <= 9 {
+			return first + second, true
+		}
+		if (first == 60 || first == 80) && second >= 10 && second <= 19 {
+			return first + second, true
+		}
+		if first == 4 && second == 20 {
+			return 80, true
+		}
+	}
+	return 0, false
+}
+
+// NormalizeSpokenNumber converts a number spoken as words in the passed in language, such as
+// "oh two oh seven double five" or "zwei drei vier", into a string which can be passed to
+// Parse. Spelled digits, teens and tens are converted to digits, so "twenty one" becomes 21,
+// multipliers like "double" and "triple" repeat the number which follows them, "plus" becomes
+// a leading + and extension words become " ext. ". Digits and symbols already in the text are
+// kept, and any other words are dropped. Returns an empty string if the text contains number
+// words we can't convert, such as hundreds or thousands, rather than a wrong number.
+//
+// Supported languages are English, Spanish, French, German and Portuguese, e.g. "en" or
+// "pt-BR". For other languages only the digits and symbols in the text are kept. Unlike
+// ConvertAlphaCharactersInNumber, letters are never treated as vanity number letters.
+func NormalizeSpokenNumber(text, lang string) string {
+	words := spokenNumberLanguages[baseLanguage(lang)]
+	if words == nil {
+		words = &spokenNumberWords{}
+	}
+
+	normalized := &strings.Builder{}
+	multiplier := 1
+	hasExtension, pendingExtension := false, false
+
+	// the extension prefix is only written once we have digits to follow it
+	writeDigits := func(digits string) {
+		if pendingExtension {
+			normalized.WriteString(DEFAULT_EXTN_PREFIX)
+			pendingExtension = false
+		}
+		for i := 0; i < multiplier; i++ {
+			normalized.WriteString(digits)
+		}
+		multiplier = 1
+	}
+
+	// spoken numbers are only written once we know the next word doesn't add to them, as in
+	// twenty one, or -1 if there isn't one
+	pending := -1
+	writePending := func() {
+		if pending >= 0 {
+			writeDigits(strconv.Itoa(pending))
+			pending = -1
+		}
+	}
+
+	for _, word := range splitSpokenNumber(text) {
+		first := []rune(word)[0]
+		if unicode.IsDigit(first) {
+			writePending()
+			for _, digit := range word {
+				writeDigits(string(digit))
+			}
+			continue
+		}
+		if !unicode.IsLetter(first) {
+			// symbols like + * and # are kept as they are
+			writePending()
+			normalized.WriteString(word)
+			multiplier = 1
+			continue
+		}
+
+		word = strings.ToLower(word)
+		value, isNumber := words.numbers[word]
+		if digit, isDigit := words.digits[word]; isDigit {
+			value, isNumber = int(digit-'0'), true
+		}
+		if isNumber {
+			if combined, ok := words.combine(pending, value); pending >= 0 && ok {
+				pending = combined
+			} else {
+				writePending()
+				pending = value
+			}
+			continue
+		}
+
+		// words like the y in treinta y dos keep the tens pending for the units which follow
+		if containsString(words.conjunctions, word) && pending >= 20 {
+			continue
+		}
+		if words.isUnsupported(word) {
+			return ""
+		}
+
+		writePending()
+		if times, isMultiplier := words.multipliers[word]; isMultiplier {
+			multiplier = times
+		} else if containsString(words.plus, word) && normalized.Len() == 0 {
+			normalized.WriteRune(PLUS_SIGN)
+		} else if containsString(words.extension, word) && normalized.Len() > 0 && !hasExtension {
+			hasExtension, pendingExtension = true, true
+			multiplier = 1
+		}
+	}
+	writePending()
+	return normalized.String()
+}
+
+// splits the passed in text into words, runs of digits, and the symbols + * and #
+func splitSpokenNumber(text string) []string {
+	words := make([]string, 0)
+	current := &strings.Builder{}
+	var currentIsDigits bool
+
+	flush := func() {
+		if current.Len() > 0 {
+			words = append(words, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.IsDigit(r):
+			if !currentIsDigits {
+				flush()
+			}
+			current.WriteRune(r)
+			currentIsDigits = true
+		case unicode.IsLetter(r) || unicode.Is(unicode.Mn, r):
+			if currentIsDigits {
+				flush()
+			}
+			current.WriteRune(r)
+			currentIsDigits = false
+		default:
+			flush()
+			if r == '+' || r == '＋' || r == '*' || r == '#' {
+				words = append(words, string(r))
+			}
+		}
+	}
+	flush()
+	return words
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,286 @@
+package phonenumbers
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// ErrInvalidTelURI is returned when parsing a tel: URI which doesn't follow the syntax of RFC 3966
+var ErrInvalidTelURI = errors.New("the URI supplied is not a valid tel URI")
+
+// TelURIParam is a parameter of a tel: URI other than the extension, ISDN subaddress and phone
+// context, such as the npdi and rn parameters used for number portability (RFC 4694)
+type TelURIParam struct {
+	// Name is the name of the parameter, which is always lowercase as names are case-insensitive
+	Name string
+
+	// Value is the value of the parameter, empty if it has none, e.g. npdi
+	Value string
+}
+
+// TelURI is a phone number written as a tel: URI as described in RFC 3966, along with the
+// parameters which followed it
+type TelURI struct {
+	// Number is the phone number, including any extension
+	Number *PhoneNumber
+
+	// PhoneContext is the domain name the number is local to, if it is a local number. Local
+	// numbers with a global number prefix as their context are converted to global numbers.
+	PhoneContext string
+
+	// Subaddress is the ISDN subaddress
+	Subaddress string
+
+	// Params are any other parameters, in the order they appeared
+	Params []TelURIParam
+}
+
+// ParseTelURI parses the passed in tel: URI. Numbers local to a domain name are parsed as if
+// from defaultRegion. Malformed URIs return a ParseError which unwraps to ErrInvalidTelURI
+// and whose offset points at the problem.
+func ParseTelURI(uri, defaultRegion string) (*TelURI, error) {
+	invalid := func(offset int) error {
+		return newParseError(PARSE_INVALID_TEL_URI, uri, offset, defaultRegion)
+	}
+
+	if !strings.HasPrefix(strings.ToLower(uri), RFC3966_PREFIX) {
+		return nil, invalid(0)
+	}
+
+	// split into the number and its parameters, remembering where each starts
+	parts := strings.Split(uri[len(RFC3966_PREFIX):], ";")
+	offsets := make([]int, len(parts))
+	offset := len(RFC3966_PREFIX)
+	for i, part := range parts {
+		offsets[i] = offset
+		offset += len(part) + 1
+	}
+
+	number := parts[0]
+	isGlobal := strings.HasPrefix(number, "+")
+	if isGlobal && !isTelURIGlobalDigits(number) || !isGlobal && !isTelURILocalDigits(number) {
+		return nil, invalid(offsets[0])
+	}
+
+	telURI := &TelURI{}
+	var extension, context string
+	var hasExtension, hasSubaddress, hasContext bool
+
+	for i, param := range parts[1:] {
+		paramOffset := offsets[i+1]
+		name, value, hasValue := param, "", false
+		if eq := strings.IndexByte(param, '='); eq >= 0 {
+			name, value, hasValue = param[:eq], param[eq+1:], true
+		}
+		name = strings.ToLower(name)
+		if !isTelURIParamName(name) || hasValue && value == "" {
+			return nil, invalid(paramOffset)
+		}
+
+		switch name {
+		case "ext":
+			if hasExtension || hasSubaddress || !hasValue || !isTelURIPhoneDigits(value, false) {
+				return nil, invalid(paramOffset)
+			}
+			extension, hasExtension = removeTelURISeparators(value), true
+		case "isub":
+			if hasSubaddress || hasExtension || !hasValue || !isTelURIValue(value, true) {
+				return nil, invalid(paramOffset)
+			}
+			telURI.Subaddress, hasSubaddress = value, true
+		case "phone-context":
+			if hasContext || isGlobal || !hasValue {
+				return nil, invalid(paramOffset)
+			}
+			if strings.HasPrefix(value, "+") {
+				if !isTelURIGlobalDigits(value) {
+					return nil, invalid(paramOffset)
+				}
+			} else if !isDomainName(value) {
+				return nil, invalid(paramOffset)
+			}
+			context, hasContext = value, true
+		default:
+			if hasValue && !isTelURIValue(value, false) {
+				return nil, invalid(paramOffset)
+			}
+			telURI.Params = append(telURI.Params, TelURIParam{Name: name, Value: value})
+		}
+	}
+
+	// local numbers must say what they are local to
+	if !isGlobal && !hasContext {
+		return nil, invalid(offsets[0])
+	}
+
+	// work out what we should pass to Parse, global numbers and those local to a global number
+	// prefix can be parsed without a region
+	toParse, region := removeTelURISeparators(number), UNKNOWN_REGION
+	if hasContext {
+		if strings.HasPrefix(context, "+") {
+			toParse = removeTelURISeparators(context) + toParse
+		} else {
+			telURI.PhoneContext = strings.ToLower(strings.TrimSuffix(context, "."))
+			region = defaultRegion
+		}
+	}
+
+	phoneNumber, err := Parse(toParse, region)
+	if err != nil {
+		if parseErr, ok := err.(*ParseError); ok {
+			return nil, newParseError(parseErr.Reason, uri, offsets[0], parseErr.Region)
+		}
+		return nil, err
+	}
+	if hasExtension {
+		phoneNumber.Extension = proto.String(extension)
+	}
+	telURI.Number = phoneNumber
+
+	return telURI, nil
+}
+
+// String returns the canonical form of this URI, which has the extension or subaddress first,
+// then the phone context, then the other parameters ordered by name. Local numbers are written
+// as their national significant number, without a national prefix, so that they parse back to
+// the same number.
+func (u *TelURI) String() string {
+	number := &PhoneNumber{}
+	proto.Merge(number, u.Number)
+	number.Extension = nil
+
+	uri := &strings.Builder{}
+	if u.PhoneContext != "" {
+		uri.WriteString(RFC3966_PREFIX)
+		uri.WriteString(GetNationalSignificantNumber(number))
+	} else {
+		uri.WriteString(Format(number, RFC3966))
+	}
+
+	if u.Number.GetExtension() != "" {
+		uri.WriteString(RFC3966_EXTN_PREFIX)
+		uri.WriteString(u.Number.GetExtension())
+	} else if u.Subaddress != "" {
+		uri.WriteString(RFC3966_ISDN_SUBADDRESS)
+		uri.WriteString(u.Subaddress)
+	}
+	if u.PhoneContext != "" {
+		uri.WriteString(RFC3966_PHONE_CONTEXT)
+		uri.WriteString(u.PhoneContext)
+	}
+
+	params := make([]TelURIParam, len(u.Params))
+	copy(params, u.Params)
+	sort.SliceStable(params, func(i, j int) bool { return params[i].Name < params[j].Name })
+	for _, param := range params {
+		uri.WriteString(";")
+		uri.WriteString(param.Name)
+		if param.Value != "" {
+			uri.WriteString("=")
+			uri.WriteString(param.Value)
+		}
+	}
+	return uri.String()
+}
+
+// whether c is one of RFC 3966's visual separators
+func isTelURISeparator(c byte) bool {
+	return c == '-' || c == '.' || c == '(' || c == ')'
+}
+
+func removeTelURISeparators(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x80 && isTelURISeparator(byte(r)) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func isDigit(c byte) bool    { return c >= '0' && c <= '9' }
+func isHexDigit(c byte) bool { return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F' }
+func isAlpha(c byte) bool    { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+
+// whether s is made up of phonedigits, or phonedigit-hexes if hex is true, with at least one
+// character which isn't a separator
+func isTelURIPhoneDigits(s string, hex bool) bool {
+	hasDigit := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case isDigit(c), hex && (isHexDigit(c) || c == '*' || c == '#'):
+			hasDigit = true
+		case isTelURISeparator(c):
+		default:
+			return false
+		}
+	}
+	return hasDigit
+}
+
+// whether s is a global-number-digits, e.g. +1-201-555-0123
+func isTelURIGlobalDigits(s string) bool {
+	return strings.HasPrefix(s, "+") && isTelURIPhoneDigits(s[1:], false)
+}
+
+// whether s is a local-number-digits, e.g. 7042 or *21#
+func isTelURILocalDigits(s string) bool {
+	return isTelURIPhoneDigits(s, true)
+}
+
+// whether s is a valid parameter name, made up of alphanumerics and hyphens
+func isTelURIParamName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) && !isAlpha(s[i]) && s[i] != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// whether s is a valid parameter value (made up of paramchars), or if uric is true, a valid
+// ISDN subaddress (made up of urics)
+func isTelURIValue(s string, uric bool) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case isDigit(c), isAlpha(c), strings.IndexByte("-_.!~*'()", c) >= 0:
+			// unreserved
+		case strings.IndexByte("[]/:&+$", c) >= 0:
+			// param-unreserved
+		case uric && strings.IndexByte("?@=,", c) >= 0:
+			// the rest of reserved, we treat ; as the start of the next parameter
+		case c == '%' && i+2 < len(s) && isHexDigit(s[i+1]) && isHexDigit(s[i+2]):
+			i += 2
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
+
+// whether s is a domain name, e.g. example.com, optionally with a trailing dot
+func isDomainName(s string) bool {
+	labels := strings.Split(strings.TrimSuffix(s, "."), ".")
+	for i, label := range labels {
+		if label == "" || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for j := 0; j < len(label); j++ {
+			if !isDigit(label[j]) && !isAlpha(label[j]) && label[j] != '-' {
+				return false
+			}
+		}
+		// the top label must start with a letter so it can't be confused with a number
+		if i == len(labels)-1 && !isAlpha(label[0]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+// Package timezone maps phone numbers to the timezones they are likely to be in.
+//
+// The timezone data lives in this package rather than in phonenumbers itself and is only
+// linked into programs which import it.
+package timezone
+
+import (
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/nyaruka/phonenumbers/internal/prefixmap"
+)
+
+// our gzipped map from prefixes to timezones
+//
+//go:embed data/prefix_to_timezone.gz
+var timezoneMapData []byte
+
+// Our once and map for prefix to timezone lookups
+var (
+	timezoneOnce sync.Once
+	timezoneMap  *prefixmap.IntStringArrayMap
+	timezoneErr  error
+)
+
+func init() {
+	prefixmap.TimezonesForPrefix = GetTimezonesForPrefix
+}
+
+// GetTimezonesForPrefix returns a slice of Timezones corresponding to the number passed
+// or error when it is impossible to convert the string to int
+// The algorythm tries to match the timezones starting from the maximum
+// number of phone number digits and decreasing until it finds one or reaches 0
+func GetTimezonesForPrefix(number string) ([]string, error) {
+	timezoneOnce.Do(func() {
+		timezoneMap, timezoneErr = prefixmap.LoadIntStringArrayMap(timezoneMapData)
+	})
+
+	if timezoneMap == nil {
+		return nil, fmt.Errorf("error loading timezone map: %v", timezoneErr)
+	}
+
+	// strip any leading +
+	number = strings.TrimLeft(number, "+")
+
+	for i := timezoneMap.MaxLength; i > 0; i-- {
+		index, err := strconv.Atoi(number[0:i])
+		if err != nil {
+			return nil, err
+		}
+		tzs, found := timezoneMap.Map[index]
+		if found {
+			return tzs, nil
+		}
+	}
+	return []string{phonenumbers.UNKNOWN_TIMEZONE}, nil
+}
+
+// GetTimezonesForNumber returns the names of timezones which we believe maps to the
+// passed in number.
+func GetTimezonesForNumber(number *phonenumbers.PhoneNumber) ([]string, error) {
+	e164 := phonenumbers.Format(number, phonenumbers.E164)
+	return GetTimezonesForPrefix(e164)
+}
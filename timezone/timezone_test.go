@@ -0,0 +1,124 @@
+package timezone
+
+import (
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/nyaruka/phonenumbers/internal/prefixmap"
+)
+
+func TestGetTimeZonesForPrefix(t *testing.T) {
+	tests := []struct {
+		num              string
+		expectedTimeZone string
+	}{
+		{
+			num:              "+442073238299",
+			expectedTimeZone: "Europe/London",
+		},
+		{
+			num:              "+61491570156",
+			expectedTimeZone: "Australia/Sydney",
+		},
+		{
+			num:              "+61255501234",
+			expectedTimeZone: "Australia/Sydney",
+		},
+		{
+			num:              "+12067798181",
+			expectedTimeZone: "America/Los_Angeles",
+		},
+		{
+			num:              "+390399123456",
+			expectedTimeZone: "Europe/Rome",
+		},
+		{
+			num:              "+541151123456",
+			expectedTimeZone: "America/Buenos_Aires",
+		},
+		{
+			num:              "+15167706076",
+			expectedTimeZone: "America/New_York",
+		},
+		{
+			num:              "+917999999543",
+			expectedTimeZone: "Asia/Calcutta",
+		},
+		{
+			num:              "+540111561234567",
+			expectedTimeZone: "America/Buenos_Aires",
+		},
+		{
+			num:              "+18504320800",
+			expectedTimeZone: "America/Chicago",
+		},
+		{
+			num:              "+14079395277",
+			expectedTimeZone: "America/New_York",
+		},
+		{
+			num:              "+18508632167",
+			expectedTimeZone: "America/Chicago",
+		},
+		{
+			num:              "+40213158207",
+			expectedTimeZone: "Europe/Bucharest",
+		},
+		// UTC +5:45
+		{
+			num:              "+97714240520",
+			expectedTimeZone: "Asia/Katmandu",
+		},
+		// UTC -3:30
+		{
+			num:              "+17097264534",
+			expectedTimeZone: "America/St_Johns",
+		},
+		{
+			num:              "0000000000",
+			expectedTimeZone: "Etc/Unknown",
+		},
+	}
+
+	for _, test := range tests {
+		timeZones, err := GetTimezonesForPrefix(test.num)
+		if err != nil {
+			t.Errorf("Failed to getTimezone for the number %s: %s", test.num, err)
+		}
+
+		if len(timeZones) == 0 {
+			t.Errorf("Expected at least 1 timezone.")
+		}
+
+		if timeZones[0] != test.expectedTimeZone {
+			t.Errorf("Expected '%s', got '%s' for '%s'", test.expectedTimeZone, timeZones[0], test.num)
+		}
+
+		num, err := phonenumbers.Parse(test.num, "")
+		if err != nil {
+			continue
+		}
+
+		timeZones, err = GetTimezonesForNumber(num)
+		if err != nil {
+			t.Errorf("Failed to getTimezone for the number %s: %s", num, err)
+		}
+
+		if len(timeZones) == 0 {
+			t.Errorf("Expected at least 1 timezone.")
+		}
+
+		if timeZones[0] != test.expectedTimeZone {
+			t.Errorf("Expected '%s', got '%s' for '%s'", test.expectedTimeZone, timeZones[0], num)
+		}
+	}
+}
+
+func BenchmarkLoadTimezoneMap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := prefixmap.LoadIntStringArrayMap(timezoneMapData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}